@@ -0,0 +1,30 @@
+package unicode_id_trie_rle
+
+import (
+	"strings"
+	"unicode"
+)
+
+func isBidiControl(cp rune) bool {
+	return unicode.Is(unicode.Bidi_Control, cp)
+}
+
+// Returns whether s contains a character with the `Bidi_Control` property,
+// such as U+202E RIGHT-TO-LEFT OVERRIDE. These are invisible, but reorder the
+// text around them, which can make source code display differently from how
+// it's parsed.
+func ContainsBidiControl(s string) bool {
+	return strings.IndexFunc(s, isBidiControl) >= 0
+}
+
+// Returns the byte offsets of every character in s which ContainsBidiControl
+// would report, so that each one can be flagged.
+func BidiControlPositions(s string) []int {
+	var positions []int
+	for i, c := range s {
+		if isBidiControl(c) {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
@@ -0,0 +1,27 @@
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBidiControlPositions(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected []int
+	}{
+		{"access_level", nil},
+		{"a\u202eb\u2066c", []int{1, 5}},
+		{"\u200fé\u061c", []int{0, 5}},
+		{"", nil},
+	}
+	for _, c := range cases {
+		got := BidiControlPositions(c.s)
+		if !slices.Equal(got, c.expected) {
+			t.Errorf("BidiControlPositions(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+		if contains := ContainsBidiControl(c.s); contains != (len(c.expected) > 0) {
+			t.Errorf("ContainsBidiControl(%q) = %v", c.s, contains)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+//go:build identblob
+
+package unicode_id_trie_rle
+
+// With the identblob build tag, the tables are decoded from tablesBlob when
+// the package is initialized rather than compiled in as array literals.
+var (
+	leafOffsets   []uint16
+	leafRunStarts []uint16
+	leafRunValues []IdentifierClass
+	level2Tables  []uint16
+	level1Table   []uint16
+	mphSeeds      []uint16
+	mphKeys       []uint16
+	mphLeaves     []uint16
+
+	runStreamOffsets []uint16
+	runStream        []byte
+)
+
+func init() {
+	decodeTables(tablesBlob)
+}
+
+func decodeTables(blob string) {
+	t, err := decodeTrieTables(blob)
+	if err != nil {
+		panic("unicode_id_trie_rle: decoding the embedded tables: " + err.Error())
+	}
+	if t.version != unicodeVersion || t.minCodepoint != minCodepoint || t.maxCodepoint != maxCodepoint ||
+		t.shift != shift || t.lowerBits != lowerBits || t.useMPH != useMPH ||
+		t.useVarintRuns != useVarintRuns || t.mphDefaultLeaf != mphDefaultLeaf || t.customBits != customBits {
+		panic("unicode_id_trie_rle: the embedded tables don't match the generated constants")
+	}
+
+	leafOffsets = t.leafOffsets
+	leafRunStarts = t.leafRunStarts
+	leafRunValues = t.leafRunValues
+	level2Tables = t.level2Tables
+	level1Table = t.level1Table
+	mphSeeds = t.mphSeeds
+	mphKeys = t.mphKeys
+	mphLeaves = t.mphLeaves
+	runStreamOffsets = t.runStreamOffsets
+	runStream = t.runStream
+}
@@ -0,0 +1,12 @@
+//go:build identblob
+
+package unicode_id_trie_rle
+
+import "testing"
+
+func BenchmarkDecodeTables(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		decodeTables(tablesBlob)
+	}
+}
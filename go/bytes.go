@@ -0,0 +1,151 @@
+package unicode_id_trie_rle
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// Returns the byte offset just past the longest identifier beginning at
+// b[start], or start itself if no identifier begins there. This is meant for
+// the inner loop of a tokenizer, which can call it at each position where an
+// identifier may begin.
+//
+// The UTF-8 is decoded one rune at a time and never beyond len(b). A
+// trailing ZWNJ or ZWJ isn't part of the identifier, and neither is invalid
+// UTF-8.
+func ValidIdentBytesAt(b []byte, start int) (end int) {
+	if start < 0 || start >= len(b) {
+		return start
+	}
+
+	c, size := utf8.DecodeRune(b[start:])
+	if UnicodeIdentifierClass(c)&Start == 0 {
+		return start
+	}
+
+	end = start + size
+	for i := end; i < len(b); i += size {
+		if b[i] < utf8.RuneSelf {
+			c, size = rune(b[i]), 1
+		} else {
+			c, size = utf8.DecodeRune(b[i:])
+		}
+
+		// Join controls only extend the identifier once something
+		// which continues it follows them.
+		if IsJoinControl(c) {
+			continue
+		}
+		if UnicodeIdentifierClass(c)&Continue == 0 {
+			break
+		}
+		end = i + size
+	}
+	return end
+}
+
+// Checks if b is a unicode identifier, as defined by IsIdent. Invalid UTF-8
+// is never part of an identifier.
+func IsIdentBytes(b []byte) bool {
+	return len(b) > 0 && ValidIdentBytesAt(b, 0) == len(b)
+}
+
+// Returns the identifier class of the rune encoded in UTF-8 as the first n
+// bytes of b0, b1, b2 and b3, for tokenizers which already have the bytes of
+// a rune at hand and know its length from the leading byte. The codepoint is
+// assembled directly from the bytes, which are assumed to be well-formed
+// UTF-8 for the given n, since nothing is validated. Other is returned if n
+// isn't between 1 and 4.
+func ClassifyUTF8(b0, b1, b2, b3 byte, n int) IdentifierClass {
+	var cp rune
+	switch n {
+	case 1:
+		cp = rune(b0)
+	case 2:
+		cp = rune(b0&0x1f)<<6 | rune(b1&0x3f)
+	case 3:
+		cp = rune(b0&0x0f)<<12 | rune(b1&0x3f)<<6 | rune(b2&0x3f)
+	case 4:
+		cp = rune(b0&0x07)<<18 | rune(b1&0x3f)<<12 | rune(b2&0x3f)<<6 | rune(b3&0x3f)
+	default:
+		return Other
+	}
+	return UnicodeIdentifierClass(cp)
+}
+
+// How IsIdentBytesPolicy treats invalid UTF-8.
+type DecodeErrorPolicy int
+
+const (
+	// Invalid UTF-8 means the bytes aren't an identifier, as with
+	// IsIdentBytes.
+	DecodeReject DecodeErrorPolicy = iota
+	// Each invalid byte is skipped, as though it weren't there, so that a
+	// lenient tool can keep going past an encoding error.
+	DecodeSkipByte
+	// Each invalid byte is decoded as ReplacementChar, as utf8.DecodeRune
+	// does. Its class is Other, so this rejects the same identifiers as
+	// DecodeReject, but leaves the choice to the caller.
+	DecodeReplaceRune
+)
+
+// Checks if b is a unicode identifier, as defined by IsIdent, treating any
+// invalid UTF-8 in it as onError says.
+func IsIdentBytesPolicy(b []byte, onError DecodeErrorPolicy) bool {
+	var v identScanner
+	for i := 0; i < len(b); {
+		c, size := utf8.DecodeRune(b[i:])
+		i += size
+		if c == utf8.RuneError && size == 1 {
+			switch onError {
+			case DecodeReject:
+				return false
+			case DecodeSkipByte:
+				continue
+			}
+			c = ReplacementChar
+		}
+		if !v.next(c) {
+			return false
+		}
+	}
+	return v.valid()
+}
+
+// Checks if the bytes read from r until io.EOF are a unicode identifier, as
+// defined by IsIdent, for callers streaming a file or network connection.
+// Runes split between reads are held back until the rest of their bytes
+// arrive. Invalid UTF-8, including a rune cut short by the end of the
+// stream, means the bytes aren't an identifier, as with IsIdentBytes.
+//
+// Reading stops as soon as the bytes can't be an identifier, returning false
+// and a nil error. Any error from r other than io.EOF is returned with false.
+func IsIdentByteReader(r io.Reader) (bool, error) {
+	var v identScanner
+	var buf [4096]byte
+	pending := 0
+	for {
+		n, err := r.Read(buf[pending:])
+		n += pending
+
+		i := 0
+		for i < n && utf8.FullRune(buf[i:n]) {
+			c, size := utf8.DecodeRune(buf[i:n])
+			if c == utf8.RuneError && size == 1 {
+				return false, nil
+			}
+			if !v.next(c) {
+				return false, nil
+			}
+			i += size
+		}
+		pending = copy(buf[:], buf[i:n])
+
+		if err == io.EOF {
+			return pending == 0 && v.valid(), nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+}
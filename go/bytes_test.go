@@ -0,0 +1,266 @@
+package unicode_id_trie_rle
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode/utf8"
+)
+
+func TestValidIdentBytesAt(t *testing.T) {
+	cases := []struct {
+		s     string
+		start int
+		end   int
+	}{
+		{"foo bar", 0, 3},
+		{"foo bar", 4, 7},
+		{"foo bar", 3, 3},
+		{"x = 42", 4, 4},
+		{"été", 0, 5},
+		{"(été)", 1, 6},
+		{"a€b", 0, 1},
+		{"日本語;", 0, 9},
+		{"a\u200cb", 0, 5},
+		{"a\u200c", 0, 1},
+		{"a\u200c\u200d+", 0, 1},
+		{"ab\xe6\x97", 0, 2},
+		{"\xe6\x97\xa5\xe6\x97", 0, 3},
+		{"abc", 3, 3},
+		{"abc", -1, -1},
+	}
+	for _, c := range cases {
+		if got := ValidIdentBytesAt([]byte(c.s), c.start); got != c.end {
+			t.Errorf("ValidIdentBytesAt(%q, %d) = %d, expected %d", c.s, c.start, got, c.end)
+		}
+	}
+}
+
+func TestValidIdentBytesAtTruncated(t *testing.T) {
+	// Every prefix of a buffer which ends inside a multibyte rune must
+	// stop before that rune, without reading past the slice.
+	full := []byte("aé日𝔸")
+	expected := []int{0, 1, 1, 3, 3, 3, 6, 6, 6, 6, 10}
+	for n := range full {
+		if got := ValidIdentBytesAt(full[:n+1:n+1], 0); got != expected[n+1] {
+			t.Errorf("ValidIdentBytesAt(%q, 0) = %d, expected %d", full[:n+1], got, expected[n+1])
+		}
+	}
+}
+
+func BenchmarkValidIdentBytesAt(b *testing.B) {
+	src := []byte("let identifier = snake_case_name_42 + идентификатор * 変数名;")
+	b.SetBytes(int64(len(src)))
+	for i := 0; i < b.N; i++ {
+		for pos := 0; pos < len(src); {
+			end := ValidIdentBytesAt(src, pos)
+			if end == pos {
+				end++
+			}
+			pos = end
+		}
+	}
+}
+
+func TestClassifyUTF8(t *testing.T) {
+	for _, cp := range []rune{'a', '_', '0', ' ', 0x7f, 'é', 0x0301, 'π', 0x07ff, '日', 0x200c, 0xfffd, 0xffff, 0x1d538, 0x1f600, 0x10ffff} {
+		var buf [utf8.UTFMax]byte
+		n := utf8.EncodeRune(buf[:], cp)
+		if got, expected := ClassifyUTF8(buf[0], buf[1], buf[2], buf[3], n), UnicodeIdentifierClass(cp); got != expected {
+			t.Errorf("ClassifyUTF8(% x, %d) = %d, expected %d", buf[:n], n, got, expected)
+		}
+	}
+
+	if got := ClassifyUTF8('a', 0, 0, 0, 0); got != Other {
+		t.Errorf("ClassifyUTF8 with n = 0 returned %d, expected Other", got)
+	}
+}
+
+func TestClassifyUTF8AllRunes(t *testing.T) {
+	var buf [utf8.UTFMax]byte
+	for cp := rune(0); cp <= 0x10ffff; cp++ {
+		if !utf8.ValidRune(cp) {
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], cp)
+		if got, expected := ClassifyUTF8(buf[0], buf[1], buf[2], buf[3], n), UnicodeIdentifierClass(cp); got != expected {
+			t.Fatalf("ClassifyUTF8 disagrees at U+%04X: got %d, expected %d", cp, got, expected)
+		}
+	}
+}
+
+func TestIsIdentBytes(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"foo", true},
+		{"été", true},
+		{"a\u200cb", true},
+		{"a\u200c", false},
+		{"1a", false},
+		{"a\xff", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := IsIdentBytes([]byte(c.s)); got != c.expected {
+			t.Errorf("IsIdentBytes(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+// Every string of up to four runes drawn from these, which cover each
+// identifier class, multibyte encodings and the join controls.
+var crossCheckRunes = []rune{'a', '_', '1', '$', ' ', 'é', 0x0301, 0x094d, '日', 0x1d538, ZWNJ, ZWJ}
+
+func crossCheckInputs(n int) []string {
+	inputs := []string{""}
+	prev := inputs
+	for range n {
+		var next []string
+		for _, s := range prev {
+			for _, r := range crossCheckRunes {
+				next = append(next, s+string(r))
+			}
+		}
+		inputs = append(inputs, next...)
+		prev = next
+	}
+	return inputs
+}
+
+func TestIdentVariantsAgree(t *testing.T) {
+	for _, s := range crossCheckInputs(4) {
+		expected := IsIdent([]rune(s))
+		if got := IsIdentString(s); got != expected {
+			t.Fatalf("IsIdentString(%q) = %v, but IsIdent returned %v", s, got, expected)
+		}
+		if got := IsIdentBytes([]byte(s)); got != expected {
+			t.Fatalf("IsIdentBytes(%q) = %v, but IsIdent returned %v", s, got, expected)
+		}
+	}
+}
+
+func TestIdentVariantsRejectInvalidUTF8(t *testing.T) {
+	// A stray continuation byte, an invalid byte, a truncated rune and an
+	// encoded surrogate.
+	invalid := []string{"\x80", "\xff", "\xe6\x97", "\xed\xa0\x80"}
+	for _, s := range crossCheckInputs(3) {
+		for i := 0; i <= len(s); i++ {
+			if i < len(s) && !utf8.RuneStart(s[i]) {
+				continue
+			}
+			for _, bad := range invalid {
+				in := s[:i] + bad + s[i:]
+				if IsIdentString(in) {
+					t.Fatalf("IsIdentString accepted invalid UTF-8 %q", in)
+				}
+				if IsIdentBytes([]byte(in)) {
+					t.Fatalf("IsIdentBytes accepted invalid UTF-8 %q", in)
+				}
+			}
+		}
+	}
+}
+
+func TestIsIdentBytesRejectsInvalidUTF8(t *testing.T) {
+	for _, s := range []string{"a\xffb", "a\x80", "\xc3", "ab\xe6\x97", "a\xed\xa0\x80b", "a\xc0\xafb"} {
+		if IsIdentBytes([]byte(s)) {
+			t.Errorf("IsIdentBytes accepted invalid UTF-8 %q", s)
+		}
+		if end := ValidIdentBytesAt([]byte(s), 0); end > 0 && !utf8.ValidString(s[:end]) {
+			t.Errorf("ValidIdentBytesAt(%q, 0) included invalid UTF-8", s)
+		}
+	}
+
+	// An encoded U+FFFD is rejected just like the invalid UTF-8 it stands for.
+	if IsIdentBytes([]byte("a\ufffdb")) {
+		t.Errorf("IsIdentBytes accepted U+FFFD")
+	}
+}
+
+func TestIsIdentBytesPolicy(t *testing.T) {
+	cases := []struct {
+		b       string
+		reject  bool
+		skip    bool
+		replace bool
+	}{
+		{"abc", true, true, true},
+		{"ab\xffcd", false, true, false},
+		{"\xffab", false, true, false},
+		{"ab\xe2\x80", false, true, false},
+		{"a\xff\u200d", false, false, false},
+		{"\xff", false, false, false},
+		{"1\xffa", false, false, false},
+		{"ab\ufffd", false, false, false},
+	}
+	for _, c := range cases {
+		policies := []struct {
+			name     string
+			policy   DecodeErrorPolicy
+			expected bool
+		}{
+			{"DecodeReject", DecodeReject, c.reject},
+			{"DecodeSkipByte", DecodeSkipByte, c.skip},
+			{"DecodeReplaceRune", DecodeReplaceRune, c.replace},
+		}
+		for _, p := range policies {
+			if got := IsIdentBytesPolicy([]byte(c.b), p.policy); got != p.expected {
+				t.Errorf("IsIdentBytesPolicy(%q, %s) = %v, expected %v", c.b, p.name, got, p.expected)
+			}
+		}
+		if got := IsIdentBytes([]byte(c.b)); got != c.reject {
+			t.Errorf("IsIdentBytes(%q) = %v, but DecodeReject expected %v", c.b, got, c.reject)
+		}
+	}
+}
+
+func TestIsIdentByteReader(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"\u0438\u0434\u0435\u043d\u0442", true},
+		{"\u5909\u6570\U0001d7d8", true},
+		{"a\u200cb", true},
+		{"a\u200d", false},
+		{"1a", false},
+		{"", false},
+		{"ab\xe6\x97", false},
+		{"a\xffb", false},
+		{strings.Repeat("\u00e9", 3000), true},
+		{strings.Repeat("\u00e9", 3000) + "\xc3", false},
+	}
+	// Reading a byte at a time splits every multibyte rune between reads.
+	readers := []struct {
+		name string
+		wrap func(io.Reader) io.Reader
+	}{
+		{"whole", func(r io.Reader) io.Reader { return r }},
+		{"one byte", iotest.OneByteReader},
+		{"data with EOF", iotest.DataErrReader},
+	}
+	for _, c := range cases {
+		for _, r := range readers {
+			ok, err := IsIdentByteReader(r.wrap(strings.NewReader(c.s)))
+			if err != nil || ok != c.expected {
+				t.Errorf("%s: IsIdentByteReader(%.20q) = (%v, %v), expected %v", r.name, c.s, ok, err, c.expected)
+			}
+		}
+	}
+
+	for _, s := range crossCheckInputs(3) {
+		ok, err := IsIdentByteReader(iotest.OneByteReader(strings.NewReader(s)))
+		if err != nil || ok != IsIdentString(s) {
+			t.Fatalf("IsIdentByteReader(%q) = (%v, %v), but IsIdentString returned %v", s, ok, err, !ok)
+		}
+	}
+
+	readErr := errors.New("read failed")
+	if ok, err := IsIdentByteReader(iotest.ErrReader(readErr)); ok || !errors.Is(err, readErr) {
+		t.Errorf("IsIdentByteReader = (%v, %v), expected the reader's error", ok, err)
+	}
+}
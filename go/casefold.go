@@ -0,0 +1,36 @@
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+import "strings"
+
+// Returns s with full Unicode case folding applied, as given by the C and F
+// mappings of CaseFolding.txt, so that strings differing only in case, like
+// "Straße" and "STRASSE", fold to the same string.
+func foldCase(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, c := range s {
+		folded, ok := lookupMapping(foldKeys[:], foldOffsets[:], foldRunes[:], c)
+		if !ok {
+			b.WriteRune(c)
+			continue
+		}
+		for _, r := range folded {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Returns s with full Unicode case folding applied, and whether s is a
+// unicode identifier, as defined by IsIdent. Identifiers which only differ
+// in case, such as "Foo" and "FOO", have the same canonical case, which
+// makes it the key for a case-insensitive symbol table. If s isn't an
+// identifier, "" and false are returned.
+//
+// The case folding tables are only compiled in with the identnorm build tag.
+func CanonicalCase(s string) (string, bool) {
+	key, err := Profile{CaseInsensitive: true}.Key(s)
+	return key, err == nil
+}
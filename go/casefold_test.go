@@ -0,0 +1,28 @@
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestCanonicalCase(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected string
+		ok       bool
+	}{
+		{"Foo", "foo", true},
+		{"FOO", "foo", true},
+		{"foo", "foo", true},
+		{"straße", "strasse", true},
+		{"STRASSE", "strasse", true},
+		{"ΣΊΣΥΦΟΣ", "σίσυφοσ", true},
+		{"1foo", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		got, ok := CanonicalCase(c.s)
+		if got != c.expected || ok != c.ok {
+			t.Errorf("CanonicalCase(%q) = (%q, %v), expected (%q, %v)", c.s, got, ok, c.expected, c.ok)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package unicode_id_trie_rle
+
+import "context"
+
+// Classifies each rune received from runes, sending its IdentifierClass on
+// the returned channel in the same order. The returned channel is closed once
+// runes is closed and drained, or as soon as ctx is cancelled.
+func ClassifyChan(ctx context.Context, runes <-chan rune) <-chan IdentifierClass {
+	out := make(chan IdentifierClass)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case c, ok := <-runes:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- UnicodeIdentifierClass(c):
+				}
+			}
+		}
+	}()
+	return out
+}
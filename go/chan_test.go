@@ -0,0 +1,51 @@
+package unicode_id_trie_rle
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestClassifyChan(t *testing.T) {
+	input := []rune{'a', '1', '-', 'é', ZWJ}
+	runes := make(chan rune)
+	go func() {
+		defer close(runes)
+		for _, c := range input {
+			runes <- c
+		}
+	}()
+
+	var got []IdentifierClass
+	for class := range ClassifyChan(context.Background(), runes) {
+		got = append(got, class)
+	}
+
+	expected := []IdentifierClass{Start | Continue, Continue, Other, Start | Continue, Continue}
+	if !slices.Equal(got, expected) {
+		t.Fatalf("ClassifyChan sent %v, expected %v", got, expected)
+	}
+}
+
+func TestClassifyChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	runes := make(chan rune)
+	out := ClassifyChan(ctx, runes)
+
+	runes <- 'a'
+	if class := <-out; class != Start|Continue {
+		t.Fatalf("ClassifyChan sent %d, expected %d", class, Start|Continue)
+	}
+
+	// runes is never closed, so only the cancellation can stop it.
+	cancel()
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatalf("ClassifyChan sent a class after cancellation")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("ClassifyChan didn't stop after cancellation")
+	}
+}
@@ -0,0 +1,34 @@
+package unicode_id_trie_rle
+
+import "unicode/utf8"
+
+// An IdentChecker validates identifiers under a profile, reusing its buffers
+// from one call to the next so that validating many identifiers doesn't
+// allocate once the buffers have grown to fit.
+//
+// An IdentChecker is not safe for concurrent use. Use one per goroutine.
+type IdentChecker struct {
+	profile Profile
+	runes   []rune
+}
+
+// Returns an IdentChecker which validates identifiers under the profile p.
+func NewIdentChecker(p Profile) *IdentChecker {
+	return &IdentChecker{profile: p}
+}
+
+// Checks if s, once converted to the profile's normalization form, is a
+// unicode identifier under the profile. Unlike Profile.IsIdentString, which
+// takes s as written, this matches languages such as Python which normalize
+// identifiers before validating them. Invalid UTF-8 is never part of an
+// identifier.
+func (c *IdentChecker) Valid(s string) bool {
+	if !utf8.ValidString(s) {
+		return false
+	}
+
+	// The runes are normalized in place in c.runes, so once it has grown
+	// to fit, normalizing doesn't allocate either.
+	c.runes = c.profile.Normalization.appendRunes(c.runes[:0], s)
+	return c.profile.IsIdent(c.runes)
+}
@@ -0,0 +1,68 @@
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestIdentChecker(t *testing.T) {
+	requireNormalization(t)
+	cases := []struct {
+		p        Profile
+		s        string
+		expected bool
+	}{
+		{Profile{}, "foo", true},
+		{Profile{}, "1foo", false},
+		{Profile{}, "", false},
+		{Profile{}, "a\xff", false},
+		{Python3, "_private", true},
+		// U+2460 CIRCLED DIGIT ONE is NFKC normalized to '1'.
+		{Python3, "a①", true},
+		{Profile{}, "a①", false},
+		{Python3, "①a", false},
+	}
+	for _, c := range cases {
+		checker := NewIdentChecker(c.p)
+		// The second call reuses the buffers of the first.
+		for range 2 {
+			if got := checker.Valid(c.s); got != c.expected {
+				t.Errorf("Valid(%q) = %v, expected %v", c.s, got, c.expected)
+			}
+		}
+	}
+}
+
+var checkerIdents = []string{
+	"snake_case_name_42",
+	"camelCaseIdentifier",
+	"идентификатор",
+	"変数名",
+	"café",
+	"not an identifier",
+}
+
+func TestIdentCheckerDoesNotAllocate(t *testing.T) {
+	requireNormalization(t)
+	checker := NewIdentChecker(Python3)
+	for _, s := range checkerIdents {
+		checker.Valid(s)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, s := range checkerIdents {
+			checker.Valid(s)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Valid allocated %v times per run, expected 0", allocs)
+	}
+}
+
+func BenchmarkIdentChecker(b *testing.B) {
+	requireNormalization(b)
+	checker := NewIdentChecker(Python3)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, s := range checkerIdents {
+			checker.Valid(s)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package unicode_id_trie_rle
+
+import (
+	"fmt"
+	"sync"
+	"unicode"
+)
+
+// A Classifier determines identifier classes like UnicodeIdentifierClass,
+// but with its own table for the ASCII range, so that the rules for the most
+// common characters can be tweaked without regenerating any data, and
+// optionally with tables for another Unicode version, loaded from a blob by
+// NewClassifierFromBlob.
+type Classifier struct {
+	ascii [startCodepoint]IdentifierClass
+
+	// The tables for the rest of the codepoints, or nil for the
+	// compiled-in ones.
+	tables *trieTables
+}
+
+// Returns a copy of the table UnicodeIdentifierClass uses for ASCII, for use
+// as a starting point with NewClassifierWithASCII.
+func ASCIITable() [startCodepoint]IdentifierClass {
+	return asciiTable
+}
+
+// Returns a bitmap of the ASCII bytes which can begin an identifier, in
+// which byte c is a member if bit c%8 of the bitmap's byte c/8 is set. This
+// lets a lexer test a byte with a shift and an AND.
+func ASCIIStartBitmap() [16]byte {
+	return asciiBitmap(Start)
+}
+
+// Returns a bitmap of the ASCII bytes which can continue an identifier,
+// laid out like ASCIIStartBitmap.
+func ASCIIContinueBitmap() [16]byte {
+	return asciiBitmap(Continue)
+}
+
+func asciiBitmap(class IdentifierClass) [16]byte {
+	var bitmap [16]byte
+	for c, v := range asciiTable {
+		if v&class != 0 {
+			bitmap[c/8] |= 1 << (c % 8)
+		}
+	}
+	return bitmap
+}
+
+// Returns a Classifier which uses ascii to classify U+0000..U+007F, and the
+// generated data for every other codepoint.
+func NewClassifierWithASCII(ascii [startCodepoint]IdentifierClass) *Classifier {
+	return &Classifier{ascii: ascii}
+}
+
+// Returns a Classifier which uses the tables in blob, as written by the
+// generator's -blob flag, instead of the compiled-in ones, and ASCIITable
+// for U+0000..U+007F. Generating a blob from each version of the Unicode
+// data lets one program classify identifiers under several versions; see
+// RegisterVersion. An error is returned if blob is malformed.
+func NewClassifierFromBlob(blob []byte) (*Classifier, error) {
+	t, err := decodeTrieTables(string(blob))
+	if err != nil {
+		return nil, fmt.Errorf("unicode_id_trie_rle: invalid tables blob: %w", err)
+	}
+	return &Classifier{ascii: asciiTable, tables: t}, nil
+}
+
+// Returns the version of the Unicode Character Database c's tables were
+// generated from, which is UnicodeVersion unless c was loaded from a blob.
+func (c *Classifier) UnicodeVersion() string {
+	if c.tables != nil {
+		return c.tables.version
+	}
+	return UnicodeVersion
+}
+
+// Returns the tables c reads outside of ASCII.
+func (c *Classifier) source() tableSource {
+	if c.tables != nil {
+		return c.tables
+	}
+	return compiledTables{}
+}
+
+// Returns the identifier class of cp under the classifier c.
+func (c *Classifier) Class(cp rune) IdentifierClass {
+	src := c.source()
+	if lo, hi := src.codepointRange(); cp < lo || cp > hi {
+		return Other
+	}
+	if cp < startCodepoint {
+		return c.ascii[cp]
+	}
+	return sourceClass(src, cp) & (Start | Continue)
+}
+
+// Checks if a codepoint array is a unicode identifier under the classifier c,
+// following the same rules as IsIdent.
+func (c *Classifier) IsIdent(s []rune) bool {
+	if len(s) == 0 || c.Class(s[0])&Start == 0 {
+		return false
+	}
+
+	last := len(s) - 1
+	for i := 1; i <= last; i++ {
+		if IsJoinControl(s[i]) {
+			if i == last {
+				return false
+			}
+			continue
+		}
+		if c.Class(s[i])&Continue == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	versionsMu sync.RWMutex
+	versions   = map[string]*Classifier{
+		UnicodeVersion: NewClassifierWithASCII(asciiTable),
+	}
+)
+
+// Registers c as the Classifier for the Unicode version v, such as
+// "16.0.0", which is normally c.UnicodeVersion(). With Classifiers loaded
+// from blobs by NewClassifierFromBlob, this lets a program validate each
+// request against the Unicode version its client expects, through
+// ClassifierForVersion. The compiled-in tables are registered from the
+// start, under UnicodeVersion.
+//
+// RegisterVersion panics if a Classifier is already registered for v, so
+// that one version's tables can't silently replace another's, like
+// database/sql.Register does for drivers.
+func RegisterVersion(v string, c *Classifier) {
+	versionsMu.Lock()
+	defer versionsMu.Unlock()
+	if c == nil {
+		panic("unicode_id_trie_rle: RegisterVersion Classifier is nil")
+	}
+	if _, dup := versions[v]; dup {
+		panic("unicode_id_trie_rle: RegisterVersion called twice for version " + v)
+	}
+	versions[v] = c
+}
+
+// Returns the Classifier registered for the Unicode version v, such as
+// "17.0.0", or false if there's none.
+func ClassifierForVersion(v string) (*Classifier, bool) {
+	versionsMu.RLock()
+	defer versionsMu.RUnlock()
+	c, ok := versions[v]
+	return c, ok
+}
+
+// A range of codepoints, Lo..Hi inclusive, which two Classifiers classify
+// differently, as reported by DiffClassifiers. A and B are the classes given
+// to each codepoint in the range by the first and second Classifier.
+type ClassDiff struct {
+	Lo, Hi rune
+	A, B   IdentifierClass
+}
+
+// Returns the ranges of codepoints which a and b classify differently, in
+// order, so that a service can report what upgrading from one Unicode version
+// to another would change. Adjacent codepoints are only merged into one range
+// if both classifiers agree on each's class.
+//
+// Whole runs of each classifier's tables are compared at once, so the
+// codepoints both classify alike are skipped a run at a time.
+func DiffClassifiers(a, b *Classifier) []ClassDiff {
+	var diffs []ClassDiff
+	for cp := rune(0); cp <= unicode.MaxRune; {
+		classA, lastA := a.classRun(cp)
+		classB, lastB := b.classRun(cp)
+		last := min(lastA, lastB)
+		if classA != classB {
+			n := len(diffs)
+			if n > 0 && diffs[n-1].Hi == cp-1 && diffs[n-1].A == classA && diffs[n-1].B == classB {
+				diffs[n-1].Hi = last
+			} else {
+				diffs = append(diffs, ClassDiff{Lo: cp, Hi: last, A: classA, B: classB})
+			}
+		}
+		cp = last + 1
+	}
+	return diffs
+}
+
+// Returns the class of cp under c, along with the last codepoint of the run
+// beginning at cp which shares it, like classRun.
+func (c *Classifier) classRun(cp rune) (IdentifierClass, rune) {
+	src := c.source()
+	lo, hi := src.codepointRange()
+	switch {
+	case cp < lo:
+		return Other, lo - 1
+	case cp > hi:
+		return Other, unicode.MaxRune
+	case cp < startCodepoint:
+		return c.ascii[cp], cp
+	}
+	return sourceClassRun(src, cp)
+}
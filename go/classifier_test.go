@@ -0,0 +1,249 @@
+package unicode_id_trie_rle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewClassifierWithASCII(t *testing.T) {
+	ascii := ASCIITable()
+	ascii['_'] = Other
+	ascii['-'] = Continue
+	c := NewClassifierWithASCII(ascii)
+
+	if class := c.Class('_'); class != Other {
+		t.Errorf("Class('_') = %d, expected %d", class, Other)
+	}
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"a_b", false},
+		{"_", false},
+		{"a-b", true},
+		{"abc", true},
+		{"été", true},
+		{"été_", false},
+		{"日本-語", true},
+		{"1abc", false},
+	}
+	for _, tc := range cases {
+		if got := c.IsIdent([]rune(tc.s)); got != tc.expected {
+			t.Errorf("IsIdent(%q) = %v, expected %v", tc.s, got, tc.expected)
+		}
+	}
+
+	for cp := rune(startCodepoint); cp <= 0x10ffff; cp++ {
+		if c.Class(cp) != UnicodeIdentifierClass(cp) {
+			t.Fatalf("Class(U+%04X) differs from UnicodeIdentifierClass", cp)
+		}
+	}
+}
+
+func TestNewClassifierFromBlob(t *testing.T) {
+	blob, err := os.ReadFile("ident_blob_generated.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClassifierFromBlob(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := c.UnicodeVersion(); v != UnicodeVersion {
+		t.Errorf("blob is for Unicode %s, expected %s", v, UnicodeVersion)
+	}
+	for cp := rune(-1); cp <= 0x110000; cp++ {
+		if got, expected := c.Class(cp), UnicodeIdentifierClass(cp); got != expected {
+			t.Fatalf("Class(U+%04X) = %d, expected %d", cp, got, expected)
+		}
+	}
+}
+
+// A blob which is cut short or has any byte changed must either be rejected
+// or load tables which can be looked up without panicking.
+func TestNewClassifierFromBlobCorrupt(t *testing.T) {
+	blob, err := os.ReadFile("ident_blob_generated.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := range len(blob) {
+		if _, err := NewClassifierFromBlob(blob[:n]); err == nil {
+			t.Fatalf("a blob truncated to %d bytes was accepted", n)
+		}
+	}
+	if _, err := NewClassifierFromBlob(append(slices.Clone(blob), 0)); err == nil {
+		t.Fatal("a blob with trailing data was accepted")
+	}
+
+	for i := range blob {
+		corrupt := slices.Clone(blob)
+		corrupt[i] ^= 0xa5
+		c, err := NewClassifierFromBlob(corrupt)
+		if err != nil {
+			continue
+		}
+		for cp := rune(startCodepoint); cp <= 0x10ffff; cp += 61 {
+			c.Class(cp)
+		}
+	}
+}
+
+func TestClassifierForVersion(t *testing.T) {
+	c, ok := ClassifierForVersion(UnicodeVersion)
+	if !ok {
+		t.Fatalf("no Classifier is registered for %s", UnicodeVersion)
+	}
+	if diffs := DiffClassifiers(c, NewClassifierWithASCII(ASCIITable())); len(diffs) != 0 {
+		t.Errorf("the Classifier registered for %s differs from the compiled-in tables: %v", UnicodeVersion, diffs)
+	}
+
+	// Relabel the current tables as another version, keeping the
+	// version's length so that the rest of the blob is unchanged.
+	blob, err := os.ReadFile("ident_blob_generated.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	version := strings.Repeat("9", len(UnicodeVersion))
+	i := bytes.Index(blob, []byte(UnicodeVersion))
+	copy(blob[i:], version)
+	relabeled, err := NewClassifierFromBlob(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registerVersion(t, version, relabeled)
+	if got, ok := ClassifierForVersion(version); !ok || got != relabeled {
+		t.Errorf("ClassifierForVersion(%q) = (%p, %v), expected (%p, true)", version, got, ok, relabeled)
+	}
+	if got, _ := ClassifierForVersion(UnicodeVersion); got != c {
+		t.Errorf("registering %s replaced the Classifier for %s", version, UnicodeVersion)
+	}
+
+	// A second Classifier for a version which is already registered is
+	// refused, so the first one stays.
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("RegisterVersion(%q) didn't panic for a registered version", UnicodeVersion)
+			}
+		}()
+		RegisterVersion(UnicodeVersion, relabeled)
+	}()
+	if got, _ := ClassifierForVersion(UnicodeVersion); got != c {
+		t.Errorf("registering a second Classifier for %s replaced the first", UnicodeVersion)
+	}
+
+	if _, ok := ClassifierForVersion("test-3.0"); ok {
+		t.Errorf("ClassifierForVersion found an unregistered version")
+	}
+}
+
+// Registers c under v for the rest of the test.
+func registerVersion(t *testing.T, v string, c *Classifier) {
+	t.Helper()
+	RegisterVersion(v, c)
+	t.Cleanup(func() {
+		versionsMu.Lock()
+		defer versionsMu.Unlock()
+		delete(versions, v)
+	})
+}
+
+func TestASCIIBitmaps(t *testing.T) {
+	start := ASCIIStartBitmap()
+	cont := ASCIIContinueBitmap()
+	for c := 0; c < startCodepoint; c++ {
+		var class IdentifierClass
+		if start[c>>3]&(1<<(c&7)) != 0 {
+			class |= Start
+		}
+		if cont[c>>3]&(1<<(c&7)) != 0 {
+			class |= Continue
+		}
+		if class != asciiTable[c] {
+			t.Errorf("bitmaps give %q class %d, expected %d", rune(c), class, asciiTable[c])
+		}
+	}
+}
+
+func TestDiffClassifiers(t *testing.T) {
+	full := NewClassifierWithASCII(ASCIITable())
+	if diffs := DiffClassifiers(full, full); len(diffs) != 0 {
+		t.Errorf("a classifier differs from itself: %v", diffs)
+	}
+
+	overlaid := ASCIITable()
+	for c := '0'; c <= '9'; c++ {
+		overlaid[c] = Start | Continue
+	}
+	overlaid['$'] = Continue
+	other := NewClassifierWithASCII(overlaid)
+
+	expected := []ClassDiff{
+		{Lo: '$', Hi: '$', A: Other, B: Continue},
+		{Lo: '0', Hi: '9', A: Continue, B: Start | Continue},
+	}
+	if got := DiffClassifiers(full, other); !slices.Equal(got, expected) {
+		t.Errorf("DiffClassifiers returned %v, expected %v", got, expected)
+	}
+}
+
+// Diffs two classifiers a codepoint at a time, for checking DiffClassifiers.
+func diffByCodepoint(a, b *Classifier) []ClassDiff {
+	var diffs []ClassDiff
+	for cp := rune(0); cp <= 0x10ffff; cp++ {
+		classA, classB := a.Class(cp), b.Class(cp)
+		if classA == classB {
+			continue
+		}
+		if n := len(diffs); n > 0 && diffs[n-1].Hi == cp-1 && diffs[n-1].A == classA && diffs[n-1].B == classB {
+			diffs[n-1].Hi = cp
+			continue
+		}
+		diffs = append(diffs, ClassDiff{Lo: cp, Hi: cp, A: classA, B: classB})
+	}
+	return diffs
+}
+
+// Loads the embedded blob with its header changed to cover only lo..hi.
+func narrowedClassifier(t *testing.T, lo, hi rune) *Classifier {
+	t.Helper()
+	blob, err := os.ReadFile("ident_blob_generated.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := len(blobMagic) + 2 + len(UnicodeVersion)
+	binary.LittleEndian.PutUint32(blob[i:], uint32(lo))
+	binary.LittleEndian.PutUint32(blob[i+4:], uint32(hi))
+	c, err := NewClassifierFromBlob(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestDiffClassifiersMatchesCodepoints(t *testing.T) {
+	overlaid := ASCIITable()
+	overlaid['$'] = Start | Continue
+	classifiers := []*Classifier{
+		NewClassifierWithASCII(ASCIITable()),
+		NewClassifierWithASCII(overlaid),
+		narrowedClassifier(t, 0, 0xffff),
+		narrowedClassifier(t, 0x41, 0x2fff),
+		narrowedClassifier(t, 0x3a9, maxCodepoint),
+	}
+	for i, a := range classifiers {
+		for j, b := range classifiers {
+			got, expected := DiffClassifiers(a, b), diffByCodepoint(a, b)
+			if !slices.Equal(got, expected) {
+				t.Errorf("DiffClassifiers(%d, %d) returned %v, expected %v", i, j, got, expected)
+			}
+			if (i == j) != (len(got) == 0) {
+				t.Errorf("DiffClassifiers(%d, %d) returned %d ranges", i, j, len(got))
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package unicode_id_trie_rle
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Returned, possibly wrapped, when ParseCodepoint is given a string which
+// doesn't name a Unicode scalar value.
+var ErrInvalidCodepoint = errors.New("invalid codepoint")
+
+// Returns the rune named by s, which may be written as "U+00E9", "0x00E9",
+// the decimal "233", or the literal rune "é" itself. A string of digits is
+// always read as decimal, so the digit '7' must be written as "U+0037".
+// Surrogates and anything beyond U+10FFFF aren't scalar values and are
+// refused.
+func ParseCodepoint(s string) (rune, error) {
+	digits, base := s, 10
+	switch {
+	case strings.HasPrefix(s, "U+"), strings.HasPrefix(s, "u+"),
+		strings.HasPrefix(s, "0x"), strings.HasPrefix(s, "0X"):
+		digits, base = s[2:], 16
+	case !isDecimal(s):
+		c, size := utf8.DecodeRuneInString(s)
+		if size == 0 || size != len(s) || (c == utf8.RuneError && size == 1) {
+			return 0, fmt.Errorf("%w %q", ErrInvalidCodepoint, s)
+		}
+		return c, nil
+	}
+
+	n, err := strconv.ParseUint(digits, base, 32)
+	if err != nil || !utf8.ValidRune(rune(n)) {
+		return 0, fmt.Errorf("%w %q", ErrInvalidCodepoint, s)
+	}
+	return rune(n), nil
+}
+
+func isDecimal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the class of the rune named by s, in any of the forms
+// ParseCodepoint accepts, for tools which read codepoints from user input.
+func ClassifyName(s string) (IdentifierClass, error) {
+	c, err := ParseCodepoint(s)
+	if err != nil {
+		return Other, err
+	}
+	return UnicodeIdentifierClass(c), nil
+}
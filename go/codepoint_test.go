@@ -0,0 +1,57 @@
+package unicode_id_trie_rle
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseCodepoint(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected rune
+	}{
+		{"U+00E9", 0xe9},
+		{"u+00e9", 0xe9},
+		{"U+10FFFF", 0x10ffff},
+		{"0x00E9", 0xe9},
+		{"0Xe9", 0xe9},
+		{"233", 0xe9},
+		{"0", 0},
+		{"\u00e9", 0xe9},
+		{"a", 'a'},
+		{"\U0001f600", 0x1f600},
+	}
+	for _, c := range cases {
+		got, err := ParseCodepoint(c.s)
+		if err != nil || got != c.expected {
+			t.Errorf("ParseCodepoint(%q) = (%U, %v), expected %U", c.s, got, err, c.expected)
+		}
+	}
+
+	for _, s := range []string{"", "U+", "U+00G9", "0x", "U+D800", "U+110000", "1114112", "-1", "+233", "ab", "\xff", "e\u0301", "U+ E9"} {
+		if got, err := ParseCodepoint(s); !errors.Is(err, ErrInvalidCodepoint) {
+			t.Errorf("ParseCodepoint(%q) = (%U, %v), expected ErrInvalidCodepoint", s, got, err)
+		}
+	}
+}
+
+func TestClassifyName(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected IdentifierClass
+	}{
+		{"U+0041", Start | Continue},
+		{"U+0037", Continue},
+		{"0x0301", Continue},
+		{"32", Other},
+		{"\u00e9", Start | Continue},
+	}
+	for _, c := range cases {
+		if got, err := ClassifyName(c.s); err != nil || got != c.expected {
+			t.Errorf("ClassifyName(%q) = (%d, %v), expected %d", c.s, got, err, c.expected)
+		}
+	}
+	if _, err := ClassifyName("U+XYZ"); !errors.Is(err, ErrInvalidCodepoint) {
+		t.Errorf("ClassifyName accepted U+XYZ: %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+package unicode_id_trie_rle
+
+import "unicode"
+
+// Returns the `Canonical_Combining_Class` of cp, generated from
+// DerivedCombiningClass.txt.
+func combiningClass(cp rune) uint8 {
+	return runValue(combiningClassRunStarts[:], combiningClassRunValues[:], cp)
+}
+
+// Checks if s contains a combining sequence which UTS #39 considers
+// suspicious: a combining mark at the very start, with no base to attach to,
+// or a mark directly preceded by another mark of the same non-zero
+// `Canonical_Combining_Class`, such as two stacked acute accents. Both are
+// common in spoofed or garbled identifiers, though IsIdent accepts the
+// latter.
+func HasDisallowedCombiningSequence(s []rune) bool {
+	if len(s) > 0 && unicode.Is(unicode.M, s[0]) {
+		return true
+	}
+
+	for i := 1; i < len(s); i++ {
+		ccc := combiningClass(s[i])
+		if ccc != 0 && ccc == combiningClass(s[i-1]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestHasDisallowedCombiningSequence(t *testing.T) {
+	cases := []struct {
+		s        []rune
+		expected bool
+		comment  string
+	}{
+		{[]rune{'e', 0x0301}, false, "accented letter"},
+		{[]rune{'e', 0x0301, 0x0301}, true, "stacked acute accents"},
+		{[]rune{'e', 0x0301, 0x0300}, true, "stacked acute and grave accents"},
+		{[]rune{'e', 0x0301, 0x0323}, false, "accents above and below"},
+		{[]rune{0x0301, 'e'}, true, "leading accent"},
+		{[]rune{0x0903, 'a'}, true, "leading spacing mark"},
+		{[]rune{0x0915, 0x094d, 0x0937}, false, "virama"},
+		{[]rune("plain"), false, "ascii"},
+		{nil, false, "empty"},
+	}
+	for _, c := range cases {
+		if got := HasDisallowedCombiningSequence(c.s); got != c.expected {
+			t.Errorf("%s: returned %v, expected %v", c.comment, got, c.expected)
+		}
+	}
+}
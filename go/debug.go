@@ -0,0 +1,24 @@
+//go:build identdebug
+
+package unicode_id_trie_rle
+
+// Returns the intermediate indices UnicodeIdentifierClass computes while
+// resolving cp through the two-level tables: the block containing cp, the
+// block's position in level1Table (top) and in its level 2 table (bottom),
+// the level 2 table used, and finally the leaf. All of them are -1 for
+// codepoints outside the trie, which covers U+0000..U+FFFFF.
+//
+// This is only built with the identdebug build tag, and is meant for
+// diagnosing the generated tables.
+func LookupPath(cp rune) (block, top, bottom, level2Idx, leafIdx int) {
+	if cp < 0 || cp >= blockCount<<shift {
+		return -1, -1, -1, -1, -1
+	}
+
+	block = int(cp) >> shift
+	top = block >> lowerBits
+	bottom = block & lowerMask
+	level2Idx = int(level1Table[top])
+	leafIdx = int(level2Tables[level2Idx*lowerSize+bottom])
+	return block, top, bottom, level2Idx, leafIdx
+}
@@ -0,0 +1,28 @@
+//go:build identdebug
+
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestLookupPath(t *testing.T) {
+	// U+00E9 LATIN SMALL LETTER E WITH ACUTE, U+4E00 the first CJK
+	// ideograph and U+1F600 an emoji.
+	for _, cp := range []rune{0xe9, 0x4e00, 0x1f600} {
+		block, top, bottom, _, leafIdx := LookupPath(cp)
+		if block != int(cp)>>shift || top*lowerSize+bottom != block {
+			t.Fatalf("U+%04X: inconsistent block %d, top %d, bottom %d", cp, block, top, bottom)
+		}
+		if expected := int(trieBlockLeaf(uint32(block))); leafIdx != expected {
+			t.Fatalf("U+%04X: LookupPath returned leaf %d, expected %d", cp, leafIdx, expected)
+		}
+
+		offset := uint16(uint32(cp) & blockMask)
+		if got := leafValue(loadLeaf(uint16(leafIdx)), offset); got != UnicodeIdentifierClass(cp) {
+			t.Fatalf("U+%04X: leaf %d gives class %d, expected %d", cp, leafIdx, got, UnicodeIdentifierClass(cp))
+		}
+	}
+
+	if block, _, _, _, leafIdx := LookupPath(0x100000); block != -1 || leafIdx != -1 {
+		t.Fatalf("LookupPath(U+100000) returned block %d, leaf %d", block, leafIdx)
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/aeldidi/unicode-id-trie-rle/go/generate
+
+go 1.27.0
+
+require golang.org/x/text v0.42.0
@@ -2,22 +2,30 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"maps"
 	"math/bits"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 )
 
 const (
 	maxCodepoint = 0x0fffff
+	unicodeMax   = 0x10ffff
 	startCode    = 0x80
 	shift        = 10
-	topBits      = 6
+	maxTopBits   = 6
 
 	byteValuesPerLine  = 12
 	indexValuesPerLine = 8
@@ -55,72 +63,559 @@ func parseRange(field string) (uint32, uint32, error) {
 	}
 }
 
-func buildTable(path string) ([]byte, error) {
+// Matches the first line of a UCD file which names its Unicode version.
+var versionRe = regexp.MustCompile(`^#\s*\w+-(\d+\.\d+\.\d+)\.txt`)
+
+// Returns the Unicode version named on the first line of a UCD file, such as
+// "# DerivedCoreProperties-17.0.0.txt", or "" if it doesn't name one.
+func readUnicodeVersion(path string) (string, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() {
+		return "", scanner.Err()
+	}
+	m := versionRe.FindStringSubmatch(scanner.Text())
+	if m == nil {
+		return "", nil
+	}
+	return m[1], nil
+}
+
+// Returns the Unicode version shared by the UCD files, which must all name
+// the same one, though some may name none.
+func readInputsVersion(paths []string) (string, error) {
+	version := ""
+	for _, path := range paths {
+		v, err := readUnicodeVersion(path)
+		if err != nil {
+			return "", err
+		}
+		if v == "" {
+			continue
+		}
+		if version != "" && v != version {
+			return "", fmt.Errorf("%s is for Unicode %s, but other inputs are for %s", path, v, version)
+		}
+		version = v
+	}
+	if version == "" {
+		return "", errors.New("no input names its Unicode version on its first line")
+	}
+	return version, nil
+}
+
+// The bits of the table set by each property. Lines of the input files for
+// any other property are skipped, so UCD files such as PropList.txt can be
+// given alongside DerivedCoreProperties.txt, and their properties are read
+// into the property tables by readProperties instead.
+var propertyBits = map[string]byte{
+	"XID_Start":    1,
+	"XID_Continue": 2,
+}
+
+// The bits set by each property in the table written by -full, which holds
+// the `ID_` properties in place of the `XID_` ones.
+var idPropertyBits = map[string]byte{
+	"ID_Start":    1,
+	"ID_Continue": 2,
+}
+
+// Builds a table of the identifier classes of the codepoints minCP..maxCP,
+// merging the properties found in each of the files at paths and leaving
+// every other entry zeroed. The table has size entries.
+func buildTable(paths []string, minCP, maxCP uint32, size int) ([]byte, error) {
+	return buildPropertyTable(paths, propertyBits, minCP, maxCP, size)
+}
+
+// Builds a table like buildTable does, with the bits set by props.
+func buildPropertyTable(paths []string, props map[string]byte, minCP, maxCP uint32, size int) ([]byte, error) {
+	table := make([]byte, size)
+	for _, path := range paths {
+		if err := mergeTable(table, props, path, minCP, maxCP); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return table, nil
+}
+
+func mergeTable(table []byte, props map[string]byte, path string, minCP, maxCP uint32) error {
+	name, err := ucdFileName(path)
+	if err != nil {
+		return err
+	}
+	if valueFiles[name] {
+		return nil
+	}
+
+	return readUCDRanges(path, func(start, end uint32, fields []string) error {
+		bits := props[fields[0]]
+		if bits == 0 || start > maxCP || end < minCP {
+			return nil
+		}
+		for cp := max(start, minCP); cp <= min(end, maxCP); cp++ {
+			table[cp] |= bits
+		}
+		return nil
+	})
+}
+
+// Calls f with the range and the trimmed fields after it of each line of the
+// UCD file at path, skipping comments and blank lines.
+func readUCDRanges(path string, f func(start, end uint32, fields []string) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
 	}
 	defer file.Close()
 
-	table := make([]byte, maxCodepoint+1)
 	scanner := bufio.NewScanner(file)
 	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
-	commentRe := regexp.MustCompile(`#.*`)
 	for scanner.Scan() {
-		line := commentRe.ReplaceAllString(scanner.Text(), "")
-		line = strings.TrimSpace(line)
-		if line == "" {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		parts := strings.Split(line, ";")
+		if len(parts) < 2 {
 			continue
 		}
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
 
-		parts := strings.SplitN(line, ";", 2)
-		if len(parts) != 2 {
-			continue
+		start, end, err := parseRange(parts[0])
+		if err != nil {
+			return fmt.Errorf("parse range %q: %w", parts[0], err)
 		}
+		if err := f(start, end, parts[1:]); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+var fileNameRe = regexp.MustCompile(`^#\s*([A-Za-z]+)(-\d+\.\d+\.\d+)?\.txt`)
+
+// Returns the name of the UCD file at path, such as "Scripts" for
+// Scripts.txt, which decides how its lines are read. The name is taken from
+// the first line of the file, like "# Scripts-17.0.0.txt", or from path if
+// that doesn't give it.
+func ucdFileName(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
 
-		prop := strings.TrimSpace(parts[1])
-		var bits byte
-		if strings.Contains(prop, "XID_Start") {
-			bits |= 1
+	scanner := bufio.NewScanner(file)
+	if scanner.Scan() {
+		if m := fileNameRe.FindStringSubmatch(scanner.Text()); m != nil {
+			return m[1], nil
 		}
-		if strings.Contains(prop, "XID_Continue") {
-			bits |= 2
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	name, _, _ := strings.Cut(filepath.Base(path), ".")
+	name, _, _ = strings.Cut(name, "-")
+	return name, nil
+}
+
+// The UCD files whose lines give a property's value rather than list the
+// codepoints with a binary property, like DerivedCoreProperties.txt and
+// PropList.txt do. They're read by readProperties, and skipped by
+// buildTable, so that a value which happens to share its name with a binary
+// property, like the Deprecated `Identifier_Type`, isn't taken for it.
+var valueFiles = map[string]bool{
+	"DerivedCombiningClass":  true,
+	"DerivedGeneralCategory": true,
+	"DerivedJoiningType":     true,
+	"IdentifierType":         true,
+	"PropertyValueAliases":   true,
+	"ScriptExtensions":       true,
+	"Scripts":                true,
+}
+
+// The bits of the property table. propAssigned and propCombiningMark come
+// from DerivedGeneralCategory.txt, propAllowed from IdentifierType.txt, and
+// the others from the binary properties in propTableBits.
+const (
+	propAssigned = 1 << iota
+	propDeprecated
+	propPrependedConcatenationMark
+	propDefaultIgnorable
+	propAllowed
+	propCombiningMark
+)
+
+// The names of the bits of the property table, as emitted in the generated
+// constants, in the order of their values.
+var propBitNames = []string{
+	"propAssigned",
+	"propDeprecated",
+	"propPrependedConcatenationMark",
+	"propDefaultIgnorable",
+	"propAllowed",
+	"propCombiningMark",
+}
+
+// The bits of the property table set by each binary property, read from
+// files like PropList.txt and DerivedCoreProperties.txt.
+var propTableBits = map[string]byte{
+	"Deprecated":                   propDeprecated,
+	"Prepended_Concatenation_Mark": propPrependedConcatenationMark,
+	"Default_Ignorable_Code_Point": propDefaultIgnorable,
+}
+
+// The `Joining_Type` values, as they appear in DerivedJoiningType.txt, in
+// the order of the values of the joining table. Non_Joining, the default, is
+// 0.
+var joiningTypes = []string{"U", "D", "R", "L", "C", "T"}
+
+// The names of the `Joining_Type` values, as emitted in the generated
+// constants, in the same order as joiningTypes.
+var joiningTypeNames = []string{
+	"joiningNone",
+	"joiningDual",
+	"joiningRight",
+	"joiningLeft",
+	"joiningCausing",
+	"joiningTransparent",
+}
+
+// The properties read from the inputs besides the identifier classes, each
+// as a table with an entry for every codepoint.
+type properties struct {
+	// The bits given by the propAssigned constants.
+	bits []byte
+
+	// The index in scriptNames of the `Script` of each codepoint.
+	scripts     []byte
+	scriptNames []string
+
+	// The index in scriptSets of the `Script_Extensions` of each
+	// codepoint, or 0 where that's just its `Script`. Each set holds
+	// indexes in scriptNames, in ascending order.
+	scriptExtensions []byte
+	scriptSets       [][]byte
+
+	// The index in joiningTypes of the `Joining_Type` of each codepoint.
+	joining []byte
+
+	// The `Canonical_Combining_Class` of each codepoint.
+	combiningClasses []byte
+
+	// Whether IdentifierType.txt was among the inputs, without which no
+	// codepoint has propAllowed.
+	identifierType bool
+}
+
+// Reads the properties of every codepoint from the files at paths, sending
+// each to the parser for the UCD file it is. `Script_Extensions` names
+// scripts by their short aliases, so ScriptExtensions.txt needs
+// PropertyValueAliases.txt to be among the inputs too.
+func readProperties(paths []string) (*properties, error) {
+	props := &properties{
+		bits:             make([]byte, unicodeMax+1),
+		scripts:          make([]byte, unicodeMax+1),
+		scriptNames:      []string{"Unknown"},
+		scriptExtensions: make([]byte, unicodeMax+1),
+		scriptSets:       [][]byte{nil},
+		joining:          make([]byte, unicodeMax+1),
+		combiningClasses: make([]byte, unicodeMax+1),
+	}
+	scriptIndex := map[string]byte{"Unknown": 0}
+	addScript := func(name string) (byte, error) {
+		if i, ok := scriptIndex[name]; ok {
+			return i, nil
 		}
-		if bits == 0 {
-			continue
+		if len(props.scriptNames) > 0xff {
+			return 0, errors.New("too many scripts")
+		}
+		i := byte(len(props.scriptNames))
+		props.scriptNames = append(props.scriptNames, name)
+		scriptIndex[name] = i
+		return i, nil
+	}
+	setRange := func(table []byte, start, end uint32, v byte) {
+		for cp := start; cp <= min(end, unicodeMax); cp++ {
+			table[cp] = v
 		}
+	}
 
-		start, end, err := parseRange(strings.TrimSpace(parts[0]))
+	// ScriptExtensions.txt is read last, once the aliases it needs have
+	// been read.
+	aliases := make(map[string]string)
+	var extensions []string
+	for _, path := range paths {
+		name, err := ucdFileName(path)
 		if err != nil {
-			return nil, fmt.Errorf("parse range %q: %w", parts[0], err)
+			return nil, err
+		}
+
+		switch name {
+		case "Scripts":
+			err = readUCDRanges(path, func(start, end uint32, fields []string) error {
+				i, err := addScript(fields[0])
+				setRange(props.scripts, start, end, i)
+				return err
+			})
+		case "ScriptExtensions":
+			extensions = append(extensions, path)
+		case "PropertyValueAliases":
+			err = readScriptAliases(path, aliases)
+		case "IdentifierType":
+			props.identifierType = true
+			err = readUCDRanges(path, func(start, end uint32, fields []string) error {
+				for _, t := range strings.Fields(fields[0]) {
+					if t == "Recommended" || t == "Inclusion" {
+						for cp := start; cp <= min(end, unicodeMax); cp++ {
+							props.bits[cp] |= propAllowed
+						}
+					}
+				}
+				return nil
+			})
+		case "DerivedGeneralCategory":
+			err = readUCDRanges(path, func(start, end uint32, fields []string) error {
+				if fields[0] == "Cn" {
+					return nil
+				}
+				bits := byte(propAssigned)
+				if fields[0] == "Mn" || fields[0] == "Mc" {
+					bits |= propCombiningMark
+				}
+				for cp := start; cp <= min(end, unicodeMax); cp++ {
+					props.bits[cp] |= bits
+				}
+				return nil
+			})
+		case "DerivedJoiningType":
+			err = readUCDRanges(path, func(start, end uint32, fields []string) error {
+				i := slices.Index(joiningTypes, fields[0])
+				if i < 0 {
+					return fmt.Errorf("unknown Joining_Type %q", fields[0])
+				}
+				setRange(props.joining, start, end, byte(i))
+				return nil
+			})
+		case "DerivedCombiningClass":
+			err = readUCDRanges(path, func(start, end uint32, fields []string) error {
+				ccc, err := strconv.ParseUint(fields[0], 10, 8)
+				if err != nil {
+					return fmt.Errorf("invalid Canonical_Combining_Class %q", fields[0])
+				}
+				setRange(props.combiningClasses, start, end, byte(ccc))
+				return nil
+			})
+		default:
+			err = readUCDRanges(path, func(start, end uint32, fields []string) error {
+				if bit := propTableBits[fields[0]]; bit != 0 {
+					for cp := start; cp <= min(end, unicodeMax); cp++ {
+						props.bits[cp] |= bit
+					}
+				}
+				return nil
+			})
 		}
-		if start > maxCodepoint {
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	setIndex := map[string]byte{}
+	for _, path := range extensions {
+		err := readUCDRanges(path, func(start, end uint32, fields []string) error {
+			var set []byte
+			for _, alias := range strings.Fields(fields[0]) {
+				name, ok := aliases[alias]
+				if !ok {
+					return fmt.Errorf("unknown script %q - PropertyValueAliases.txt must be among the inputs", alias)
+				}
+				i, err := addScript(name)
+				if err != nil {
+					return err
+				}
+				set = append(set, i)
+			}
+			slices.Sort(set)
+			set = slices.Compact(set)
+
+			key := string(set)
+			i, ok := setIndex[key]
+			if !ok {
+				if len(props.scriptSets) > 0xff {
+					return errors.New("too many distinct Script_Extensions")
+				}
+				i = byte(len(props.scriptSets))
+				props.scriptSets = append(props.scriptSets, set)
+				setIndex[key] = i
+			}
+			setRange(props.scriptExtensions, start, end, i)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return props, nil
+}
+
+// Reads the long names of the `Script` values, keyed by their short aliases,
+// from the "sc" lines of PropertyValueAliases.txt, such as
+// "sc ; Adlm ; Adlam".
+func readScriptAliases(path string, aliases map[string]string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	for line := range strings.Lines(string(data)) {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 || strings.TrimSpace(fields[0]) != "sc" {
 			continue
 		}
-		if end > maxCodepoint {
-			end = maxCodepoint
+		name := strings.TrimSpace(fields[2])
+		for _, alias := range fields[1:] {
+			aliases[strings.TrimSpace(alias)] = name
 		}
+	}
+	return nil
+}
 
-		for cp := start; cp <= end; cp++ {
-			table[cp] |= bits
+// Writes the property tables as runs, the first codepoint of each run of
+// codepoints sharing a value followed by that value, along with the
+// constants for their values.
+func emitProperties(w *bufio.Writer, props *properties) {
+	fmt.Fprintln(w, "// The bits of propRunValues.")
+	fmt.Fprintln(w, "const (")
+	for i, name := range propBitNames {
+		fmt.Fprintf(w, "\t%s = 0x%02x\n", name, 1<<i)
+	}
+	fmt.Fprintln(w, ")")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// Whether the tables were generated with IdentifierType.txt, without which")
+	fmt.Fprintln(w, "// no codepoint has propAllowed.")
+	fmt.Fprintf(w, "const identifierTypeData = %t\n", props.identifierType)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// The values of joiningRunValues.")
+	fmt.Fprintln(w, "const (")
+	for i, name := range joiningTypeNames {
+		fmt.Fprintf(w, "\t%s = %d\n", name, i)
+	}
+	fmt.Fprintln(w, ")")
+	fmt.Fprintln(w)
+
+	emitRuns := func(name string, table []byte) {
+		starts, values := flatRuns(table)
+		emitRuneArray(w, name+"RunStarts", starts, indexValuesPerLine)
+		emitByteArray(w, name+"RunValues", values, byteValuesPerLine)
+	}
+	emitRuns("prop", props.bits)
+
+	fmt.Fprintln(w, "var scriptNames = [...]string{")
+	for _, name := range props.scriptNames {
+		fmt.Fprintf(w, "\t%q,\n", name)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	emitRuns("script", props.scripts)
+
+	fmt.Fprintln(w, "var scriptSets = [...][]byte{")
+	for _, set := range props.scriptSets {
+		fmt.Fprint(w, "\t{")
+		for i, v := range set {
+			if i > 0 {
+				fmt.Fprint(w, ", ")
+			}
+			fmt.Fprintf(w, "%d", v)
 		}
+		fmt.Fprintln(w, "},")
 	}
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	emitRuns("scriptExtension", props.scriptExtensions)
+	emitRuns("joining", props.joining)
+	emitRuns("combiningClass", props.combiningClasses)
+}
+
+// A property given with -custom, whose ranges are read from a file and set
+// as bit in the table alongside the standard properties.
+type customProperty struct {
+	name string
+	path string
+	bit  byte
+}
+
+// Parses a -custom value of the form name=file.txt:bit. The name becomes the
+// Raw constant for the property, so it must be usable in a Go identifier,
+// and bits 0 and 1, which hold `XID_Start` and `XID_Continue`, are taken.
+func parseCustom(v string) (customProperty, error) {
+	name, rest, ok := strings.Cut(v, "=")
+	colon := strings.LastIndex(rest, ":")
+	if !ok || colon < 0 {
+		return customProperty{}, fmt.Errorf("-custom %q: expected name=file.txt:bit", v)
 	}
+	if !customNameRe.MatchString(name) {
+		return customProperty{}, fmt.Errorf("-custom %q: name %q isn't an identifier", v, name)
+	}
+	bit, err := strconv.ParseUint(rest[colon+1:], 10, 8)
+	if err != nil || bit < 2 || bit > 7 {
+		return customProperty{}, fmt.Errorf("-custom %q: bit must be between 2 and 7", v)
+	}
+	return customProperty{name: name, path: rest[:colon], bit: byte(bit)}, nil
+}
 
-	return table, nil
+var customNameRe = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Sets the bit of c for every codepoint in the ranges listed by its file,
+// one range per line in the form of the first field of a UCD file, such as
+// "0041..005A". Anything after a ';' or '#' is ignored. Codepoints below
+// U+0080 are classified by asciiTable rather than the tables, so ranges
+// reaching into ASCII are rejected.
+func mergeCustom(table []byte, c customProperty, minCP, maxCP uint32) error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return err
+	}
+
+	for line := range strings.Lines(string(data)) {
+		line, _, _ = strings.Cut(line, "#")
+		line, _, _ = strings.Cut(line, ";")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		start, end, err := parseRange(line)
+		if err != nil {
+			return fmt.Errorf("parse range %q: %w", line, err)
+		}
+		if start < startCode {
+			return fmt.Errorf("range %s covers ASCII, which custom properties can't", line)
+		}
+		if start > maxCP || end < minCP {
+			continue
+		}
+		for cp := max(start, minCP); cp <= min(end, maxCP); cp++ {
+			table[cp] |= 1 << c.bit
+		}
+	}
+	return nil
 }
 
 func buildRuns(table []byte) []run {
 	runs := make([]run, 0, 1024)
-	endCP := uint32(maxCodepoint + 1)
+	endCP := uint32(len(table))
 
 	runStart := uint32(startCode)
 	current := table[startCode]
 	for cp := uint32(startCode + 1); cp <= endCP; cp++ {
 		value := byte(0)
-		if cp <= maxCodepoint {
+		if cp < endCP {
 			value = table[cp]
 		}
 
@@ -138,6 +633,25 @@ func buildRuns(table []byte) []run {
 	return runs
 }
 
+// Merges adjacent runs with equal values, so that every range emitted from
+// runs is as long as possible. The last run marks the end of the codepoint
+// range and is always kept.
+func coalesceRuns(runs []run) []run {
+	if len(runs) < 2 {
+		return runs
+	}
+
+	out := make([]run, 0, len(runs))
+	out = append(out, runs[0])
+	for _, r := range runs[1 : len(runs)-1] {
+		if r.value == out[len(out)-1].value {
+			continue
+		}
+		out = append(out, r)
+	}
+	return append(out, runs[len(runs)-1])
+}
+
 func buildBlockIndex(runs []run, blockCount int) []int {
 	index := make([]int, blockCount)
 	runIdx := 0
@@ -177,9 +691,6 @@ func buildLeaves(runs []run, blockIndex []int, blockCount int) ([]leafRun, []uin
 	for block := 0; block < blockCount; block++ {
 		blockStart := uint32(block << shift)
 		blockEnd := uint32((block + 1) << shift)
-		if blockEnd > uint32(maxCodepoint+1) {
-			blockEnd = uint32(maxCodepoint + 1)
-		}
 
 		idx := blockIndex[block]
 		local := make([]leafRun, 0, 8)
@@ -268,6 +779,481 @@ func buildLevelTables(blockToLeaf []uint16, lowerSize, topSize int) ([]uint16, [
 	return level2Tables, level1Table
 }
 
+// Must match mphHash in ident.go.
+func mphHash(key, seed uint32) uint32 {
+	h := key*0x9e3779b1 ^ seed*0x85ebca6b
+	h ^= h >> 15
+	h *= 0x2c1b3c6d
+	h ^= h >> 12
+	return h
+}
+
+// Builds a minimal perfect hash over the blocks which don't map to the most
+// common leaf, using the hash and displace method. Blocks absent from the
+// hash map to the returned default leaf.
+func buildMPH(blockToLeaf []uint16) ([]uint16, []uint16, []uint16, uint16) {
+	counts := make(map[uint16]int)
+	for _, leaf := range blockToLeaf {
+		counts[leaf]++
+	}
+	defaultLeaf := blockToLeaf[0]
+	for leaf, count := range counts {
+		if count > counts[defaultLeaf] || (count == counts[defaultLeaf] && leaf < defaultLeaf) {
+			defaultLeaf = leaf
+		}
+	}
+
+	keys := make([]uint32, 0, len(blockToLeaf))
+	for block, leaf := range blockToLeaf {
+		if leaf != defaultLeaf {
+			keys = append(keys, uint32(block))
+		}
+	}
+
+	// The hash needs at least one slot. Block 0 can fill it, since it
+	// maps to its own leaf whether or not it's in the hash.
+	if len(keys) == 0 {
+		keys = append(keys, 0)
+	}
+
+	n := uint32(len(keys))
+	buckets := make([][]uint32, n)
+	for _, key := range keys {
+		b := mphHash(key, 0) % n
+		buckets[b] = append(buckets[b], key)
+	}
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+	seeds := make([]uint16, n)
+	slotKeys := make([]uint16, n)
+	slotLeaves := make([]uint16, n)
+	used := make([]bool, n)
+	for _, b := range order {
+		bucket := buckets[b]
+		if len(bucket) == 0 {
+			break
+		}
+
+		for seed := uint32(1); ; seed++ {
+			if seed > maxUint16Value {
+				log.Fatalf("no perfect hash seed found for bucket %d", b)
+			}
+
+			slots := make([]uint32, 0, len(bucket))
+			taken := make(map[uint32]bool, len(bucket))
+			for _, key := range bucket {
+				slot := mphHash(key, seed) % n
+				if used[slot] || taken[slot] {
+					break
+				}
+				taken[slot] = true
+				slots = append(slots, slot)
+			}
+			if len(slots) != len(bucket) {
+				continue
+			}
+
+			seeds[b] = uint16(seed)
+			for i, key := range bucket {
+				used[slots[i]] = true
+				slotKeys[slots[i]] = uint16(key)
+				slotLeaves[slots[i]] = blockToLeaf[key]
+			}
+			break
+		}
+	}
+
+	return seeds, slotKeys, slotLeaves, defaultLeaf
+}
+
+// A trie over the bytes of the keywords, flattened so that the edges leaving
+// node n are edgeBytes[nodeEdges[n]:nodeEdges[n+1]], sorted by byte.
+// nodeIDs[n] is one more than the id of the keyword ending at node n, or 0 if
+// none does.
+type keywordTrie struct {
+	nodeEdges   []uint16
+	edgeBytes   []byte
+	edgeTargets []uint16
+	nodeIDs     []uint16
+}
+
+// Reads a keyword list, one keyword per line. Blank lines and lines starting
+// with '#' are skipped, and each keyword's id is its position in the list.
+func readKeywords(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keywords []string
+	seen := make(map[string]bool)
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if seen[line] {
+			return nil, fmt.Errorf("duplicate keyword %q", line)
+		}
+		seen[line] = true
+		keywords = append(keywords, line)
+	}
+	return keywords, nil
+}
+
+func buildKeywordTrie(keywords []string) keywordTrie {
+	type node struct {
+		children map[byte]int
+		id       int
+	}
+	nodes := []node{{children: make(map[byte]int)}}
+	for id, keyword := range keywords {
+		n := 0
+		for i := 0; i < len(keyword); i++ {
+			child, ok := nodes[n].children[keyword[i]]
+			if !ok {
+				child = len(nodes)
+				nodes = append(nodes, node{children: make(map[byte]int)})
+				nodes[n].children[keyword[i]] = child
+			}
+			n = child
+		}
+		nodes[n].id = id + 1
+	}
+	if len(nodes) > maxUint16Value {
+		log.Fatalf("keyword trie too large for uint16 index: %d nodes", len(nodes))
+	}
+
+	labels := func(n int) []byte {
+		return slices.Sorted(maps.Keys(nodes[n].children))
+	}
+
+	// Nodes are numbered in breadth-first order, so that each node's
+	// edges are contiguous.
+	order := []int{0}
+	number := make([]uint16, len(nodes))
+	for i := 0; i < len(order); i++ {
+		n := order[i]
+		number[n] = uint16(i)
+		for _, b := range labels(n) {
+			order = append(order, nodes[n].children[b])
+		}
+	}
+
+	var trie keywordTrie
+	for _, n := range order {
+		trie.nodeEdges = append(trie.nodeEdges, uint16(len(trie.edgeBytes)))
+		trie.nodeIDs = append(trie.nodeIDs, uint16(nodes[n].id))
+		for _, b := range labels(n) {
+			trie.edgeBytes = append(trie.edgeBytes, b)
+			trie.edgeTargets = append(trie.edgeTargets, number[nodes[n].children[b]])
+		}
+	}
+	trie.nodeEdges = append(trie.nodeEdges, uint16(len(trie.edgeBytes)))
+	return trie
+}
+
+func emitByteArray(w *bufio.Writer, name string, data []byte, perLine int) {
+	fmt.Fprintf(w, "var %s = [...]byte{\n", name)
+	for i, v := range data {
+		if i%perLine == 0 {
+			fmt.Fprint(w, "\t")
+		}
+		fmt.Fprintf(w, "0x%02x,", v)
+		if i%perLine == perLine-1 || i+1 == len(data) {
+			fmt.Fprintln(w)
+		} else {
+			fmt.Fprint(w, " ")
+		}
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+}
+
+// The lookup emitted alongside the keyword trie.
+const lookupKeywordSource = `// Returns the id of the keyword s, which is its position in the keyword list
+// given to the generator, or false if s isn't a keyword. s is matched byte by
+// byte in a single pass, so it's meant to be called once s is known to be an
+// identifier.
+func LookupKeyword(s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		next := -1
+		for e := keywordNodeEdges[n]; e < keywordNodeEdges[n+1]; e++ {
+			if keywordEdgeBytes[e] == s[i] {
+				next = int(keywordEdgeTargets[e])
+				break
+			}
+		}
+		if next < 0 {
+			return 0, false
+		}
+		n = next
+	}
+
+	id := keywordNodeIDs[n]
+	if id == 0 {
+		return 0, false
+	}
+	return int(id) - 1, true
+}
+`
+
+// A block from Blocks.txt, covering first..last.
+type block struct {
+	first, last uint32
+	name        string
+}
+
+// Reads the blocks listed in Blocks.txt, which are sorted and don't overlap.
+func readBlocks(path string) ([]block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []block
+	for line := range strings.Lines(string(data)) {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		rangePart, name, ok := strings.Cut(line, ";")
+		if !ok {
+			return nil, fmt.Errorf("malformed line %q", line)
+		}
+		first, last, err := parseRange(strings.TrimSpace(rangePart))
+		if err != nil {
+			return nil, err
+		}
+		if len(blocks) > 0 && first <= blocks[len(blocks)-1].last {
+			return nil, fmt.Errorf("block %s overlaps or precedes the block before it", strings.TrimSpace(name))
+		}
+		blocks = append(blocks, block{first: first, last: last, name: strings.TrimSpace(name)})
+	}
+	return blocks, nil
+}
+
+// The lookup emitted alongside the block table.
+const blockNameSource = `// Returns the name of the Unicode block containing cp, such as "Basic Latin",
+// or "" if cp isn't in any block. This is meant for diagnostics, like saying
+// which block a rejected rune comes from.
+func BlockName(cp rune) string {
+	i := sort.Search(len(blocks), func(i int) bool {
+		return blocks[i].last >= cp
+	})
+	if i == len(blocks) || blocks[i].first > cp {
+		return ""
+	}
+	return blocks[i].name
+}
+`
+
+// Reconstructs the class of every codepoint from the leaves, reading them the
+// way UnicodeIdentifierClass does. The leaves don't cover ASCII, so those
+// classes are taken from table instead.
+func classesFromLeaves(table []byte, leafRuns []leafRun, leafOffsets, blockToLeaf []uint16) []byte {
+	classes := make([]byte, len(table))
+	copy(classes, table[:startCode])
+	for cp := startCode; cp < len(classes); cp++ {
+		leaf := blockToLeaf[cp>>shift]
+		runs := leafRuns[leafOffsets[leaf]:leafOffsets[leaf+1]]
+		offset := uint16(cp & (1<<shift - 1))
+		i := sort.Search(len(runs), func(i int) bool {
+			return runs[i].start > offset
+		})
+		classes[cp] = runs[max(i-1, 0)].value
+	}
+	return classes
+}
+
+// Writes classes as DerivedCoreProperties.txt lines, coalescing consecutive
+// codepoints into ranges, so that the output can be diffed against the
+// official file or given back to the generator with -i.
+func dumpProps(w io.Writer, version string, classes []byte) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# DerivedCoreProperties-%s.txt\n", version)
+	fmt.Fprintln(bw, "# Reconstructed from the generated tables by generate -dump-props.")
+	for _, prop := range []struct {
+		name string
+		bit  byte
+	}{{"XID_Start", 1}, {"XID_Continue", 2}} {
+		fmt.Fprintf(bw, "\n# Derived Property: %s\n\n", prop.name)
+		for cp := 0; cp < len(classes); cp++ {
+			if classes[cp]&prop.bit == 0 {
+				continue
+			}
+			end := cp
+			for end+1 < len(classes) && classes[end+1]&prop.bit != 0 {
+				end++
+			}
+			if end == cp {
+				fmt.Fprintf(bw, "%04X          ; %s\n", cp, prop.name)
+			} else {
+				fmt.Fprintf(bw, "%04X..%04X    ; %s\n", cp, end, prop.name)
+			}
+			cp = end
+		}
+	}
+	return bw.Flush()
+}
+
+// Returns which codepoints an IdentifierStatus.txt from UTS #39 marks as
+// Allowed, indexed by codepoint. Only Allowed codepoints are listed in the
+// file; everything else is Restricted.
+func readIdentifierStatus(path string) ([]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make([]bool, unicodeMax+1)
+	for line := range strings.Lines(string(data)) {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		rangePart, status, ok := strings.Cut(line, ";")
+		if !ok {
+			return nil, fmt.Errorf("malformed line %q", line)
+		}
+		first, last, err := parseRange(strings.TrimSpace(rangePart))
+		if err != nil {
+			return nil, err
+		}
+		if first > last || last > unicodeMax {
+			return nil, fmt.Errorf("invalid range %q", strings.TrimSpace(rangePart))
+		}
+		switch status = strings.TrimSpace(status); status {
+		case "Allowed":
+			for cp := first; cp <= last; cp++ {
+				allowed[cp] = true
+			}
+		case "Restricted":
+		default:
+			return nil, fmt.Errorf("unknown identifier status %q", status)
+		}
+	}
+	return allowed, nil
+}
+
+// Writes the codepoints whose status differs between before and after, as
+// returned by readIdentifierStatus, coalescing consecutive codepoints which
+// changed the same way into ranges, such as "0149 ; Allowed -> Restricted".
+func diffStatus(w io.Writer, before, after []bool) error {
+	bw := bufio.NewWriter(w)
+	status := map[bool]string{true: "Allowed", false: "Restricted"}
+	for cp := 0; cp < len(before); cp++ {
+		if before[cp] == after[cp] {
+			continue
+		}
+		end := cp
+		for end+1 < len(before) && before[end+1] == before[cp] && after[end+1] == after[cp] {
+			end++
+		}
+		change := status[before[cp]] + " -> " + status[after[cp]]
+		if end == cp {
+			fmt.Fprintf(bw, "%04X          ; %s\n", cp, change)
+		} else {
+			fmt.Fprintf(bw, "%04X..%04X    ; %s\n", cp, end, change)
+		}
+		cp = end
+	}
+	return bw.Flush()
+}
+
+// Writes the level tables and leaves as a Graphviz DOT graph, for
+// inspecting how they're shared. Every level1 entry pointing at the same
+// level2 table is drawn as one edge, as is every entry of a level2 table
+// pointing at the same leaf, labelled with the number of entries it stands
+// for, which keeps the graph readable for the full tables.
+func writeDot(w io.Writer, level1, level2 []uint16, lowerSize int, leafOffsets []uint16) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph trie {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+	fmt.Fprintln(bw, "\tnode [shape=box];")
+	fmt.Fprintf(bw, "\tlevel1 [label=\"level1\\n%d entries\"];\n", len(level1))
+
+	// Counts the edges from each node to each target, in the order the
+	// targets are first seen.
+	type edges struct {
+		targets []uint16
+		counts  map[uint16]int
+	}
+	count := func(e *edges, target uint16) {
+		if e.counts == nil {
+			e.counts = make(map[uint16]int)
+		}
+		if e.counts[target] == 0 {
+			e.targets = append(e.targets, target)
+		}
+		e.counts[target]++
+	}
+
+	var fromLevel1 edges
+	for _, table := range level1 {
+		count(&fromLevel1, table)
+	}
+	for _, table := range fromLevel1.targets {
+		fmt.Fprintf(bw, "\tlevel2_%d [label=\"level2 #%d\"];\n", table, table)
+		fmt.Fprintf(bw, "\tlevel1 -> level2_%d [label=\"%d\"];\n", table, fromLevel1.counts[table])
+	}
+
+	usedLeaves := make(map[uint16]bool)
+	for _, table := range fromLevel1.targets {
+		var fromTable edges
+		for _, leaf := range level2[int(table)*lowerSize : (int(table)+1)*lowerSize] {
+			count(&fromTable, leaf)
+			usedLeaves[leaf] = true
+		}
+		for _, leaf := range fromTable.targets {
+			fmt.Fprintf(bw, "\tlevel2_%d -> leaf_%d [label=\"%d\"];\n", table, leaf, fromTable.counts[leaf])
+		}
+	}
+	for _, leaf := range slices.Sorted(maps.Keys(usedLeaves)) {
+		runs := leafOffsets[leaf+1] - leafOffsets[leaf]
+		fmt.Fprintf(bw, "\tleaf_%d [label=\"leaf #%d\\n%d runs\"];\n", leaf, leaf, runs)
+	}
+	fmt.Fprintf(bw, "\tlabel=\"%d level2 tables, %d unique leaves\";\n", len(fromLevel1.targets), len(usedLeaves))
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// Encodes the runs of each leaf as a byte stream, in which each run is the
+// distance from the start of the run before it, as a uvarint, followed by its
+// value. The sentinel run ending each leaf is left out. Returns the stream
+// and the offset of each leaf's runs in it, with a final offset marking the
+// end of the last leaf, like leafOffsets.
+func buildRunStream(leafRuns []leafRun, leafOffsets []uint16) ([]byte, []uint16) {
+	var stream []byte
+	offsets := make([]uint16, 0, len(leafOffsets))
+	for leaf := 0; leaf+1 < len(leafOffsets); leaf++ {
+		offsets = append(offsets, uint16(len(stream)))
+		runs := leafRuns[leafOffsets[leaf]:leafOffsets[leaf+1]]
+		prev := uint16(0)
+		for _, r := range runs[:len(runs)-1] {
+			stream = binary.AppendUvarint(stream, uint64(r.start-prev))
+			stream = append(stream, r.value)
+			prev = r.start
+		}
+	}
+	if len(stream) > maxUint16Value {
+		log.Fatalf("run stream too large for uint16 offsets: %d bytes", len(stream))
+	}
+	offsets = append(offsets, uint16(len(stream)))
+	return stream, offsets
+}
+
 func splitLeafRuns(runs []leafRun) ([]uint16, []byte) {
 	offsets := make([]uint16, len(runs))
 	values := make([]byte, len(runs))
@@ -295,6 +1281,37 @@ func emitUint16Array(w *bufio.Writer, name string, data []uint16, perLine int) {
 	fmt.Fprintln(w)
 }
 
+func emitRuneArray(w *bufio.Writer, name string, data []uint32, perLine int) {
+	fmt.Fprintf(w, "var %s = [...]rune{\n", name)
+	for i, v := range data {
+		if i%perLine == 0 {
+			fmt.Fprint(w, "\t")
+		}
+		fmt.Fprintf(w, "0x%05x,", v)
+		if i%perLine == perLine-1 || i+1 == len(data) {
+			fmt.Fprintln(w)
+		} else {
+			fmt.Fprint(w, " ")
+		}
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+}
+
+// Returns the first codepoint of each run of codepoints sharing a value in
+// table, along with that value, starting from codepoint 0.
+func flatRuns(table []byte) ([]uint32, []byte) {
+	var starts []uint32
+	var values []byte
+	for cp, v := range table {
+		if cp == 0 || v != values[len(values)-1] {
+			starts = append(starts, uint32(cp))
+			values = append(values, v)
+		}
+	}
+	return starts, values
+}
+
 func emitClassArray(w *bufio.Writer, name string, data []byte, perLine int) {
 	fmt.Fprintf(w, "var %s = [...]IdentifierClass{\n", name)
 	for i, v := range data {
@@ -312,71 +1329,458 @@ func emitClassArray(w *bufio.Writer, name string, data []byte, perLine int) {
 	fmt.Fprintln(w)
 }
 
+// Appends the header of a blob: its magic number and format, followed by
+// the constants the generated Go file holds for the array literals, so that
+// a blob can be loaded without them. Must match decodeTrieTables.
+func appendBlobHeader(blob []byte, version string, minCP, maxCP uint32, lowerBits int, mph, varint bool, mphDefaultLeaf uint16, customBits byte) []byte {
+	if len(version) > 0xff {
+		log.Fatalf("Unicode version %q is too long for a blob", version)
+	}
+	var flags byte
+	if mph {
+		flags |= 1
+	}
+	if varint {
+		flags |= 2
+	}
+	blob = append(blob, "UIDT"...)
+	blob = append(blob, 1, byte(len(version)))
+	blob = append(blob, version...)
+	blob = binary.LittleEndian.AppendUint32(blob, minCP)
+	blob = binary.LittleEndian.AppendUint32(blob, maxCP)
+	blob = append(blob, shift, byte(lowerBits), flags)
+	blob = binary.LittleEndian.AppendUint16(blob, mphDefaultLeaf)
+	return append(blob, customBits)
+}
+
+// Appends a table to a blob as its length followed by its little-endian
+// values. Must match the decoding in trietables.go.
+func appendUint16Table(blob []byte, data []uint16) []byte {
+	blob = binary.LittleEndian.AppendUint32(blob, uint32(len(data)))
+	for _, v := range data {
+		blob = binary.LittleEndian.AppendUint16(blob, v)
+	}
+	return blob
+}
+
+func appendByteTable(blob []byte, data []byte) []byte {
+	blob = binary.LittleEndian.AppendUint32(blob, uint32(len(data)))
+	return append(blob, data...)
+}
+
+// Returns the path of the i'th of the n files -split divides count arrays
+// between, the first of which is output itself, along with the range
+// lo..hi of the arrays it holds.
+func splitFile(output string, i, n, count int) (path string, lo, hi int) {
+	path = output
+	if i > 0 {
+		path = fmt.Sprintf("%s_%d.go", strings.TrimSuffix(output, ".go"), i)
+	}
+	return path, i * count / n, (i + 1) * count / n
+}
+
+// Where the generated files go. They're normally written out, but with
+// -check they're compared against the files already on disk instead, and
+// those which differ are collected in stale.
+type outputs struct {
+	check bool
+	stale []string
+}
+
+func (o *outputs) write(path string, data []byte) {
+	if !o.check {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		o.stale = append(o.stale, err.Error())
+		return
+	}
+	if diff := describeDiff(existing, data); diff != "" {
+		o.stale = append(o.stale, fmt.Sprintf("%s: %s", path, diff))
+	}
+}
+
+// Summarizes how existing differs from generated, or returns "" if they're
+// the same.
+func describeDiff(existing, generated []byte) string {
+	if bytes.Equal(existing, generated) {
+		return ""
+	}
+
+	line := 1
+	for i := 0; i < len(existing) && i < len(generated) && existing[i] == generated[i]; i++ {
+		if existing[i] == '\n' {
+			line++
+		}
+	}
+	return fmt.Sprintf("differs from the generated output at line %d (%d bytes on disk, %d generated)", line, len(existing), len(generated))
+}
+
+// Returns the generator's arguments as recorded in the header of the files it
+// generates, which leaves out -check so that checking doesn't change them.
+func headerArgs(args []string) []string {
+	var kept []string
+	for _, arg := range args {
+		name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if strings.HasPrefix(arg, "-") && name == "check" {
+			continue
+		}
+		kept = append(kept, arg)
+	}
+	return kept
+}
+
+func createGoFile(header, buildTag, pkg string) (*bytes.Buffer, *bufio.Writer) {
+	out := new(bytes.Buffer)
+	writer := bufio.NewWriter(out)
+	fmt.Fprint(writer, header)
+	if buildTag != "" {
+		fmt.Fprintf(writer, "\n//go:build %s\n", buildTag)
+	}
+	fmt.Fprintf(writer, "\npackage %s\n\n", pkg)
+	return out, writer
+}
+
+func finishGoFile(o *outputs, path string, out *bytes.Buffer, writer *bufio.Writer) {
+	if err := writer.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	o.write(path, out.Bytes())
+}
+
+// A flag which may be given more than once, collecting each value.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
 func main() {
 	log.SetFlags(0)
 	log.SetPrefix("generate: ")
-	input := flag.String("i", "", "the path to DerivedCoreProperties.txt")
+	var inputs stringList
+	flag.Var(&inputs, "i", "the path to DerivedCoreProperties.txt, or another UCD file to read the properties of, such as PropList.txt or Scripts.txt (repeatable)")
 	output := flag.String("o", "", "the path to the output file")
+	useMPH := flag.Bool("mph", false, "look up leaves through the minimal perfect hash instead of the level tables")
+	minCP := flag.Uint("min", 0, "the first codepoint the tables cover")
+	maxCP := flag.Uint("max", maxCodepoint, "the last codepoint the tables cover")
+	blob := flag.String("blob", "", "also write the tables as an embedded blob, loaded by this Go file when built with the identblob tag")
+	check := flag.Bool("check", false, "compare the generated files against the existing ones instead of writing them, and fail if they differ")
+	split := flag.Int("split", 1, "divide the arrays between this many Go files, for build environments which struggle with one large file")
+	varint := flag.Bool("varint", false, "look up runs in the varint-encoded run stream instead of the run arrays (experimental)")
+	dot := flag.Bool("dot", false, "print the level tables and leaves as a Graphviz DOT graph instead of writing any files")
+	dump := flag.Bool("dump-props", false, "print the tables as DerivedCoreProperties.txt lines instead of writing any files")
+	full := flag.String("full", "", "also write the ID_Start and ID_Continue properties to this Go file, built with the identfull tag")
+	blocksPath := flag.String("blocks", "", "also write BlockName for the Blocks.txt at this path to blocks_generated.go, beside the output file")
+	var customs stringList
+	flag.Var(&customs, "custom", "also set a bit, from 2 to 7, for the codepoint ranges listed in a file, given as name=file.txt:bit (repeatable)")
+	keywords := flag.String("keywords", "", "also write LookupKeyword for the keyword list at this path to keywords_generated.go, beside the output file")
+	text := flag.String("text", "", "also write the normalization, case folding and bidi tables, taken from golang.org/x/text, to this Go file, built with the identnorm tag")
+	statusDiff := flag.Bool("status-diff", false, "print the codepoints which moved between Allowed and Restricted from the IdentifierStatus.txt given as the first argument to the one given as the second, instead of writing any files")
 	flag.Parse()
 
-	if *input == "" {
+	if *statusDiff {
+		if flag.NArg() != 2 {
+			log.Fatal("-status-diff takes the old and new IdentifierStatus.txt as arguments")
+		}
+		before, err := readIdentifierStatus(flag.Arg(0))
+		if err != nil {
+			log.Fatalf("%s: %v", flag.Arg(0), err)
+		}
+		after, err := readIdentifierStatus(flag.Arg(1))
+		if err != nil {
+			log.Fatalf("%s: %v", flag.Arg(1), err)
+		}
+		if err := diffStatus(os.Stdout, before, after); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(inputs) == 0 {
 		log.Fatal("must provide input file with -i")
 	}
-	if *output == "" {
-		log.Fatal("must provide output file with -o")
+	pkg := os.Getenv("GOPACKAGE")
+	if !*dump && !*dot {
+		if *output == "" {
+			log.Fatal("must provide output file with -o")
+		}
+		if pkg == "" {
+			log.Fatal("GOPACKAGE not set - run this tool with go generate")
+		}
 	}
 
-	pkg := os.Getenv("GOPACKAGE")
-	if pkg == "" {
-		log.Fatal("GOPACKAGE not set - run this tool with go generate")
+	if *maxCP > maxCodepoint {
+		log.Fatalf("-max must be at most %#x", maxCodepoint)
+	}
+	if *minCP > *maxCP {
+		log.Fatal("-min must not be greater than -max")
 	}
 
-	table, err := buildTable(*input)
+	// The block count is rounded up to a power of two, so that every
+	// index the level tables can produce is a real block.
+	blockBits := max(bits.Len32(uint32(*maxCP>>shift)), 1)
+	blockCount := 1 << blockBits
+	topBits := min(maxTopBits, blockBits-1)
+
+	version, err := readInputsVersion(inputs)
+	if err != nil {
+		log.Fatalf("failed to read the Unicode version: %v", err)
+	}
+
+	table, err := buildTable(inputs, uint32(*minCP), uint32(*maxCP), blockCount<<shift)
 	if err != nil {
 		log.Fatalf("failed to build table: %v", err)
 	}
 
-	runs := buildRuns(table)
+	var custom []customProperty
+	var customBits byte
+	for _, v := range customs {
+		c, err := parseCustom(v)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if customBits&(1<<c.bit) != 0 {
+			log.Fatalf("-custom %q: bit %d is already used", v, c.bit)
+		}
+		if err := mergeCustom(table, c, uint32(*minCP), uint32(*maxCP)); err != nil {
+			log.Fatalf("%s: %v", c.path, err)
+		}
+		custom = append(custom, c)
+		customBits |= 1 << c.bit
+	}
+
+	runs := coalesceRuns(buildRuns(table))
 	if len(runs) >= 1<<16 {
 		log.Fatalf("run table too large for uint16 index: %d", len(runs))
 	}
 
-	blockCount := (maxCodepoint >> shift) + 1
 	blockIndex := buildBlockIndex(runs, blockCount)
-	blockBits := 32 - bits.LeadingZeros32(uint32(blockCount-1))
-	if blockBits <= topBits {
-		log.Fatalf("topBits (%d) must be smaller than block bit width (%d)", topBits, blockBits)
-	}
 	lowerBits := blockBits - topBits
 	lowerSize := 1 << lowerBits
 	topSize := 1 << topBits
 
 	leafRuns, leafOffsets, blockToLeaf := buildLeaves(runs, blockIndex, blockCount)
+	if *dump {
+		classes := classesFromLeaves(table, leafRuns, leafOffsets, blockToLeaf)
+		if err := dumpProps(os.Stdout, version, classes); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	leafRunStarts, leafRunValues := splitLeafRuns(leafRuns)
 	level2Tables, level1Table := buildLevelTables(blockToLeaf, lowerSize, topSize)
+	if *dot {
+		if err := writeDot(os.Stdout, level1Table, level2Tables, lowerSize, leafOffsets); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	mphSeeds, mphKeys, mphLeaves, mphDefaultLeaf := buildMPH(blockToLeaf)
+	var runStream []byte
+	var runStreamOffsets []uint16
+	if *varint {
+		runStream, runStreamOffsets = buildRunStream(leafRuns, leafOffsets)
+	}
+
+	header := fmt.Sprintf("// Code generated by \"generate %s\"; DO NOT EDIT.\n", strings.Join(headerArgs(os.Args[1:]), " "))
+	emitConstants := func(writer *bufio.Writer) {
+		fmt.Fprintln(writer, "const (")
+		fmt.Fprintf(writer, "\tminCodepoint = 0x%04x\n", *minCP)
+		fmt.Fprintf(writer, "\tmaxCodepoint = 0x%04x\n", *maxCP)
+		fmt.Fprintf(writer, "\tshift = %d\n", shift)
+		fmt.Fprintf(writer, "\tblockCount = %d\n", blockCount)
+		fmt.Fprintf(writer, "\tlowerBits = %d\n", lowerBits)
+		fmt.Fprintf(writer, "\tlowerSize = %d\n", lowerSize)
+		fmt.Fprintf(writer, "\tuseMPH = %t\n", *useMPH)
+		fmt.Fprintf(writer, "\tmphDefaultLeaf = %d\n", mphDefaultLeaf)
+		fmt.Fprintf(writer, "\tuseVarintRuns = %t\n", *varint)
+		fmt.Fprintf(writer, "\tunicodeVersion = %q\n", version)
+		fmt.Fprintf(writer, "\tcustomBits = 0x%02x\n", customBits)
+		fmt.Fprintln(writer, ")")
+		fmt.Fprintln(writer)
+		if len(custom) > 0 {
+			fmt.Fprintln(writer, "// The bits of RawClass set by the properties given with -custom.")
+			fmt.Fprintln(writer, "const (")
+			for _, c := range custom {
+				fmt.Fprintf(writer, "\tRaw%s uint16 = 1 << %d\n", c.name, c.bit)
+			}
+			fmt.Fprintln(writer, ")")
+			fmt.Fprintln(writer)
+		}
+		// The leaves don't cover ASCII, which asciiTable classifies by hand,
+		// so the classes the data gives it are emitted for SelfTest to
+		// compare against.
+		fmt.Fprintln(writer, "// The classes of U+0000..U+007F in the data the tables were generated")
+		fmt.Fprintln(writer, "// from, which SelfTest checks asciiTable against.")
+		emitClassArray(writer, "asciiClasses", table[:startCode], byteValuesPerLine)
+	}
+
+	o := &outputs{check: *check}
+	arrayTag := ""
+	if *blob != "" {
+		arrayTag = "!identblob"
 
-	out, err := os.Create(*output)
+		data := appendBlobHeader(nil, version, uint32(*minCP), uint32(*maxCP), lowerBits, *useMPH, *varint, mphDefaultLeaf, customBits)
+		data = appendUint16Table(data, leafOffsets)
+		data = appendUint16Table(data, leafRunStarts)
+		data = appendByteTable(data, leafRunValues)
+		data = appendUint16Table(data, level2Tables)
+		data = appendUint16Table(data, level1Table)
+		data = appendUint16Table(data, mphSeeds)
+		data = appendUint16Table(data, mphKeys)
+		data = appendUint16Table(data, mphLeaves)
+		if *varint {
+			data = appendUint16Table(data, runStreamOffsets)
+			data = appendByteTable(data, runStream)
+		}
+		binPath := strings.TrimSuffix(*blob, ".go") + ".bin"
+		o.write(binPath, data)
+
+		out, writer := createGoFile(header, "identblob", pkg)
+		fmt.Fprintln(writer, "import _ \"embed\"")
+		fmt.Fprintln(writer)
+		emitConstants(writer)
+		fmt.Fprintf(writer, "//go:embed %s\n", filepath.Base(binPath))
+		fmt.Fprintln(writer, "var tablesBlob string")
+		finishGoFile(o, *blob, out, writer)
+	}
+
+	arrays := []func(*bufio.Writer){
+		func(w *bufio.Writer) { emitUint16Array(w, "leafOffsets", leafOffsets, indexValuesPerLine) },
+		func(w *bufio.Writer) { emitUint16Array(w, "leafRunStarts", leafRunStarts, indexValuesPerLine) },
+		func(w *bufio.Writer) { emitClassArray(w, "leafRunValues", leafRunValues, byteValuesPerLine) },
+		func(w *bufio.Writer) { emitUint16Array(w, "level2Tables", level2Tables, indexValuesPerLine) },
+		func(w *bufio.Writer) { emitUint16Array(w, "level1Table", level1Table, indexValuesPerLine) },
+		func(w *bufio.Writer) { emitUint16Array(w, "mphSeeds", mphSeeds, indexValuesPerLine) },
+		func(w *bufio.Writer) { emitUint16Array(w, "mphKeys", mphKeys, indexValuesPerLine) },
+		func(w *bufio.Writer) { emitUint16Array(w, "mphLeaves", mphLeaves, indexValuesPerLine) },
+	}
+	if *varint {
+		arrays = append(arrays,
+			func(w *bufio.Writer) { emitUint16Array(w, "runStreamOffsets", runStreamOffsets, indexValuesPerLine) },
+			func(w *bufio.Writer) { emitByteArray(w, "runStream", runStream, byteValuesPerLine) },
+		)
+	} else {
+		// The run stream is only looked up with -varint, so the default
+		// build declares it empty rather than carrying both encodings.
+		arrays = append(arrays, func(w *bufio.Writer) {
+			fmt.Fprintln(w, "var runStreamOffsets [0]uint16")
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "var runStream [0]byte")
+			fmt.Fprintln(w)
+		})
+	}
+	if *split < 1 || *split > len(arrays) {
+		log.Fatalf("-split must be between 1 and %d", len(arrays))
+	}
+
+	// The arrays are divided between the files in order, with the
+	// constants in the first file, which is the -o file. The others are
+	// named after it, like ident_generated_1.go.
+	for i := range *split {
+		path, lo, hi := splitFile(*output, i, *split, len(arrays))
+		out, writer := createGoFile(header, arrayTag, pkg)
+		if i == 0 {
+			emitConstants(writer)
+		}
+		for _, emit := range arrays[lo:hi] {
+			emit(writer)
+		}
+		finishGoFile(o, path, out, writer)
+	}
+
+	if *full != "" {
+		idTable, err := buildPropertyTable(inputs, idPropertyBits, uint32(*minCP), uint32(*maxCP), int(*maxCP)+1)
+		if err != nil {
+			log.Fatalf("failed to build ID table: %v", err)
+		}
+		starts, values := flatRuns(idTable)
+
+		out, writer := createGoFile(header, "identfull", pkg)
+		emitRuneArray(writer, "idRunStarts", starts, indexValuesPerLine)
+		emitClassArray(writer, "idRunValues", values, byteValuesPerLine)
+		finishGoFile(o, *full, out, writer)
+	}
+
+	if *keywords != "" {
+		list, err := readKeywords(*keywords)
+		if err != nil {
+			log.Fatalf("failed to read keywords: %v", err)
+		}
+		trie := buildKeywordTrie(list)
+
+		out, writer := createGoFile(header, "", pkg)
+		emitUint16Array(writer, "keywordNodeEdges", trie.nodeEdges, indexValuesPerLine)
+		emitByteArray(writer, "keywordEdgeBytes", trie.edgeBytes, byteValuesPerLine)
+		emitUint16Array(writer, "keywordEdgeTargets", trie.edgeTargets, indexValuesPerLine)
+		emitUint16Array(writer, "keywordNodeIDs", trie.nodeIDs, indexValuesPerLine)
+		fmt.Fprint(writer, lookupKeywordSource)
+		finishGoFile(o, filepath.Join(filepath.Dir(*output), "keywords_generated.go"), out, writer)
+	}
+
+	if *blocksPath != "" {
+		blockVersion, err := readUnicodeVersion(*blocksPath)
+		if err != nil {
+			log.Fatalf("failed to read blocks: %v", err)
+		}
+		if blockVersion != "" && blockVersion != version {
+			log.Fatalf("%s is for Unicode %s, but the tables are for %s", *blocksPath, blockVersion, version)
+		}
+		blocks, err := readBlocks(*blocksPath)
+		if err != nil {
+			log.Fatalf("failed to read blocks: %v", err)
+		}
+
+		out, writer := createGoFile(header, "", pkg)
+		fmt.Fprintln(writer, "import \"sort\"")
+		fmt.Fprintln(writer)
+		fmt.Fprintln(writer, "var blocks = [...]struct {")
+		fmt.Fprintln(writer, "\tfirst, last rune")
+		fmt.Fprintln(writer, "\tname        string")
+		fmt.Fprintln(writer, "}{")
+		for _, b := range blocks {
+			fmt.Fprintf(writer, "\t{0x%04x, 0x%04x, %q},\n", b.first, b.last, b.name)
+		}
+		fmt.Fprintln(writer, "}")
+		fmt.Fprintln(writer)
+		fmt.Fprint(writer, blockNameSource)
+		finishGoFile(o, filepath.Join(filepath.Dir(*output), "blocks_generated.go"), out, writer)
+	}
+
+	props, err := readProperties(inputs)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("failed to read properties: %v", err)
 	}
-	defer out.Close()
+	out, writer := createGoFile(header, "", pkg)
+	emitProperties(writer, props)
+	finishGoFile(o, filepath.Join(filepath.Dir(*output), "props_generated.go"), out, writer)
 
-	writer := bufio.NewWriter(out)
-	defer writer.Flush()
-
-	fmt.Fprintf(writer, "// Code generated by \"generate %s\"; DO NOT EDIT.\n", strings.Join(os.Args[1:], " "))
-	fmt.Fprintf(writer, "package %s\n\n", pkg)
-	fmt.Fprintln(writer, "const (")
-	fmt.Fprintf(writer, "\tshift = %d\n", shift)
-	fmt.Fprintf(writer, "\tblockCount = %d\n", blockCount)
-	fmt.Fprintf(writer, "\tlowerBits = %d\n", lowerBits)
-	fmt.Fprintf(writer, "\tlowerSize = %d\n", lowerSize)
-	fmt.Fprintln(writer, ")")
-	fmt.Fprintln(writer)
-
-	emitUint16Array(writer, "leafOffsets", leafOffsets, indexValuesPerLine)
-	emitUint16Array(writer, "leafRunStarts", leafRunStarts, indexValuesPerLine)
-	emitClassArray(writer, "leafRunValues", leafRunValues, byteValuesPerLine)
-	emitUint16Array(writer, "level2Tables", level2Tables, indexValuesPerLine)
-	emitUint16Array(writer, "level1Table", level1Table, indexValuesPerLine)
+	if *text != "" {
+		tables, err := buildTextTables(version)
+		if err != nil {
+			log.Fatalf("failed to build text tables: %v", err)
+		}
+		out, writer := createGoFile(header, "identnorm", pkg)
+		emitTextTables(writer, tables)
+		finishGoFile(o, *text, out, writer)
+	}
+
+	if len(o.stale) > 0 {
+		for _, stale := range o.stale {
+			log.Print(stale)
+		}
+		log.Fatal("generated files are out of date - run go generate")
+	}
 }
@@ -0,0 +1,926 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const derivedDataPath = "../../DerivedCoreProperties.txt"
+
+// Copies the runtime sources of the package, without its tests or generated
+// tables, into a temporary directory so that they can be built against
+// freshly generated tables. The text tables are copied too, since only -text
+// generates them and they don't depend on the other inputs.
+func newTestPackage(t *testing.T) string {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("skipping package generation in short mode")
+	}
+
+	dir := t.TempDir()
+	paths, err := filepath.Glob("../*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths, "../go.mod", "../go.sum")
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_generated.go") && name != "text_generated.go" {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// Runs the generator on the derived data, writing the tables into dir.
+func runGenerator(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	input, err := filepath.Abs(derivedDataPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args = append([]string{"run", ".", "-i", input, "-o", filepath.Join(dir, "ident_generated.go")}, args...)
+	cmd := exec.Command("go", args...)
+	cmd.Env = append(os.Environ(), "GOPACKAGE=unicode_id_trie_rle")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generator failed: %v\n%s", err, out)
+	}
+}
+
+// Writes a test file into the package in dir and runs its tests.
+func runPackageTest(t *testing.T, dir, name, src string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "test", "./")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated package failed its tests: %v\n%s", err, out)
+	}
+}
+
+func TestCoalesceRuns(t *testing.T) {
+	runs := []run{
+		{start: 0x80, value: 0},
+		{start: 0x90, value: 0},
+		{start: 0xa0, value: 3},
+		{start: 0xa8, value: 3},
+		{start: 0xb0, value: 3},
+		{start: 0xc0, value: 2},
+		{start: 0xd0, value: 0},
+		{start: 0x100000, value: 0},
+	}
+	expected := []run{
+		{start: 0x80, value: 0},
+		{start: 0xa0, value: 3},
+		{start: 0xc0, value: 2},
+		{start: 0xd0, value: 0},
+		{start: 0x100000, value: 0},
+	}
+
+	got := coalesceRuns(runs)
+	if !slices.Equal(got, expected) {
+		t.Fatalf("coalesceRuns returned %v, expected %v", got, expected)
+	}
+	if again := coalesceRuns(got); !slices.Equal(again, expected) {
+		t.Fatalf("coalesceRuns is not idempotent: %v", again)
+	}
+}
+
+const expectedClassesTest = `package unicode_id_trie_rle
+
+import (
+	"os"
+	"testing"
+)
+
+func TestExpectedClasses(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("expected.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for cp := rune(0); cp <= 0x10ffff; cp++ {
+		want := Other
+		if int(cp) < len(expected) {
+			want = IdentifierClass(expected[cp])
+		}
+		if got := UnicodeIdentifierClass(cp); got != want {
+			t.Fatalf("U+%04X: got class %d, expected %d", cp, got, want)
+		}
+	}
+}
+`
+
+// -max 0xffff leaves every codepoint past the BMP zeroed in the table, and
+// the leaves built from the BMP alone give back its classes.
+func TestBuildTableBMPOnly(t *testing.T) {
+	full, err := buildTable([]string{derivedDataPath}, 0, maxCodepoint, maxCodepoint+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := buildTable([]string{derivedDataPath}, 0, 0xffff, maxCodepoint+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(table[:0x10000], full[:0x10000]) {
+		t.Errorf("the BMP's classes differ from those in the full table")
+	}
+	if i := slices.IndexFunc(table[0x10000:], func(v byte) bool { return v != 0 }); i >= 0 {
+		t.Errorf("U+%04X past -max has class %d", 0x10000+i, table[0x10000+i])
+	}
+
+	const blockCount = 0x10000 >> shift
+	bmp := table[:0x10000]
+	runs := coalesceRuns(buildRuns(bmp))
+	leafRuns, leafOffsets, blockToLeaf := buildLeaves(runs, buildBlockIndex(runs, blockCount), blockCount)
+	if classes := classesFromLeaves(bmp, leafRuns, leafOffsets, blockToLeaf); !slices.Equal(classes, bmp) {
+		t.Errorf("classes reconstructed from the BMP's leaves differ from the table")
+	}
+}
+
+func TestGenerateVarint(t *testing.T) {
+	dir := newTestPackage(t)
+	runGenerator(t, dir, "-varint", "-mph")
+
+	expected, err := buildTable([]string{derivedDataPath}, 0, maxCodepoint, maxCodepoint+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "expected.bin"), expected, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runPackageTest(t, dir, "expected_test.go", expectedClassesTest)
+}
+
+// Looks s up in trie the way the LookupKeyword emitted beside it does.
+func lookupKeyword(trie keywordTrie, s string) (int, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		next := -1
+		for e := trie.nodeEdges[n]; e < trie.nodeEdges[n+1]; e++ {
+			if trie.edgeBytes[e] == s[i] {
+				next = int(trie.edgeTargets[e])
+				break
+			}
+		}
+		if next < 0 {
+			return 0, false
+		}
+		n = next
+	}
+	id := trie.nodeIDs[n]
+	if id == 0 {
+		return 0, false
+	}
+	return int(id) - 1, true
+}
+
+func TestKeywordTrie(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keywords.txt")
+	list := "# control flow\nif\nin\n\nint\nfor\nfunción\n"
+	if err := os.WriteFile(path, []byte(list), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	keywords, err := readKeywords(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trie := buildKeywordTrie(keywords)
+
+	cases := []struct {
+		s  string
+		id int
+		ok bool
+	}{
+		{"if", 0, true},
+		{"in", 1, true},
+		{"int", 2, true},
+		{"for", 3, true},
+		{"función", 4, true},
+		{"i", 0, false},
+		{"into", 0, false},
+		{"fo", 0, false},
+		{"If", 0, false},
+		{"", 0, false},
+		{"función_", 0, false},
+	}
+	for _, c := range cases {
+		id, ok := lookupKeyword(trie, c.s)
+		if ok != c.ok || (ok && id != c.id) {
+			t.Errorf("lookupKeyword(%q) = (%d, %v), expected (%d, %v)", c.s, id, ok, c.id, c.ok)
+		}
+	}
+}
+
+func TestReadBlocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Blocks.txt")
+	data := "# Blocks-17.0.0.txt\n" +
+		"# Start Code..End Code; Block Name\n\n" +
+		"0000..007F; Basic Latin\n" +
+		"0080..00FF; Latin-1 Supplement\n" +
+		"4E00..9FFF; CJK Unified Ideographs\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks, err := readBlocks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []block{
+		{first: 0x0000, last: 0x007f, name: "Basic Latin"},
+		{first: 0x0080, last: 0x00ff, name: "Latin-1 Supplement"},
+		{first: 0x4e00, last: 0x9fff, name: "CJK Unified Ideographs"},
+	}
+	if !slices.Equal(blocks, expected) {
+		t.Errorf("readBlocks returned %v, expected %v", blocks, expected)
+	}
+	if version, err := readUnicodeVersion(path); err != nil || version != "17.0.0" {
+		t.Errorf("readUnicodeVersion returned (%q, %v), expected 17.0.0", version, err)
+	}
+}
+
+func TestReadBlocksRejectsOverlap(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Blocks.txt")
+	data := "0000..007F; Basic Latin\n0070..00FF; Latin-1 Supplement\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readBlocks(path); err == nil {
+		t.Errorf("readBlocks accepted overlapping blocks")
+	}
+}
+
+// The table written by -full holds the ID_ properties, which differ from the
+// XID_ ones for U+037A GREEK YPOGEGRAMMENI.
+func TestBuildIDTable(t *testing.T) {
+	idTable, err := buildPropertyTable([]string{derivedDataPath}, idPropertyBits, 0, maxCodepoint, maxCodepoint+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xidTable, err := buildTable([]string{derivedDataPath}, 0, maxCodepoint, maxCodepoint+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for cp, expected := range map[rune]byte{'a': 3, '1': 2, ' ': 0, 0x037a: 3} {
+		if got := idTable[cp]; got != expected {
+			t.Errorf("U+%04X has the ID class %d, expected %d", cp, got, expected)
+		}
+	}
+	if xidTable[0x037a] == idTable[0x037a] {
+		t.Errorf("U+037A has the same XID and ID classes")
+	}
+}
+
+const customTest = `package unicode_id_trie_rle
+
+import "testing"
+
+func TestCustomProperty(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		cp       rune
+		expected bool
+	}{
+		{0x00e9, true},
+		{0x00ea, false},
+		{0x0400, true},
+		{0x04ff, true},
+		{0x0500, false},
+		{'a', false},
+	} {
+		if got := RawClass(c.cp)&RawExperimental != 0; got != c.expected {
+			t.Errorf("RawClass(%U)&RawExperimental != 0 is %v, expected %v", c.cp, got, c.expected)
+		}
+	}
+	if UnicodeIdentifierClass(0x00e9) != Start|Continue || UnicodeIdentifierClass(0x04ff) != Start|Continue {
+		t.Errorf("the custom property leaked into UnicodeIdentifierClass")
+	}
+}
+`
+
+func TestGenerateCustom(t *testing.T) {
+	dir := newTestPackage(t)
+	ranges := filepath.Join(t.TempDir(), "experimental.txt")
+	data := "# An experimental property.\n00E9 ; Experimental\n0400..04FF\n"
+	if err := os.WriteFile(ranges, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runGenerator(t, dir, "-custom", "Experimental="+ranges+":3")
+	runPackageTest(t, dir, "custom_test.go", customTest)
+}
+
+func TestMergeCustomRejectsASCII(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ascii.txt")
+	if err := os.WriteFile(path, []byte("0061..00FF\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	table := make([]byte, 0x100)
+	if err := mergeCustom(table, customProperty{name: "ASCII", path: path, bit: 2}, 0, 0xff); err == nil {
+		t.Errorf("mergeCustom accepted a range covering ASCII")
+	}
+}
+
+func TestParseCustom(t *testing.T) {
+	c, err := parseCustom("Emoji=C:/data/emoji.txt:5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != (customProperty{name: "Emoji", path: "C:/data/emoji.txt", bit: 5}) {
+		t.Errorf("parseCustom = %+v", c)
+	}
+
+	for _, v := range []string{"Emoji", "Emoji=emoji.txt", "=emoji.txt:5", "Emo-ji=emoji.txt:5", "Emoji=emoji.txt:1", "Emoji=emoji.txt:8"} {
+		if _, err := parseCustom(v); err == nil {
+			t.Errorf("parseCustom(%q) succeeded", v)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ident_generated.go")
+	other := filepath.Join(dir, "ident_blob_generated.go")
+	generated := []byte("package p\n\nvar a = [...]uint16{\n\t0x0001,\n}\n")
+	(&outputs{}).write(path, generated)
+	(&outputs{}).write(other, generated)
+
+	o := &outputs{check: true}
+	o.write(path, generated)
+	o.write(other, generated)
+	if len(o.stale) != 0 {
+		t.Fatalf("-check reported up to date files: %v", o.stale)
+	}
+
+	stale := bytes.Replace(generated, []byte("0x"), []byte("0X"), 1)
+	if err := os.WriteFile(path, stale, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	o.write(path, generated)
+	o.write(other, generated)
+	o.write(filepath.Join(dir, "missing.go"), generated)
+	if len(o.stale) != 2 {
+		t.Fatalf("-check reported %d stale files, expected 2: %v", len(o.stale), o.stale)
+	}
+	if expected := path + ": differs from the generated output at line 4"; !strings.HasPrefix(o.stale[0], expected) {
+		t.Errorf("-check reported %q, expected it to begin %q", o.stale[0], expected)
+	}
+
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(after, stale) {
+		t.Errorf("-check overwrote the modified file")
+	}
+}
+
+func TestBuildTableMergesInputs(t *testing.T) {
+	dir := t.TempDir()
+	derived := filepath.Join(dir, "DerivedCoreProperties.txt")
+	propList := filepath.Join(dir, "PropList.txt")
+	scripts := filepath.Join(dir, "Scripts.txt")
+	files := map[string]string{
+		derived: "# DerivedCoreProperties-17.0.0.txt\n" +
+			"0041..0043    ; XID_Start # L&   [3] LATIN CAPITAL LETTER A..LATIN CAPITAL LETTER C\n" +
+			"0030..0031    ; XID_Continue # Nd   [2] DIGIT ZERO..DIGIT ONE\n" +
+			"0041..0043    ; XID_Continue # L&   [3] LATIN CAPITAL LETTER A..LATIN CAPITAL LETTER C\n" +
+			"0300          ; InCB; Extend # Mn       COMBINING GRAVE ACCENT\n",
+		propList: "# PropList-17.0.0.txt\n" +
+			"0020          ; White_Space # Zs       SPACE\n" +
+			"0030..0031    ; ASCII_Hex_Digit # Nd   [2] DIGIT ZERO..DIGIT ONE\n",
+		scripts: "# Scripts-17.0.0.txt\n" +
+			"0030..0031    ; Common # Nd   [2] DIGIT ZERO..DIGIT ONE\n" +
+			"0041..0043    ; Latin # L&   [3] LATIN CAPITAL LETTER A..LATIN CAPITAL LETTER C\n",
+	}
+	for path, data := range files {
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only DerivedCoreProperties.txt has identifier properties, and the
+	// other files mustn't contribute to the classes.
+	table, err := buildTable([]string{derived, propList, scripts}, 0, 0x7f, 0x80)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := make([]byte, 0x80)
+	expected['A'], expected['B'], expected['C'] = 3, 3, 3
+	expected['0'], expected['1'] = 2, 2
+	if !slices.Equal(table, expected) {
+		t.Errorf("buildTable returned %v, expected %v", table, expected)
+	}
+
+	version, err := readInputsVersion([]string{derived, propList, scripts})
+	if err != nil || version != "17.0.0" {
+		t.Errorf("readInputsVersion returned (%q, %v), expected 17.0.0", version, err)
+	}
+
+	other := filepath.Join(dir, "Blocks.txt")
+	if err := os.WriteFile(other, []byte("# Blocks-16.0.0.txt\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readInputsVersion([]string{derived, other}); err == nil {
+		t.Errorf("readInputsVersion accepted inputs for different Unicode versions")
+	}
+}
+
+// Reads each kind of UCD file, with lines taken from the real files, to
+// check that they're routed to the right parser.
+func TestReadProperties(t *testing.T) {
+	dir := t.TempDir()
+	files := []struct{ name, data string }{
+		{"DerivedCoreProperties.txt", "# DerivedCoreProperties-17.0.0.txt\n" +
+			"00AD          ; Default_Ignorable_Code_Point # Cf       SOFT HYPHEN\n" +
+			"0061..007A    ; XID_Start # L&  [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n"},
+		{"PropList.txt", "# PropList-17.0.0.txt\n" +
+			"0020          ; White_Space # Zs       SPACE\n" +
+			"0149          ; Deprecated # L&       LATIN SMALL LETTER N PRECEDED BY APOSTROPHE\n" +
+			"0600..0605    ; Prepended_Concatenation_Mark # Cf   [6] ARABIC NUMBER SIGN..ARABIC NUMBER MARK ABOVE\n"},
+		{"Scripts.txt", "# Scripts-17.0.0.txt\n" +
+			"0061..007A    ; Latin # L&  [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n" +
+			"0628          ; Arabic # Lo       ARABIC LETTER BEH\n" +
+			"0640          ; Common # Lm       ARABIC TATWEEL\n" +
+			"0710          ; Syriac # Lo       SYRIAC LETTER ALAPH\n"},
+		{"ScriptExtensions.txt", "# ScriptExtensions-17.0.0.txt\n" +
+			"0640          ; Adlm Arab Mand Mani Ougr Phlp Rohg Sogd Syrc # Lm       ARABIC TATWEEL\n"},
+		{"PropertyValueAliases.txt", "# PropertyValueAliases-17.0.0.txt\n" +
+			"sc ; Adlm                             ; Adlam\n" +
+			"sc ; Arab                             ; Arabic\n" +
+			"sc ; Latn                             ; Latin\n" +
+			"sc ; Mand                             ; Mandaic\n" +
+			"sc ; Mani                             ; Manichaean\n" +
+			"sc ; Ougr                             ; Old_Uyghur\n" +
+			"sc ; Phlp                             ; Psalter_Pahlavi\n" +
+			"sc ; Rohg                             ; Hanifi_Rohingya\n" +
+			"sc ; Sogd                             ; Sogdian\n" +
+			"sc ; Syrc                             ; Syriac\n"},
+		{"IdentifierType.txt", "# IdentifierType.txt\n" +
+			"0149          ; Deprecated                     # 1.1        LATIN SMALL LETTER N PRECEDED BY APOSTROPHE\n" +
+			"01C0..01C3    ; Technical                      # 1.1    [4] LATIN LETTER DENTAL CLICK..LATIN LETTER RETROFLEX CLICK\n" +
+			"0061..007A    ; Recommended                    # 1.1   [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n" +
+			"0673          ; Deprecated Not_NFKC            # 1.1        ARABIC LETTER ALEF WITH WAVY HAMZA BELOW\n"},
+		{"DerivedGeneralCategory.txt", "# DerivedGeneralCategory-17.0.0.txt\n" +
+			"0061..007A    ; Ll #  [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n" +
+			"0300..0314    ; Mn #  [21] COMBINING GRAVE ACCENT..COMBINING REVERSED COMMA ABOVE\n" +
+			"0378..0379    ; Cn #   [2] <reserved-0378>..<reserved-0379>\n" +
+			"0903          ; Mc #       DEVANAGARI SIGN VISARGA\n"},
+		{"DerivedJoiningType.txt", "# DerivedJoiningType-17.0.0.txt\n" +
+			"0627          ; R # Lo       ARABIC LETTER ALEF\n" +
+			"0628          ; D # Lo       ARABIC LETTER BEH\n" +
+			"064B..065F    ; T # Mn  [21] ARABIC FATHATAN..ARABIC WAVY HAMZA BELOW\n"},
+		{"DerivedCombiningClass.txt", "# DerivedCombiningClass-17.0.0.txt\n" +
+			"0300..0314    ; 230 # Mn  [21] COMBINING GRAVE ACCENT..COMBINING REVERSED COMMA ABOVE\n" +
+			"094D          ; 9 # Mn       DEVANAGARI SIGN VIRAMA\n"},
+	}
+	var paths []string
+	for _, f := range files {
+		path := filepath.Join(dir, f.name)
+		if err := os.WriteFile(path, []byte(f.data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	props, err := readProperties(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bits := []struct {
+		cp   rune
+		bits byte
+	}{
+		{'a', propAssigned | propAllowed},
+		{' ', 0},
+		{0xad, propDefaultIgnorable},
+		// Deprecated in both PropList.txt and IdentifierType.txt.
+		{0x149, propDeprecated},
+		// Technical, so it isn't allowed.
+		{0x1c0, 0},
+		// Only the Identifier_Type is Deprecated, which isn't the
+		// binary property.
+		{0x673, 0},
+		{0x600, propPrependedConcatenationMark},
+		{0x378, 0},
+		{0x301, propAssigned | propCombiningMark},
+		{0x903, propAssigned | propCombiningMark},
+	}
+	for _, c := range bits {
+		if got := props.bits[c.cp]; got != c.bits {
+			t.Errorf("U+%04X has the property bits %#02x, expected %#02x", c.cp, got, c.bits)
+		}
+	}
+	if !props.identifierType {
+		t.Errorf("IdentifierType.txt wasn't recorded as read")
+	}
+
+	script := func(cp rune) string { return props.scriptNames[props.scripts[cp]] }
+	for cp, expected := range map[rune]string{'a': "Latin", 0x628: "Arabic", 0x640: "Common", 0x710: "Syriac", 0x4e00: "Unknown"} {
+		if got := script(cp); got != expected {
+			t.Errorf("U+%04X has the script %s, expected %s", cp, got, expected)
+		}
+	}
+	var extensions []string
+	for _, i := range props.scriptSets[props.scriptExtensions[0x640]] {
+		extensions = append(extensions, props.scriptNames[i])
+	}
+	slices.Sort(extensions)
+	expectedExtensions := []string{"Adlam", "Arabic", "Hanifi_Rohingya", "Mandaic", "Manichaean", "Old_Uyghur", "Psalter_Pahlavi", "Sogdian", "Syriac"}
+	if !slices.Equal(extensions, expectedExtensions) {
+		t.Errorf("U+0640 has the script extensions %v, expected %v", extensions, expectedExtensions)
+	}
+	if props.scriptExtensions['a'] != 0 {
+		t.Errorf("U+0061 has script extensions besides its script")
+	}
+
+	for cp, expected := range map[rune]string{0x627: "R", 0x628: "D", 0x64b: "T", 'a': "U"} {
+		if got := joiningTypes[props.joining[cp]]; got != expected {
+			t.Errorf("U+%04X has the joining type %s, expected %s", cp, got, expected)
+		}
+	}
+	for cp, expected := range map[rune]byte{0x301: 230, 0x94d: 9, 'a': 0} {
+		if got := props.combiningClasses[cp]; got != expected {
+			t.Errorf("U+%04X has the combining class %d, expected %d", cp, got, expected)
+		}
+	}
+
+	// The short names of Script_Extensions can't be read without
+	// PropertyValueAliases.txt.
+	var withoutAliases []string
+	for _, path := range paths {
+		if filepath.Base(path) != "PropertyValueAliases.txt" {
+			withoutAliases = append(withoutAliases, path)
+		}
+	}
+	if _, err := readProperties(withoutAliases); err == nil {
+		t.Errorf("readProperties accepted ScriptExtensions.txt without PropertyValueAliases.txt")
+	}
+}
+
+// Only the Recommended and Inclusion types are allowed by UTS55, so the
+// Technical U+01C0 LATIN LETTER DENTAL CLICK is left without propAllowed once
+// IdentifierType.txt is given, though it's still XID_Continue, and the
+// generated tables record that the file was read.
+func TestIdentifierTypeEmitted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "IdentifierType.txt")
+	data := "# IdentifierType.txt\n" +
+		"01C0..01C3    ; Technical                      # 1.1    [4] LATIN LETTER DENTAL CLICK..LATIN LETTER RETROFLEX CLICK\n" +
+		"0061..007A    ; Recommended                    # 1.1   [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		paths    []string
+		expected string
+	}{
+		{[]string{derivedDataPath}, "const identifierTypeData = false\n"},
+		{[]string{derivedDataPath, path}, "const identifierTypeData = true\n"},
+	} {
+		props, err := readProperties(c.paths)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		w := bufio.NewWriter(&out)
+		emitProperties(w, props)
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), c.expected) {
+			t.Errorf("the properties generated from %v don't contain %q", c.paths, c.expected)
+		}
+		if props.identifierType && (props.bits[0x1c0]&propAllowed != 0 || props.bits['a']&propAllowed == 0) {
+			t.Errorf("U+01C0 and U+0061 have the property bits %#02x and %#02x", props.bits[0x1c0], props.bits['a'])
+		}
+	}
+}
+
+// U+30FC KATAKANA-HIRAGANA PROLONGED SOUND MARK has the Common script, but
+// its Script_Extensions only hold Hiragana and Katakana, so it mustn't
+// join them to Latin.
+func TestScriptExtensionsOfCommon(t *testing.T) {
+	dir := t.TempDir()
+	files := []struct{ name, data string }{
+		{"Scripts.txt", "# Scripts-17.0.0.txt\n" +
+			"0061..007A    ; Latin # L&  [26] LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n" +
+			"3041..3096    ; Hiragana # Lo  [86] HIRAGANA LETTER SMALL A..HIRAGANA LETTER SMALL KE\n" +
+			"30A1..30FA    ; Katakana # Lo  [90] KATAKANA LETTER SMALL A..KATAKANA LETTER VU\n" +
+			"30FC          ; Common # Lm       KATAKANA-HIRAGANA PROLONGED SOUND MARK\n"},
+		{"ScriptExtensions.txt", "# ScriptExtensions-17.0.0.txt\n" +
+			"30FC          ; Hira Kana # Lm       KATAKANA-HIRAGANA PROLONGED SOUND MARK\n"},
+		{"PropertyValueAliases.txt", "# PropertyValueAliases-17.0.0.txt\n" +
+			"sc ; Hira                             ; Hiragana\n" +
+			"sc ; Kana                             ; Katakana\n" +
+			"sc ; Latn                             ; Latin\n"},
+	}
+	var paths []string
+	for _, f := range files {
+		path := filepath.Join(dir, f.name)
+		if err := os.WriteFile(path, []byte(f.data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+
+	props, err := readProperties(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if script := props.scriptNames[props.scripts[0x30fc]]; script != "Common" {
+		t.Errorf("U+30FC has the script %s, expected Common", script)
+	}
+	var extensions []string
+	for _, i := range props.scriptSets[props.scriptExtensions[0x30fc]] {
+		extensions = append(extensions, props.scriptNames[i])
+	}
+	slices.Sort(extensions)
+	if expected := []string{"Hiragana", "Katakana"}; !slices.Equal(extensions, expected) {
+		t.Errorf("U+30FC has the script extensions %v, expected %v", extensions, expected)
+	}
+	if props.scriptExtensions['a'] != 0 || props.scriptExtensions[0x3041] != 0 {
+		t.Errorf("runes without Script_Extensions have extensions besides their script")
+	}
+}
+
+func TestStatusDiff(t *testing.T) {
+	dir := t.TempDir()
+	before := filepath.Join(dir, "IdentifierStatus-16.0.0.txt")
+	after := filepath.Join(dir, "IdentifierStatus-17.0.0.txt")
+	files := map[string]string{
+		before: "# IdentifierStatus.txt\n" +
+			"0030..0039    ; Allowed    # 1.1  DIGIT ZERO..DIGIT NINE\n" +
+			"0061..007A    ; Allowed    # 1.1  LATIN SMALL LETTER A..LATIN SMALL LETTER Z\n",
+		after: "# IdentifierStatus.txt\n" +
+			"0030..0039    ; Allowed    # 1.1  DIGIT ZERO..DIGIT NINE\n" +
+			"0064..007A    ; Allowed    # 1.1  LATIN SMALL LETTER D..LATIN SMALL LETTER Z\n",
+	}
+	for path, data := range files {
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := readIdentifierStatus(before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := readIdentifierStatus(after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var forward bytes.Buffer
+	if err := diffStatus(&forward, b, a); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "0061..0063    ; Allowed -> Restricted\n"; forward.String() != expected {
+		t.Errorf("diffStatus wrote %q, expected %q", forward.String(), expected)
+	}
+	var reverse bytes.Buffer
+	if err := diffStatus(&reverse, a, b); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "0061..0063    ; Restricted -> Allowed\n"; reverse.String() != expected {
+		t.Errorf("diffStatus wrote %q, expected %q", reverse.String(), expected)
+	}
+
+	bad := filepath.Join(dir, "bad.txt")
+	if err := os.WriteFile(bad, []byte("0041 ; Obsolete\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readIdentifierStatus(bad); err == nil {
+		t.Errorf("readIdentifierStatus accepted an unknown status")
+	}
+}
+
+// Builds the leaves for the data in paths, as the generator does for the
+// whole codespace.
+func buildTestLeaves(t *testing.T, paths []string) ([]byte, []leafRun, []uint16, []uint16) {
+	t.Helper()
+	const blockCount = (maxCodepoint + 1) >> shift
+	table, err := buildTable(paths, 0, maxCodepoint, blockCount<<shift)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runs := coalesceRuns(buildRuns(table))
+	leafRuns, leafOffsets, blockToLeaf := buildLeaves(runs, buildBlockIndex(runs, blockCount), blockCount)
+	return table, leafRuns, leafOffsets, blockToLeaf
+}
+
+func TestDumpPropsRoundTrip(t *testing.T) {
+	table, leafRuns, leafOffsets, blockToLeaf := buildTestLeaves(t, []string{derivedDataPath})
+	classes := classesFromLeaves(table, leafRuns, leafOffsets, blockToLeaf)
+	if !slices.Equal(classes, table) {
+		t.Fatalf("classes reconstructed from the leaves differ from the table")
+	}
+
+	path := filepath.Join(t.TempDir(), "dump.txt")
+	out, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dumpProps(out, "17.0.0", classes); err != nil {
+		t.Fatal(err)
+	}
+	if err := out.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, dumpedRuns, dumpedOffsets, dumpedBlocks := buildTestLeaves(t, []string{path})
+	if !slices.Equal(dumpedRuns, leafRuns) || !slices.Equal(dumpedOffsets, leafOffsets) || !slices.Equal(dumpedBlocks, blockToLeaf) {
+		t.Errorf("the tables built from the dumped properties differ from the originals")
+	}
+	if version, err := readInputsVersion([]string{path}); err != nil || version != "17.0.0" {
+		t.Errorf("readInputsVersion on the dump returned (%q, %v), expected 17.0.0", version, err)
+	}
+}
+
+// The statements writeDot emits: attributes of the graph, nodes and edges.
+var dotStatementRe = regexp.MustCompile(`^\t(\w+=("[^"]*"|\w+)|\w+( -> \w+)? \[(\w+=("[^"]*"|\w+))(, \w+=("[^"]*"|\w+))*\]);$`)
+
+func TestWriteDot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "DerivedCoreProperties.txt")
+	data := "# DerivedCoreProperties-17.0.0.txt\n" +
+		"00C0..00D6    ; XID_Start\n" +
+		"00C0..00D6    ; XID_Continue\n" +
+		"0300..036F    ; XID_Continue\n" +
+		"4E00..9FFF    ; XID_Start\n" +
+		"4E00..9FFF    ; XID_Continue\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, _, leafOffsets, blockToLeaf := buildTestLeaves(t, []string{path})
+	const lowerSize = 16
+	level2, level1 := buildLevelTables(blockToLeaf, lowerSize, len(blockToLeaf)/lowerSize)
+
+	var out bytes.Buffer
+	if err := writeDot(&out, level1, level2, lowerSize, leafOffsets); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) < 3 || lines[0] != "digraph trie {" || lines[len(lines)-1] != "}" {
+		t.Fatalf("writeDot didn't write a digraph:\n%s", out.String())
+	}
+
+	nodes := make(map[string]bool)
+	var edges [][2]string
+	for _, line := range lines[1 : len(lines)-1] {
+		if !dotStatementRe.MatchString(line) {
+			t.Fatalf("invalid DOT statement %q", line)
+		}
+		fields := strings.Fields(line)
+		if len(fields) > 2 && fields[1] == "->" {
+			edges = append(edges, [2]string{fields[0], fields[2]})
+		} else if strings.Contains(line, "[") {
+			nodes[fields[0]] = true
+		}
+	}
+	for _, e := range edges {
+		if !nodes[e[0]] || !nodes[e[1]] {
+			t.Errorf("edge %s -> %s refers to an undeclared node", e[0], e[1])
+		}
+	}
+	if !nodes["level1"] || !nodes["leaf_0"] {
+		t.Errorf("writeDot didn't declare the level1 table and the first leaf:\n%s", out.String())
+	}
+}
+
+// -split divides the arrays between the files in order, each holding at
+// least one, with the first file being the -o file.
+func TestSplitFile(t *testing.T) {
+	const count = 9
+	for n := 1; n <= count; n++ {
+		next := 0
+		for i := range n {
+			path, lo, hi := splitFile("out/ident_generated.go", i, n, count)
+			expected := "out/ident_generated.go"
+			if i > 0 {
+				expected = fmt.Sprintf("out/ident_generated_%d.go", i)
+			}
+			if path != expected || lo != next || hi <= lo {
+				t.Fatalf("splitFile(%d, %d) = (%q, %d, %d), expected %q starting at %d", i, n, path, lo, hi, expected, next)
+			}
+			next = hi
+		}
+		if next != count {
+			t.Errorf("-split %d only covers %d of %d arrays", n, next, count)
+		}
+	}
+}
+
+const olderBlobTest = `package unicode_id_trie_rle
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestOlderBlob(t *testing.T) {
+	blob, err := os.ReadFile("older.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	older, err := NewClassifierFromBlob(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := older.UnicodeVersion(); v != "16.0.0" {
+		t.Fatalf("blob is for Unicode %s, expected 16.0.0", v)
+	}
+
+	RegisterVersion(older.UnicodeVersion(), older)
+	if c, ok := ClassifierForVersion("16.0.0"); !ok || c != older {
+		t.Fatalf("ClassifierForVersion(\"16.0.0\") = (%p, %v), expected (%p, true)", c, ok, older)
+	}
+	current, ok := ClassifierForVersion(UnicodeVersion)
+	if !ok {
+		t.Fatal("the compiled-in tables aren't registered")
+	}
+
+	expected := []ClassDiff{
+		{Lo: 0x13a0, Hi: 0x13f5, A: Other, B: Start | Continue},
+		{Lo: 0x13f8, Hi: 0x13fd, A: Continue, B: Start | Continue},
+	}
+	if got := DiffClassifiers(older, current); !slices.Equal(got, expected) {
+		t.Fatalf("DiffClassifiers returned %v, expected %v", got, expected)
+	}
+	if older.IsIdent([]rune("Ꭰ")) || !current.IsIdent([]rune("Ꭰ")) {
+		t.Errorf("only the current classifier should accept U+13A0")
+	}
+}
+`
+
+// Generates a blob from the derived data with Cherokee's XID properties
+// removed and its version changed to 16.0.0, and checks that a Classifier
+// loaded from it classifies under that data while the package's compiled-in
+// tables are used for the current version. The blob is generated with -mph
+// so that the perfect hash lookup is exercised too.
+func TestClassifierFromBlob(t *testing.T) {
+	dir := newTestPackage(t)
+	runGenerator(t, dir)
+
+	data, err := os.ReadFile(derivedDataPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var older []string
+	for i, line := range strings.Split(string(data), "\n") {
+		switch {
+		case i == 0:
+			line = "# DerivedCoreProperties-16.0.0.txt"
+		case strings.HasPrefix(line, "13A0..13F5    ; XID_"),
+			strings.HasPrefix(line, "13F8..13FD    ; XID_Start"):
+			continue
+		}
+		older = append(older, line)
+	}
+	scratch := t.TempDir()
+	input := filepath.Join(scratch, "DerivedCoreProperties.txt")
+	if err := os.WriteFile(input, []byte(strings.Join(older, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "run", ".", "-mph", "-i", input,
+		"-o", filepath.Join(scratch, "ident_generated.go"),
+		"-blob", filepath.Join(scratch, "ident_blob_generated.go"))
+	cmd.Env = append(os.Environ(), "GOPACKAGE=unicode_id_trie_rle")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generator failed: %v\n%s", err, out)
+	}
+	blob, err := os.ReadFile(filepath.Join(scratch, "ident_blob_generated.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "older.bin"), blob, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runPackageTest(t, dir, "older_blob_test.go", olderBlobTest)
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"slices"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/bidi"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	hangulBase  = 0xac00
+	hangulCount = 11172
+)
+
+// The values of bidiRunValues.
+var bidiClassNames = []string{"bidiOther", "bidiLeftToRight", "bidiRightToLeft"}
+
+// The tables the package normalizes, case folds and checks bidi classes
+// with, taken from golang.org/x/text so that the package itself doesn't
+// depend on it.
+type textTables struct {
+	// The full canonical decomposition of each codepoint which has one,
+	// apart from the Hangul syllables, which are decomposed
+	// algorithmically.
+	canonical map[rune][]rune
+
+	// The full compatibility decomposition of each codepoint whose
+	// compatibility decomposition differs from its canonical one.
+	compat map[rune][]rune
+
+	// The primary composite each pair of codepoints composes to under
+	// canonical composition, apart from the Hangul syllables.
+	compositions map[[2]rune]rune
+
+	// The full case folding of each codepoint which doesn't fold to
+	// itself.
+	folds map[rune][]rune
+
+	// The bidi class of each codepoint, one of bidiClassNames.
+	bidi []byte
+}
+
+// Reads the text tables out of golang.org/x/text, failing unless its tables
+// are for the given Unicode version.
+func buildTextTables(version string) (*textTables, error) {
+	for _, v := range []struct{ pkg, version string }{
+		{"unicode/norm", norm.Version},
+		{"cases", cases.UnicodeVersion},
+		{"unicode/bidi", bidi.UnicodeVersion},
+	} {
+		if v.version != version {
+			return nil, fmt.Errorf("golang.org/x/text/%s is for Unicode %s, but the inputs are for %s", v.pkg, v.version, version)
+		}
+	}
+
+	t := &textTables{
+		canonical:    make(map[rune][]rune),
+		compat:       make(map[rune][]rune),
+		compositions: make(map[[2]rune]rune),
+		folds:        make(map[rune][]rune),
+		bidi:         make([]byte, unicodeMax+1),
+	}
+	fold := cases.Fold()
+	for cp := rune(0); cp <= unicodeMax; cp++ {
+		if !utf8.ValidRune(cp) {
+			continue
+		}
+		s := string(cp)
+
+		if folded := fold.String(s); folded != s {
+			t.folds[cp] = []rune(folded)
+		}
+
+		props, _ := bidi.LookupRune(cp)
+		switch props.Class() {
+		case bidi.L:
+			t.bidi[cp] = 1
+		case bidi.R, bidi.AL:
+			t.bidi[cp] = 2
+		}
+
+		if cp >= hangulBase && cp < hangulBase+hangulCount {
+			continue
+		}
+		nfd, nfkd := norm.NFD.String(s), norm.NFKD.String(s)
+		if nfd != s {
+			t.canonical[cp] = []rune(nfd)
+		}
+		if nfkd != nfd {
+			t.compat[cp] = []rune(nfkd)
+		}
+
+		// A primary composite is one NFC composes its decomposition
+		// back into. Its decomposition mapping is a pair of the
+		// composition of every rune but the last, and the last.
+		d := []rune(nfd)
+		if len(d) < 2 || norm.NFC.String(nfd) != s {
+			continue
+		}
+		first := []rune(norm.NFC.String(string(d[:len(d)-1])))
+		if len(first) != 1 || norm.NFC.String(string(first[0])+string(d[len(d)-1])) != s {
+			return nil, fmt.Errorf("U+%04X: can't find the pair it composes from", cp)
+		}
+		t.compositions[[2]rune{first[0], d[len(d)-1]}] = cp
+	}
+	return t, nil
+}
+
+func emitTextTables(w *bufio.Writer, t *textTables) {
+	fmt.Fprintln(w, "// The values of bidiRunValues.")
+	fmt.Fprintln(w, "const (")
+	for i, name := range bidiClassNames {
+		fmt.Fprintf(w, "\t%s = %d\n", name, i)
+	}
+	fmt.Fprintln(w, ")")
+	fmt.Fprintln(w)
+
+	emitRuneMapping(w, "canonicalDecomposition", t.canonical)
+	emitRuneMapping(w, "compatDecomposition", t.compat)
+	emitRuneMapping(w, "fold", t.folds)
+
+	pairs := make([]uint64, 0, len(t.compositions))
+	composites := make(map[uint64]rune, len(t.compositions))
+	for pair, cp := range t.compositions {
+		key := uint64(pair[0])<<21 | uint64(pair[1])
+		pairs = append(pairs, key)
+		composites[key] = cp
+	}
+	slices.Sort(pairs)
+	fmt.Fprintln(w, "var compositionPairs = [...]uint64{")
+	for i, v := range pairs {
+		if i%4 == 0 {
+			fmt.Fprint(w, "\t")
+		}
+		fmt.Fprintf(w, "0x%011x,", v)
+		if i%4 == 3 || i+1 == len(pairs) {
+			fmt.Fprintln(w)
+		} else {
+			fmt.Fprint(w, " ")
+		}
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	values := make([]uint32, len(pairs))
+	for i, key := range pairs {
+		values[i] = uint32(composites[key])
+	}
+	emitRuneArray(w, "compositionRunes", values, indexValuesPerLine)
+
+	starts, classes := flatRuns(t.bidi)
+	emitRuneArray(w, "bidiRunStarts", starts, indexValuesPerLine)
+	emitByteArray(w, "bidiRunValues", classes, byteValuesPerLine)
+}
+
+// Emits a mapping from codepoints to sequences of codepoints as three
+// arrays: the sorted codepoints, the offset of each one's sequence in the
+// runes array, with one more offset for the end of the last, and the runes.
+func emitRuneMapping(w *bufio.Writer, name string, m map[rune][]rune) {
+	keys := make([]rune, 0, len(m))
+	for cp := range m {
+		keys = append(keys, cp)
+	}
+	slices.Sort(keys)
+
+	var cps, runes []uint32
+	offsets := []uint16{0}
+	for _, cp := range keys {
+		cps = append(cps, uint32(cp))
+		for _, r := range m[cp] {
+			runes = append(runes, uint32(r))
+		}
+		if len(runes) > maxUint16Value {
+			log.Fatalf("%s table too large for uint16 offsets: %d runes", name, len(runes))
+		}
+		offsets = append(offsets, uint16(len(runes)))
+	}
+	emitRuneArray(w, name+"Keys", cps, indexValuesPerLine)
+	emitUint16Array(w, name+"Offsets", offsets, indexValuesPerLine)
+	emitRuneArray(w, name+"Runes", runes, indexValuesPerLine)
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"testing"
+)
+
+const textTablesTest = `package unicode_id_trie_rle
+
+import (
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/bidi"
+	"golang.org/x/text/unicode/norm"
+)
+
+var forms = []struct {
+	name string
+	form NormalizationForm
+	norm norm.Form
+}{
+	{"NFC", NFC, norm.NFC},
+	{"NFD", NFD, norm.NFD},
+	{"NFKC", NFKC, norm.NFKC},
+	{"NFKD", NFKD, norm.NFKD},
+}
+
+func checkString(t *testing.T, s string) {
+	t.Helper()
+	for _, f := range forms {
+		if got, expected := f.form.apply(s), f.norm.String(s); got != expected {
+			t.Fatalf("%s of %+q is %+q, expected %+q", f.name, s, got, expected)
+		}
+	}
+	if got, expected := foldCase(s), cases.Fold().String(s); got != expected {
+		t.Fatalf("case folding %+q gave %+q, expected %+q", s, got, expected)
+	}
+}
+
+func TestTextTablesMatchXText(t *testing.T) {
+	for cp := rune(0); cp <= utf8.MaxRune; cp++ {
+		if !utf8.ValidRune(cp) {
+			continue
+		}
+		checkString(t, string(cp))
+
+		props, _ := bidi.LookupRune(cp)
+		expected := byte(bidiOther)
+		switch props.Class() {
+		case bidi.L:
+			expected = bidiLeftToRight
+		case bidi.R, bidi.AL:
+			expected = bidiRightToLeft
+		}
+		if got := bidiClass(cp); got != expected {
+			t.Fatalf("U+%04X: bidiClass returned %d, expected %d", cp, got, expected)
+		}
+	}
+
+	// Sequences of starters, marks of different classes, Hangul jamo and
+	// runes which decompose, to exercise reordering and composition.
+	runes := []rune{
+		'a', 'e', 'A', 0x00e9, 0x0301, 0x0300, 0x0323, 0x0327, 0x031b,
+		0x0345, 0x03b1, 0x1f00, 0x0b47, 0x0b3e, 0x0b57, 0x1100, 0x1161,
+		0x11a8, 0xac00, 0xac01, 0x212b, 0xfb01, 0x1e9b, 0x0344, 0x0f73,
+		0x0f71, 0x0f72, 0x0308, 0x05b4, 0x094d, 0x093c, 0x00df, 0x0130,
+	}
+	rng := rand.New(rand.NewSource(1))
+	for range 100000 {
+		s := make([]rune, 1+rng.Intn(6))
+		for i := range s {
+			s[i] = runes[rng.Intn(len(runes))]
+		}
+		checkString(t, string(s))
+	}
+}
+`
+
+// Checks the normalization, case folding and bidi classes the package
+// computes from the text tables against golang.org/x/text, which the tables
+// are taken from, by running a test in a copy of the package which depends
+// on it.
+func TestTextTablesMatchXText(t *testing.T) {
+	dir := newTestPackage(t)
+	ccc, err := filepath.Abs("../../DerivedCombiningClass.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runGenerator(t, dir, "-i", ccc)
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Fatal("no build info")
+	}
+	version := ""
+	for _, dep := range info.Deps {
+		if dep.Path == "golang.org/x/text" {
+			version = dep.Version
+		}
+	}
+	if version == "" {
+		t.Fatal("golang.org/x/text isn't a dependency")
+	}
+
+	cmd := exec.Command("go", "mod", "edit", "-go=1.26.0", "-require=golang.org/x/text@"+version)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go mod edit failed: %v\n%s", err, out)
+	}
+	sums, err := os.ReadFile("go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	existing, err := os.ReadFile(filepath.Join(dir, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.sum"), append(existing, sums...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The package only compiles in the text tables with the identnorm tag.
+	t.Setenv("GOFLAGS", "-tags=identnorm")
+	runPackageTest(t, dir, "text_test.go", textTablesTest)
+}
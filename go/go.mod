@@ -1,3 +1,5 @@
 module github.com/aeldidi/unicode-id-trie-rle/go
 
 go 1.22
+
+require github.com/clipperhouse/uax29/v2 v2.7.0
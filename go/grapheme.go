@@ -0,0 +1,21 @@
+//go:build identgrapheme
+
+package unicode_id_trie_rle
+
+import "github.com/clipperhouse/uax29/v2/graphemes"
+
+// Returns the number of extended grapheme clusters in s, as segmented by
+// UAX #29, along with whether s is a unicode identifier, as defined by
+// IsIdent. This lets a tool display or truncate an identifier without
+// splitting a letter from its combining marks.
+//
+// The segmentation is done by github.com/clipperhouse/uax29, which keeps the
+// `Grapheme_Cluster_Break` data up to date, so it's only compiled in with the
+// identgrapheme build tag.
+func IdentGraphemeCount(s string) (int, bool) {
+	count := 0
+	for clusters := graphemes.FromString(s); clusters.Next(); {
+		count++
+	}
+	return count, IsIdentString(s)
+}
@@ -0,0 +1,37 @@
+//go:build identgrapheme
+
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestIdentGraphemeCount(t *testing.T) {
+	cases := []struct {
+		s     string
+		count int
+		ok    bool
+	}{
+		{"caf\u00e9", 4, true},
+		{"cafe\u0301", 4, true},
+		{"e\u0301\u0323x", 2, true},
+		{"\u1112\u1161\u11ab", 1, true},
+		{"\ud55c\uae00", 2, true},
+		// Devanagari "namaste", in which the conjunct of SA, the
+		// virama and TA is one cluster.
+		{"\u0928\u092e\u0938\u094d\u0924\u0947", 3, true},
+		{"\u0915\u094d\u200d\u0937", 1, true},
+		{"\u0e01\u0e33", 1, true},
+		{"a\u200cb", 2, true},
+		{"1a", 2, false},
+		{"a\r\nb", 3, false},
+		{"\U0001f1e8\U0001f1e6\U0001f1e8", 2, false},
+		// An emoji ZWJ sequence, kept together by rule GB11.
+		{"\U0001f469\u200d\U0001f4bb", 1, false},
+		{"", 0, false},
+	}
+	for _, c := range cases {
+		count, ok := IdentGraphemeCount(c.s)
+		if count != c.count || ok != c.ok {
+			t.Errorf("IdentGraphemeCount(%q) = (%d, %v), expected (%d, %v)", c.s, count, ok, c.count, c.ok)
+		}
+	}
+}
@@ -1,4 +1,5 @@
-//go:generate go run github.com/aeldidi/unicode-id-trie-rle/go/generate -i ../DerivedCoreProperties.txt -o ident_generated.go
+//go:generate go -C generate run . -i ../../DerivedCoreProperties.txt -i ../../PropList.txt -i ../../Scripts.txt -i ../../DerivedGeneralCategory.txt -i ../../DerivedJoiningType.txt -i ../../DerivedCombiningClass.txt -o ../ident_generated.go -blob ../ident_blob_generated.go -full ../ident_full_generated.go -text ../text_generated.go
+//go:generate go test -run ^TestASCIITableMatchesDerivedData$ .
 package unicode_id_trie_rle
 
 import "sort"
@@ -16,6 +17,10 @@ const (
 	Continue
 )
 
+// The version of the Unicode Character Database the tables were generated
+// from, such as "17.0.0".
+const UnicodeVersion = unicodeVersion
+
 const (
 	startCodepoint = 0x80
 	blockMask      = (1 << shift) - 1
@@ -51,9 +56,12 @@ func loadLeaf(idx uint16) leaf {
 func leafValue(l leaf, offset uint16) IdentifierClass {
 	start := int(l.offset)
 	end := start + int(l.len)
-	runs := leafRunStarts[start:end]
-	values := leafRunValues[start:end]
+	return runsValue(leafRunStarts[start:end], leafRunValues[start:end], offset)
+}
 
+// Returns the class at offset within a leaf's block, given the starts and
+// classes of the leaf's runs.
+func runsValue(runs []uint16, values []IdentifierClass, offset uint16) IdentifierClass {
 	idx := sort.Search(len(runs), func(i int) bool {
 		return runs[i] > offset
 	})
@@ -63,27 +71,158 @@ func leafValue(l leaf, offset uint16) IdentifierClass {
 	return values[idx-1]
 }
 
+// Returns the class at offset within the block covered by the leaf at idx,
+// like leafValue, but decoding the leaf's runs from the varint-encoded run
+// stream, where each run is its distance from the start of the one before
+// it, as a uvarint, followed by its class. The runs are read in order, so
+// this trades speed for a smaller table.
+func streamLeafValue(idx, offset uint16) IdentifierClass {
+	runs := runStream[runStreamOffsets[idx]:runStreamOffsets[idx+1]]
+	value := Other
+	start := uint16(0)
+	for i := 0; i < len(runs); {
+		var delta uint16
+		for shift := 0; ; shift += 7 {
+			b := runs[i]
+			i++
+			delta |= uint16(b&0x7f) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		start += delta
+		if start > offset {
+			break
+		}
+		value = IdentifierClass(runs[i])
+		i++
+	}
+	return value
+}
+
+// Must match mphHash in generate/main.go.
+func mphHash(key, seed uint32) uint32 {
+	h := key*0x9e3779b1 ^ seed*0x85ebca6b
+	h ^= h >> 15
+	h *= 0x2c1b3c6d
+	h ^= h >> 12
+	return h
+}
+
+// Looks up the leaf for a block through the two-level tables.
+func trieBlockLeaf(block uint32) uint16 {
+	return levelBlockLeaf(level1Table[:], level2Tables[:], lowerBits, block)
+}
+
+// Looks up the leaf for a block through the minimal perfect hash.
+func mphBlockLeaf(block uint32) uint16 {
+	return hashBlockLeaf(mphSeeds[:], mphKeys[:], mphLeaves[:], mphDefaultLeaf, block)
+}
+
+// Looks up the leaf for a block in level tables, whose level2 tables each
+// have 1<<lowerBits entries.
+func levelBlockLeaf(level1, level2 []uint16, lowerBits uint, block uint32) uint16 {
+	top := block >> lowerBits
+	bottom := block & (1<<lowerBits - 1)
+	return level2[int(level1[top])<<lowerBits+int(bottom)]
+}
+
+// Looks up the leaf for a block in a minimal perfect hash, whose blocks
+// without a slot of their own all use defaultLeaf.
+func hashBlockLeaf(seeds, keys, leaves []uint16, defaultLeaf uint16, block uint32) uint16 {
+	n := uint32(len(keys))
+	seed := uint32(seeds[mphHash(block, 0)%n])
+	slot := mphHash(block, seed) % n
+	if uint32(keys[slot]) != block {
+		return defaultLeaf
+	}
+	return leaves[slot]
+}
+
+// U+FFFD REPLACEMENT CHARACTER, which utf8.DecodeRune and friends return for
+// invalid UTF-8. Its class is Other, so an identifier never contains it,
+// which is how the validators for strings and bytes reject invalid UTF-8.
+const ReplacementChar = 0xfffd
+
 // Returns whether the codepoint specified has the properties `XID_Start` or
-// `XID_Continue`.
+// `XID_Continue`. ReplacementChar, along with anything outside the Unicode
+// codespace, is Other.
 func UnicodeIdentifierClass(cp rune) IdentifierClass {
-	if cp < 0 {
+	if cp < minCodepoint || cp > maxCodepoint {
 		return Other
 	}
 	if cp < startCodepoint {
 		return asciiTable[cp]
 	}
-	if cp >= 0x100000 {
-		return Other
+	return trieClass(cp) & (Start | Continue)
+}
+
+// The bits of the bitfield returned by RawClass, one for each property the
+// generated tables hold. By default, the tables hold only the two properties
+// UnicodeIdentifierClass reports, so these match Start and Continue. The
+// generator's -custom flag adds properties, each with a Raw constant of its
+// own.
+const (
+	RawXIDStart uint16 = 1 << iota
+	RawXIDContinue
+)
+
+// Returns every property bit the generated tables hold for cp, using the
+// RawXIDStart and RawXIDContinue constants. Unlike UnicodeIdentifierClass,
+// which is narrowed to Start and Continue, this also carries the properties
+// added with the generator's -custom flag, which are never set below U+0080.
+func RawClass(cp rune) uint16 {
+	if cp < minCodepoint || cp > maxCodepoint {
+		return 0
 	}
+	if cp < startCodepoint {
+		return uint16(asciiTable[cp])
+	}
+	return uint16(trieClass(cp))
+}
 
-	block := uint32(cp) >> shift
-	top := block >> lowerBits
-	bottom := block & lowerMask
-	level2Idx := level1Table[top]
-	leafIdx := level2Tables[int(level2Idx)*lowerSize+int(bottom)]
-	l := loadLeaf(leafIdx)
-	offset := uint16(uint32(cp) & blockMask)
-	return leafValue(l, offset)
+// Looks up the class of a codepoint in the generated tables, which cover
+// startCodepoint..maxCodepoint.
+func trieClass(cp rune) IdentifierClass {
+	if useVarintRuns {
+		return streamLeafValue(compiledTables{}.blockLeaf(uint32(cp)>>shift), uint16(uint32(cp)&blockMask))
+	}
+	// compiledTables is called directly, rather than through sourceClass,
+	// so that the call isn't through the interface.
+	return runsValue(compiledTables{}.runsOf(cp))
+}
+
+// Returns whether the codepoint specified has the properties `XID_Start` and
+// `XID_Continue`, as separate booleans rather than an IdentifierClass.
+func StartContinue(cp rune) (start, cont bool) {
+	class := UnicodeIdentifierClass(cp)
+	return class&Start != 0, class&Continue != 0
+}
+
+// Returns whether cp has the `XID_Continue` property but not `XID_Start`,
+// like digits and combining marks, which can appear anywhere in an identifier
+// except at its start.
+func IsContinueOnly(cp rune) bool {
+	return UnicodeIdentifierClass(cp) == Continue
+}
+
+// Returns whether cp has the `XID_Start` property but not `XID_Continue`, and
+// so can only begin an identifier. Every `XID_Start` character is also
+// `XID_Continue`, so this is always false under the default rules; it's here
+// to complete IsContinueOnly, and Profile.IsStartOnly can be true.
+func IsStartOnly(cp rune) bool {
+	return UnicodeIdentifierClass(cp) == Start
+}
+
+// Returns the class of cp, as UnicodeIdentifierClass does, along with whether
+// cp is assigned, that is, whether its general category is anything but
+// `Cn`. This tells an unassigned codepoint apart from an assigned one which
+// simply isn't part of identifiers, like punctuation.
+//
+// Assignment is generated from DerivedGeneralCategory.txt, alongside the
+// identifier classes, so both are always for the same Unicode version.
+func ClassifyWithAssignment(cp rune) (IdentifierClass, bool) {
+	return UnicodeIdentifierClass(cp), propertiesOf(cp)&propAssigned != 0
 }
 
 // U+200C ZERO WIDTH NON-JOINER and U+200D ZERO WIDTH JOINER are
@@ -93,6 +232,12 @@ const (
 	ZWJ  = 0x200d
 )
 
+// Returns whether cp is one of the join controls, ZWNJ or ZWJ, which IsIdent
+// allows inside an identifier but not at its end.
+func IsJoinControl(cp rune) bool {
+	return cp == ZWNJ || cp == ZWJ
+}
+
 // Checks if a codepoint array is a unicode identifier, defined by
 // Unicode Standard Annex #31.
 //
@@ -109,15 +254,113 @@ func IsIdent(s []rune) bool {
 		return false
 	}
 
-	for i, c := range s[1:] {
-		p := UnicodeIdentifierClass(c)
-		if p&Continue == 0 {
-			// the two special characters are only allowed in the
-			// middle, not the end.
-			if (c != ZWNJ && c != ZWJ) || i+1 == len(s) {
+	last := len(s) - 1
+	for i := 1; i <= last; i++ {
+		c := s[i]
+		// the two special characters are only allowed in the middle, not
+		// the end. They're checked before the class, since they also
+		// carry the `XID_Continue` property.
+		if IsJoinControl(c) {
+			if i == last {
 				return false
 			}
+			continue
+		}
+		if UnicodeIdentifierClass(c)&Continue == 0 {
+			return false
 		}
 	}
 	return true
 }
+
+// Returns the number of runes in s along with whether s is a unicode
+// identifier, as defined by IsIdent, for parsers which need both.
+func IsIdentLen(s []rune) (n int, ok bool) {
+	return len(s), IsIdent(s)
+}
+
+// Validates an identifier one rune at a time, for callers which can't
+// provide the whole identifier as a []rune.
+type identScanner struct {
+	count        int
+	trailingJoin bool
+}
+
+// Feeds the next rune of the identifier to the scanner, returning false once
+// the runes seen so far can't begin a valid identifier.
+func (v *identScanner) next(c rune) bool {
+	v.count++
+	if v.count == 1 {
+		return UnicodeIdentifierClass(c)&Start != 0
+	}
+
+	v.trailingJoin = IsJoinControl(c)
+	return v.trailingJoin || UnicodeIdentifierClass(c)&Continue != 0
+}
+
+// Returns whether the runes fed to the scanner form a valid identifier,
+// provided next never returned false.
+func (v *identScanner) valid() bool {
+	return v.count > 0 && !v.trailingJoin
+}
+
+// Returns the class of every codepoint in s, along with whether s is a
+// unicode identifier as defined by IsIdent, in a single pass. Each class is
+// the codepoint's own, so join controls are reported as Continue even when
+// their position makes s invalid.
+func ClassifyAll(s []rune) ([]IdentifierClass, bool) {
+	classes := make([]IdentifierClass, len(s))
+	var v identScanner
+	ok := true
+	for i, c := range s {
+		classes[i] = UnicodeIdentifierClass(c)
+		ok = ok && v.next(c)
+	}
+	return classes, ok && v.valid()
+}
+
+// Checks if the runes returned by next, until it returns false, form a unicode
+// identifier, as defined by IsIdent. This suits any source of runes, like a
+// custom cursor. next isn't called again once a rune can't be part of the
+// identifier.
+func IsIdentFunc(next func() (rune, bool)) bool {
+	var v identScanner
+	for {
+		c, ok := next()
+		if !ok {
+			return v.valid()
+		}
+		if !v.next(c) {
+			return false
+		}
+	}
+}
+
+// Returns how many runes at the start of s are part of an identifier, and
+// whether all of them are, for lexers which scan an identifier a chunk at a
+// time. When started is true, s continues an identifier begun in an earlier
+// chunk, so s[0] only needs the `XID_Continue` property; otherwise s[0] must
+// begin the identifier.
+//
+// Join controls followed by a rune which ends the identifier aren't counted,
+// since they can't end one. Join controls at the end of s are counted, as
+// the next chunk may continue the identifier, so a lexer must still reject
+// the identifier if that chunk doesn't.
+func ContinueIdent(s []rune, started bool) (consumed int, stillValid bool) {
+	for i, c := range s {
+		first := !started && i == 0
+		if IsJoinControl(c) && !first {
+			continue
+		}
+
+		want := Continue
+		if first {
+			want = Start
+		}
+		if UnicodeIdentifierClass(c)&want == 0 {
+			return consumed, false
+		}
+		consumed = i + 1
+	}
+	return len(s), len(s) > 0 || started
+}
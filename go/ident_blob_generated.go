@@ -0,0 +1,40 @@
+// Code generated by "generate -i ../../DerivedCoreProperties.txt -i ../../PropList.txt -i ../../Scripts.txt -i ../../DerivedGeneralCategory.txt -i ../../DerivedJoiningType.txt -i ../../DerivedCombiningClass.txt -o ../ident_generated.go -blob ../ident_blob_generated.go -full ../ident_full_generated.go -text ../text_generated.go"; DO NOT EDIT.
+
+//go:build identblob
+
+package unicode_id_trie_rle
+
+import _ "embed"
+
+const (
+	minCodepoint = 0x0000
+	maxCodepoint = 0xfffff
+	shift = 10
+	blockCount = 1024
+	lowerBits = 4
+	lowerSize = 16
+	useMPH = false
+	mphDefaultLeaf = 9
+	useVarintRuns = false
+	unicodeVersion = "17.0.0"
+	customBits = 0x00
+)
+
+// The classes of U+0000..U+007F in the data the tables were generated
+// from, which SelfTest checks asciiTable against.
+var asciiClasses = [...]IdentifierClass{
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x00, 0x00, 0x00, 0x00, 0x02,
+	0x00, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+}
+
+//go:embed ident_blob_generated.bin
+var tablesBlob string
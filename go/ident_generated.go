@@ -1,13 +1,39 @@
-// Code generated by "generate -i ../DerivedCoreProperties.txt -o ident_generated.go"; DO NOT EDIT.
+// Code generated by "generate -i ../../DerivedCoreProperties.txt -i ../../PropList.txt -i ../../Scripts.txt -i ../../DerivedGeneralCategory.txt -i ../../DerivedJoiningType.txt -i ../../DerivedCombiningClass.txt -o ../ident_generated.go -blob ../ident_blob_generated.go -full ../ident_full_generated.go -text ../text_generated.go"; DO NOT EDIT.
+
+//go:build !identblob
+
 package unicode_id_trie_rle
 
 const (
+	minCodepoint = 0x0000
+	maxCodepoint = 0xfffff
 	shift = 10
 	blockCount = 1024
 	lowerBits = 4
 	lowerSize = 16
+	useMPH = false
+	mphDefaultLeaf = 9
+	useVarintRuns = false
+	unicodeVersion = "17.0.0"
+	customBits = 0x00
 )
 
+// The classes of U+0000..U+007F in the data the tables were generated
+// from, which SelfTest checks asciiTable against.
+var asciiClasses = [...]IdentifierClass{
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x00, 0x00, 0x00, 0x00, 0x02,
+	0x00, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+	0x03, 0x03, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00,
+}
+
 var leafOffsets = [...]uint16{
 	0x0000, 0x002c, 0x0070, 0x013d, 0x01eb, 0x0232, 0x0257, 0x029b,
 	0x02de, 0x030c, 0x030e, 0x0334, 0x0351, 0x0353, 0x0357, 0x0373,
@@ -480,3 +506,82 @@ var level1Table = [...]uint16{
 	0x000d, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c,
 }
 
+var mphSeeds = [...]uint16{
+	0x0002, 0x0004, 0x0000, 0x0007, 0x0000, 0x0001, 0x0000, 0x0004,
+	0x0003, 0x0000, 0x0000, 0x0000, 0x0000, 0x0001, 0x000a, 0x0001,
+	0x0001, 0x0001, 0x0000, 0x0001, 0x0003, 0x0000, 0x0003, 0x0000,
+	0x0000, 0x0000, 0x0002, 0x0000, 0x0001, 0x0000, 0x0002, 0x0001,
+	0x0000, 0x0001, 0x0003, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0006, 0x0001, 0x0003, 0x0001, 0x0006, 0x0000, 0x0001, 0x0001,
+	0x0000, 0x0008, 0x0001, 0x0000, 0x0001, 0x0003, 0x0005, 0x0001,
+	0x0000, 0x0001, 0x0007, 0x0003, 0x0001, 0x0002, 0x0003, 0x0002,
+	0x0002, 0x0000, 0x0000, 0x0001, 0x0002, 0x0000, 0x0001, 0x0001,
+	0x0000, 0x0003, 0x0003, 0x0002, 0x0001, 0x0002, 0x0002, 0x0000,
+	0x0000, 0x0004, 0x0000, 0x0001, 0x0000, 0x000b, 0x0001, 0x0002,
+	0x0001, 0x0000, 0x0002, 0x0007, 0x0003, 0x0000, 0x0000, 0x0007,
+	0x0000, 0x0005, 0x0000, 0x0008, 0x0009, 0x0001, 0x0007, 0x0000,
+	0x0003, 0x0002, 0x0001, 0x000f, 0x0007, 0x0002, 0x0000, 0x0000,
+	0x0009, 0x000b, 0x0000, 0x0000, 0x0000, 0x0002, 0x0000, 0x0004,
+	0x0000, 0x0005, 0x0000, 0x0000, 0x0000, 0x0000, 0x0003, 0x0001,
+	0x0000, 0x0009, 0x0001, 0x0032, 0x0018, 0x0000, 0x0001, 0x0011,
+	0x0023, 0x0015, 0x0000, 0x0000, 0x0001, 0x0000, 0x0009, 0x000a,
+	0x0029, 0x0050, 0x0017, 0x0004, 0x0001, 0x0001, 0x0002, 0x0001,
+	0x0001, 0x001f, 0x0000, 0x0002, 0x0005, 0x0000, 0x0000, 0x0000,
+	0x0005, 0x0000, 0x0061, 0x0002, 0x0000, 0x0207, 0x0003, 0x0000,
+	0x004c, 0x0000, 0x0001,
+}
+
+var mphKeys = [...]uint16{
+	0x0099, 0x0002, 0x0046, 0x00b3, 0x0005, 0x0076, 0x0026, 0x001d,
+	0x008b, 0x0089, 0x0029, 0x0028, 0x00c6, 0x0094, 0x0042, 0x007b,
+	0x005e, 0x001b, 0x00bb, 0x007e, 0x0024, 0x002a, 0x004c, 0x00ca,
+	0x008f, 0x00a4, 0x00b0, 0x0018, 0x00ac, 0x0025, 0x0004, 0x0030,
+	0x0062, 0x0034, 0x00c3, 0x002c, 0x0021, 0x00af, 0x0049, 0x00c2,
+	0x00b2, 0x0090, 0x002e, 0x009d, 0x0001, 0x001a, 0x00c0, 0x0013,
+	0x00c1, 0x003f, 0x008e, 0x0086, 0x004d, 0x0075, 0x001f, 0x0080,
+	0x000b, 0x00a2, 0x00a5, 0x0098, 0x0016, 0x0032, 0x009f, 0x009a,
+	0x009b, 0x004f, 0x004b, 0x00ba, 0x0077, 0x0050, 0x00a6, 0x0083,
+	0x0061, 0x0058, 0x00c8, 0x00b7, 0x0078, 0x0045, 0x0003, 0x000d,
+	0x0048, 0x002f, 0x00c9, 0x00b4, 0x00a7, 0x001c, 0x00be, 0x0012,
+	0x0014, 0x0073, 0x0085, 0x0027, 0x005d, 0x008a, 0x0092, 0x000f,
+	0x0079, 0x00c4, 0x008c, 0x004e, 0x00cd, 0x0008, 0x00b8, 0x00a0,
+	0x000e, 0x002b, 0x006f, 0x007a, 0x0010, 0x006c, 0x0006, 0x0017,
+	0x0074, 0x005b, 0x0000, 0x00ae, 0x0060, 0x00b6, 0x0095, 0x0380,
+	0x0097, 0x0041, 0x0044, 0x0020, 0x00b1, 0x0087, 0x0047, 0x00a3,
+	0x003e, 0x000c, 0x00a9, 0x00b5, 0x005a, 0x002d, 0x008d, 0x0088,
+	0x0035, 0x0093, 0x00b9, 0x0023, 0x0096, 0x0011, 0x0051, 0x00cc,
+	0x0031, 0x0081, 0x0082, 0x00aa, 0x0015, 0x001e, 0x006b, 0x0007,
+	0x0084, 0x009e, 0x0019, 0x00c7, 0x00a1, 0x0033, 0x0040, 0x009c,
+	0x00a8, 0x00cb, 0x005f, 0x0091, 0x0022, 0x00ab, 0x0063, 0x00ad,
+	0x005c, 0x0043, 0x00c5,
+}
+
+var mphLeaves = [...]uint16{
+	0x000c, 0x0002, 0x0019, 0x0034, 0x0005, 0x002b, 0x000c, 0x000c,
+	0x000c, 0x000c, 0x000e, 0x000c, 0x000c, 0x000c, 0x0015, 0x0030,
+	0x000c, 0x000c, 0x0036, 0x0031, 0x000c, 0x000f, 0x000c, 0x000c,
+	0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x0004, 0x000c,
+	0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x001c, 0x000c,
+	0x000c, 0x000c, 0x000c, 0x000c, 0x0001, 0x000c, 0x000c, 0x000d,
+	0x000c, 0x0012, 0x000c, 0x000c, 0x001e, 0x002a, 0x000c, 0x000c,
+	0x000a, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c,
+	0x000c, 0x000c, 0x001d, 0x0035, 0x002c, 0x001f, 0x000c, 0x000c,
+	0x000c, 0x0021, 0x000c, 0x000c, 0x002d, 0x0018, 0x0003, 0x000c,
+	0x001b, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x0037, 0x000c,
+	0x000c, 0x0028, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c,
+	0x002e, 0x0038, 0x000c, 0x000c, 0x0039, 0x0008, 0x000c, 0x000c,
+	0x000c, 0x000c, 0x0027, 0x002f, 0x000c, 0x0026, 0x0006, 0x000c,
+	0x0029, 0x0023, 0x0000, 0x0033, 0x000c, 0x000c, 0x000c, 0x003a,
+	0x000c, 0x0014, 0x0017, 0x000c, 0x000c, 0x000c, 0x001a, 0x000c,
+	0x0011, 0x000b, 0x0032, 0x000c, 0x0022, 0x000c, 0x000c, 0x000c,
+	0x0010, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x0020, 0x000c,
+	0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x0025, 0x0007,
+	0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x0013, 0x000c,
+	0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x000c, 0x0024, 0x000c,
+	0x000c, 0x0016, 0x000c,
+}
+
+var runStreamOffsets [0]uint16
+
+var runStream [0]byte
+
@@ -6,9 +6,12 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
+	"unicode"
+	"unicode/utf8"
 )
 
 const maxScalar = 0x110000
@@ -48,6 +51,13 @@ func parseRangeField(field string) (uint32, uint32, error) {
 
 func derivedIdentifierTable(t *testing.T) []IdentifierClass {
 	t.Helper()
+	return derivedPropertyTable(t, "XID_Start", "XID_Continue")
+}
+
+// Returns the class of every scalar value, with the Start bit set by the
+// property start and the Continue bit set by the property cont.
+func derivedPropertyTable(t *testing.T, start, cont string) []IdentifierClass {
+	t.Helper()
 
 	path := derivedDataPath(t)
 	file, err := os.Open(path)
@@ -74,10 +84,10 @@ func derivedIdentifierTable(t *testing.T) []IdentifierClass {
 
 		prop := strings.TrimSpace(parts[1])
 		var bits IdentifierClass
-		if strings.Contains(prop, "XID_Start") {
+		if prop == start {
 			bits |= Start
 		}
-		if strings.Contains(prop, "XID_Continue") {
+		if prop == cont {
 			bits |= Continue
 		}
 		if bits == 0 {
@@ -107,6 +117,17 @@ func derivedIdentifierTable(t *testing.T) []IdentifierClass {
 	return table
 }
 
+// asciiTable is written by hand rather than generated, so this makes sure it
+// still agrees with the derived data. It runs as part of go generate.
+func TestASCIITableMatchesDerivedData(t *testing.T) {
+	table := derivedIdentifierTable(t)
+	for c := 0; c < startCodepoint; c++ {
+		if asciiTable[c] != table[c] {
+			t.Errorf("asciiTable disagrees with the derived data at %q: expected %d, got %d", rune(c), table[c], asciiTable[c])
+		}
+	}
+}
+
 func TestUnicodeIdentifierClassMatchesDerivedData(t *testing.T) {
 	table := derivedIdentifierTable(t)
 	for cp := rune(0); cp <= 0x10ffff; cp++ {
@@ -124,3 +145,490 @@ func TestUnicodeIdentifierClassMatchesDerivedData(t *testing.T) {
 		}
 	}
 }
+
+func TestMPHBlockLeafMatchesTrie(t *testing.T) {
+	for block := uint32(0); block < blockCount; block++ {
+		trie := trieBlockLeaf(block)
+		mph := mphBlockLeaf(block)
+		if trie != mph {
+			t.Fatalf("leaf mismatch for block %d: trie %d, mph %d", block, trie, mph)
+		}
+	}
+}
+
+func TestRunStreamMatchesRunArrays(t *testing.T) {
+	if !useVarintRuns {
+		t.Skip("the run stream is only generated with -varint")
+	}
+	for cp := rune(startCodepoint); cp <= maxCodepoint; cp++ {
+		leafIdx := trieBlockLeaf(uint32(cp) >> shift)
+		offset := uint16(uint32(cp) & blockMask)
+		if got, expected := streamLeafValue(leafIdx, offset), leafValue(loadLeaf(leafIdx), offset); got != expected {
+			t.Fatalf("U+%04X: run stream has class %d, run arrays have %d", cp, got, expected)
+		}
+	}
+}
+
+// Compares the two encodings of the runs, which hold the same classes in
+// len(runStream) bytes and 3*len(leafRunStarts) bytes respectively.
+func BenchmarkLeafValue(b *testing.B) {
+	b.Run("arrays", func(b *testing.B) {
+		benchmarkLeafValue(b, func(idx, offset uint16) IdentifierClass {
+			return leafValue(loadLeaf(idx), offset)
+		})
+	})
+	b.Run("varint", func(b *testing.B) {
+		if !useVarintRuns {
+			b.Skip("the run stream is only generated with -varint")
+		}
+		benchmarkLeafValue(b, streamLeafValue)
+	})
+}
+
+func benchmarkLeafValue(b *testing.B, lookup func(idx, offset uint16) IdentifierClass) {
+	var sink IdentifierClass
+	for i := 0; i < b.N; i++ {
+		cp := uint32(startCodepoint + i%(maxCodepoint+1-startCodepoint))
+		sink ^= lookup(trieBlockLeaf(cp>>shift), uint16(cp&blockMask))
+	}
+	_ = sink
+}
+
+func benchmarkBlockLeaf(b *testing.B, lookup func(uint32) uint16) {
+	var sink uint16
+	for i := 0; i < b.N; i++ {
+		sink ^= lookup(uint32(i) % blockCount)
+	}
+	_ = sink
+}
+
+func BenchmarkBlockLeaf(b *testing.B) {
+	b.Run("trie", func(b *testing.B) { benchmarkBlockLeaf(b, trieBlockLeaf) })
+	b.Run("mph", func(b *testing.B) { benchmarkBlockLeaf(b, mphBlockLeaf) })
+}
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// The ASCII fast path is written by hand, so SelfTest must notice when it
+// drifts from the data, such as by allowing '$' in identifiers.
+func TestSelfTestASCIIMismatch(t *testing.T) {
+	saved := asciiTable
+	t.Cleanup(func() { asciiTable = saved })
+	asciiTable['$'] = Continue
+	if err := SelfTest(); err == nil {
+		t.Fatal("SelfTest accepted an asciiTable which differs from the data")
+	}
+}
+
+func TestIsIdent(t *testing.T) {
+	cases := []struct {
+		s        []rune
+		expected bool
+	}{
+		{[]rune("id_42"), true},
+		{[]rune("_id"), false},
+		{[]rune("4id"), false},
+		{[]rune{}, false},
+		{[]rune{'a', ZWNJ, 'b'}, true},
+		{[]rune{'a', ZWJ, 'b'}, true},
+		{[]rune{'a', ZWNJ}, false},
+		{[]rune{'a', ZWJ}, false},
+		{[]rune{'a', 'b', ZWNJ, ZWJ}, false},
+		{[]rune{ZWNJ}, false},
+	}
+	for _, c := range cases {
+		if got := IsIdent(c.s); got != c.expected {
+			t.Errorf("IsIdent(%q) = %v, expected %v", string(c.s), got, c.expected)
+		}
+	}
+}
+
+var benchmarkIdents = [][]rune{
+	[]rune("identifier"),
+	[]rune("snake_case_name_42"),
+	[]rune("идентификатор"),
+	[]rune("変数名"),
+	[]rune{0x0915, 0x094d, ZWNJ, 0x0937},
+}
+
+func BenchmarkIsIdent(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, s := range benchmarkIdents {
+			IsIdent(s)
+		}
+	}
+}
+
+// Builds a RangeTable holding the sorted codepoints cps.
+func newRangeTable(cps []rune) *unicode.RangeTable {
+	table := new(unicode.RangeTable)
+	for i := 0; i < len(cps); {
+		j := i + 1
+		for j < len(cps) && cps[j] == cps[j-1]+1 {
+			j++
+		}
+		lo, hi := cps[i], cps[j-1]
+		if hi <= 0xffff {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: 1})
+			if hi <= unicode.MaxLatin1 {
+				table.LatinOffset++
+			}
+		} else {
+			table.R32 = append(table.R32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+		}
+		i = j
+	}
+	return table
+}
+
+// Compares UnicodeIdentifierClass against unicode.Is on RangeTables of the
+// same properties, which is what the package would be without the trie. The
+// table-bytes metric is the size of the static data each lookup reads: the
+// trie's level tables and runs, or the ranges of the two RangeTables.
+func BenchmarkRangeTable(b *testing.B) {
+	var starts, continues []rune
+	for cp := rune(0); cp <= unicode.MaxRune; cp++ {
+		class := UnicodeIdentifierClass(cp)
+		if class&Start != 0 {
+			starts = append(starts, cp)
+		}
+		if class&Continue != 0 {
+			continues = append(continues, cp)
+		}
+	}
+	start, cont := newRangeTable(starts), newRangeTable(continues)
+	rangeTableBytes := 0
+	for _, table := range []*unicode.RangeTable{start, cont} {
+		rangeTableBytes += 6*len(table.R16) + 12*len(table.R32)
+	}
+	trieBytes := len(asciiTable) + 2*len(leafOffsets) + 3*len(leafRunStarts) +
+		2*len(level2Tables) + 2*len(level1Table)
+
+	rangeTableClass := func(cp rune) IdentifierClass {
+		class := Other
+		if unicode.Is(start, cp) {
+			class |= Start
+		}
+		if unicode.Is(cont, cp) {
+			class |= Continue
+		}
+		return class
+	}
+	for _, input := range []struct {
+		name        string
+		first, last rune
+	}{
+		{"ascii", 0, 0x7f},
+		{"bmp", 0x80, 0xffff},
+		{"astral", 0x10000, 0x3ffff},
+	} {
+		span := int(input.last - input.first + 1)
+		run := func(name string, lookup func(rune) IdentifierClass, tableBytes int) {
+			b.Run(input.name+"/"+name, func(b *testing.B) {
+				var sink IdentifierClass
+				for i := 0; i < b.N; i++ {
+					sink ^= lookup(input.first + rune(i%span))
+				}
+				_ = sink
+				b.ReportMetric(float64(tableBytes), "table-bytes")
+			})
+		}
+		run("trie", UnicodeIdentifierClass, trieBytes)
+		run("rangetable", rangeTableClass, rangeTableBytes)
+	}
+}
+
+func TestIsIdentLen(t *testing.T) {
+	cases := []struct {
+		s  []rune
+		n  int
+		ok bool
+	}{
+		{[]rune("foo"), 3, true},
+		{[]rune("été"), 3, true},
+		{[]rune{'a', ZWNJ, 'b'}, 3, true},
+		{[]rune{}, 0, false},
+		{nil, 0, false},
+		{[]rune("1ab"), 3, false},
+		{[]rune{'a', ZWJ}, 2, false},
+	}
+	for _, c := range cases {
+		n, ok := IsIdentLen(c.s)
+		if n != c.n || ok != c.ok {
+			t.Errorf("IsIdentLen(%q) = (%d, %v), expected (%d, %v)", string(c.s), n, ok, c.n, c.ok)
+		}
+	}
+}
+
+func TestRawClass(t *testing.T) {
+	if RawXIDStart != uint16(Start) || RawXIDContinue != uint16(Continue) {
+		t.Fatalf("RawXIDStart and RawXIDContinue are %d and %d, expected %d and %d", RawXIDStart, RawXIDContinue, Start, Continue)
+	}
+
+	for cp := rune(-1); cp <= 0x110000; cp++ {
+		raw := RawClass(cp)
+		if class := UnicodeIdentifierClass(cp); IdentifierClass(raw&(RawXIDStart|RawXIDContinue)) != class {
+			t.Fatalf("RawClass(U+%04X) = %#x, which disagrees with class %d", cp, raw, class)
+		}
+	}
+}
+
+func TestStartContinue(t *testing.T) {
+	cases := []struct {
+		cp          rune
+		start, cont bool
+	}{
+		{'a', true, true},
+		{'é', true, true},
+		{'0', false, true},
+		{'_', false, true},
+		{0x0301, false, true},
+		{'-', false, false},
+		{' ', false, false},
+		{0x100000, false, false},
+	}
+	for _, c := range cases {
+		start, cont := StartContinue(c.cp)
+		if start != c.start || cont != c.cont {
+			t.Errorf("StartContinue(U+%04X) = (%v, %v), expected (%v, %v)", c.cp, start, cont, c.start, c.cont)
+		}
+	}
+}
+
+func TestIsContinueOnly(t *testing.T) {
+	cases := []struct {
+		cp       rune
+		expected bool
+	}{
+		{'0', true},
+		{'_', true},
+		{0x0301, true},
+		{0x0663, true},
+		{'a', false},
+		{'-', false},
+		{0x4e00, false},
+	}
+	for _, c := range cases {
+		if got := IsContinueOnly(c.cp); got != c.expected {
+			t.Errorf("IsContinueOnly(U+%04X) = %v, expected %v", c.cp, got, c.expected)
+		}
+	}
+}
+
+func TestIsStartOnly(t *testing.T) {
+	// XID_Start is a subset of XID_Continue, so nothing is start-only
+	// under the default rules.
+	for cp := rune(0); cp < maxScalar; cp++ {
+		if IsStartOnly(cp) {
+			t.Fatalf("IsStartOnly(U+%04X) = true, expected false", cp)
+		}
+	}
+
+	p := Profile{ExtraStart: []rune{'@', '$'}, ExtraContinue: []rune{'$'}}
+	cases := []struct {
+		cp       rune
+		expected bool
+	}{
+		{'@', true},
+		{'$', false},
+		{'a', false},
+		{'0', false},
+	}
+	for _, c := range cases {
+		if got := p.IsStartOnly(c.cp); got != c.expected {
+			t.Errorf("Profile.IsStartOnly(%q) = %v, expected %v", c.cp, got, c.expected)
+		}
+	}
+	if !p.IsIdentString("@a") || p.IsIdentString("a@") {
+		t.Errorf("a start-only rune wasn't limited to the start of an identifier")
+	}
+}
+
+func TestReplacementChar(t *testing.T) {
+	if ReplacementChar != utf8.RuneError {
+		t.Fatalf("ReplacementChar is U+%04X, expected U+%04X", ReplacementChar, utf8.RuneError)
+	}
+	if class := UnicodeIdentifierClass(ReplacementChar); class != Other {
+		t.Errorf("UnicodeIdentifierClass(ReplacementChar) = %d, expected Other", class)
+	}
+}
+
+func TestClassifyWithAssignment(t *testing.T) {
+	cases := []struct {
+		cp       rune
+		class    IdentifierClass
+		assigned bool
+	}{
+		{'a', Start | Continue, true},
+		{'!', Other, true},
+		// U+0378 is unassigned.
+		{0x0378, Other, false},
+		{0x10ffff, Other, false},
+		{0xe000, Other, true},
+	}
+	for _, c := range cases {
+		class, assigned := ClassifyWithAssignment(c.cp)
+		if class != c.class || assigned != c.assigned {
+			t.Errorf("ClassifyWithAssignment(U+%04X) = (%d, %v), expected (%d, %v)", c.cp, class, assigned, c.class, c.assigned)
+		}
+	}
+
+	// Every codepoint in an identifier must be assigned.
+	for cp := rune(0); cp <= 0x10ffff; cp++ {
+		if class, assigned := ClassifyWithAssignment(cp); class != Other && !assigned {
+			t.Fatalf("U+%04X has class %d but is unassigned", cp, class)
+		}
+	}
+}
+
+func TestIsJoinControl(t *testing.T) {
+	for cp := rune(0); cp <= 0x10ffff; cp++ {
+		expected := cp == 0x200c || cp == 0x200d
+		if got := IsJoinControl(cp); got != expected {
+			t.Fatalf("IsJoinControl(U+%04X) = %v, expected %v", cp, got, expected)
+		}
+	}
+}
+
+func TestClassifyAll(t *testing.T) {
+	cases := []struct {
+		s       []rune
+		classes []IdentifierClass
+		ok      bool
+	}{
+		{[]rune("a1"), []IdentifierClass{Start | Continue, Continue}, true},
+		{[]rune("1a"), []IdentifierClass{Continue, Start | Continue}, false},
+		{[]rune("a-b"), []IdentifierClass{Start | Continue, Other, Start | Continue}, false},
+		{[]rune{'a', ZWNJ, 'b'}, []IdentifierClass{Start | Continue, Continue, Start | Continue}, true},
+		{[]rune{'a', ZWJ}, []IdentifierClass{Start | Continue, Continue}, false},
+		{[]rune{}, []IdentifierClass{}, false},
+	}
+	for _, c := range cases {
+		classes, ok := ClassifyAll(c.s)
+		if !slices.Equal(classes, c.classes) || ok != c.ok {
+			t.Errorf("ClassifyAll(%q) = (%v, %v), expected (%v, %v)", string(c.s), classes, ok, c.classes, c.ok)
+		}
+		if ok != IsIdent(c.s) {
+			t.Errorf("ClassifyAll(%q) disagrees with IsIdent", string(c.s))
+		}
+	}
+}
+
+func TestContinueIdent(t *testing.T) {
+	cases := []struct {
+		s          []rune
+		started    bool
+		consumed   int
+		stillValid bool
+	}{
+		{[]rune("foo"), false, 3, true},
+		{[]rune("foo bar"), false, 3, false},
+		{[]rune("123"), false, 0, false},
+		{[]rune("123"), true, 3, true},
+		{[]rune("_bar"), true, 4, true},
+		{[]rune(" bar"), true, 0, false},
+		{[]rune{'a', ZWNJ}, false, 2, true},
+		{[]rune{'a', ZWNJ, '+'}, false, 1, false},
+		{[]rune{ZWNJ, 'b'}, true, 2, true},
+		{[]rune{ZWNJ, 'b'}, false, 0, false},
+		{[]rune{}, false, 0, false},
+		{[]rune{}, true, 0, true},
+	}
+	for _, c := range cases {
+		consumed, stillValid := ContinueIdent(c.s, c.started)
+		if consumed != c.consumed || stillValid != c.stillValid {
+			t.Errorf("ContinueIdent(%q, %v) = (%d, %v), expected (%d, %v)", string(c.s), c.started, consumed, stillValid, c.consumed, c.stillValid)
+		}
+	}
+
+	// Feeding an identifier a chunk at a time must agree with IsIdent.
+	full := []rune("snake_case_идентификатор_42")
+	for split := 1; split < len(full); split++ {
+		n, ok := ContinueIdent(full[:split], false)
+		if n != split || !ok {
+			t.Fatalf("first chunk of %d runes returned (%d, %v)", split, n, ok)
+		}
+		n, ok = ContinueIdent(full[split:], true)
+		if n != len(full)-split || !ok {
+			t.Fatalf("second chunk after %d runes returned (%d, %v)", split, n, ok)
+		}
+	}
+}
+
+// Derives where the class changes from the leaf runs alone, without the
+// derived data, and checks that UnicodeIdentifierClass changes at exactly
+// those codepoints.
+func TestClassChangesAtRunBoundaries(t *testing.T) {
+	boundary := make([]bool, blockCount<<shift)
+	last := IdentifierClass(0xff)
+	for block := uint32(0); block < blockCount; block++ {
+		l := loadLeaf(trieBlockLeaf(block))
+		starts := leafRunStarts[l.offset : l.offset+l.len]
+		values := leafRunValues[l.offset : l.offset+l.len]
+		// The final run is the sentinel marking the end of the block.
+		for i := 0; i < len(starts)-1; i++ {
+			cp := block<<shift + uint32(starts[i])
+			if cp < startCodepoint {
+				continue
+			}
+			if values[i] != last && cp > startCodepoint {
+				boundary[cp] = true
+			}
+			last = values[i]
+		}
+	}
+
+	count := 0
+	for cp := rune(startCodepoint + 1); cp <= maxCodepoint; cp++ {
+		changed := UnicodeIdentifierClass(cp) != UnicodeIdentifierClass(cp-1)
+		if changed != boundary[cp] {
+			t.Fatalf("U+%04X: class changed is %v, but the leaf runs say %v", cp, changed, boundary[cp])
+		}
+		if changed {
+			count++
+		}
+	}
+	if count == 0 {
+		t.Fatalf("no run boundaries found")
+	}
+}
+
+// Returns a func yielding the runes of s, and a count of its calls.
+func runeSource(s []rune) (func() (rune, bool), *int) {
+	calls := 0
+	return func() (rune, bool) {
+		calls++
+		if calls > len(s) {
+			return 0, false
+		}
+		return s[calls-1], true
+	}, &calls
+}
+
+func TestIsIdentFunc(t *testing.T) {
+	cases := []struct {
+		s        []rune
+		expected bool
+		calls    int
+	}{
+		{[]rune("foo"), true, 4},
+		{[]rune{'a', ZWNJ, 'b'}, true, 4},
+		{[]rune{'a', ZWJ}, false, 3},
+		{[]rune("1abc"), false, 1},
+		{[]rune("ab-cd"), false, 3},
+		{nil, false, 1},
+	}
+	for _, c := range cases {
+		next, calls := runeSource(c.s)
+		if got := IsIdentFunc(next); got != c.expected {
+			t.Errorf("IsIdentFunc(%q) = %v, expected %v", string(c.s), got, c.expected)
+		}
+		if *calls != c.calls {
+			t.Errorf("IsIdentFunc(%q) called next %d times, expected %d", string(c.s), *calls, c.calls)
+		}
+	}
+}
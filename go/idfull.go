@@ -0,0 +1,23 @@
+//go:build identfull
+
+package unicode_id_trie_rle
+
+import "sort"
+
+// Returns whether the codepoint specified has the properties `ID_Start` or
+// `ID_Continue`, as the Start and Continue bits of an IdentifierClass. These
+// are the properties XID_Start and XID_Continue are derived from, before the
+// few characters which aren't closed under NFKC are removed, and some
+// language specifications, like ECMAScript's, are written in terms of them.
+//
+// The `ID_` data is only compiled in with the identfull build tag, so that
+// the default build only carries the `XID_` tables.
+func IDClass(cp rune) IdentifierClass {
+	if cp < minCodepoint || cp > maxCodepoint {
+		return Other
+	}
+	i := sort.Search(len(idRunStarts), func(i int) bool {
+		return idRunStarts[i] > cp
+	})
+	return idRunValues[i-1]
+}
@@ -0,0 +1,25 @@
+//go:build identfull
+
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestIDClassMatchesDerivedData(t *testing.T) {
+	table := derivedPropertyTable(t, "ID_Start", "ID_Continue")
+	for cp := rune(0); cp < maxScalar; cp++ {
+		if got := IDClass(cp); got != table[cp] {
+			t.Fatalf("U+%04X: IDClass returned %d, expected %d", cp, got, table[cp])
+		}
+	}
+}
+
+func TestIDClassDiffersFromXID(t *testing.T) {
+	// U+037A GREEK YPOGEGRAMMENI is ID_Start, but its NFKC form begins
+	// with a space, so it isn't XID_Start.
+	if IDClass(0x037a) != Start|Continue || UnicodeIdentifierClass(0x037a) != Other {
+		t.Errorf("U+037A: IDClass = %d, UnicodeIdentifierClass = %d", IDClass(0x037a), UnicodeIdentifierClass(0x037a))
+	}
+	if IDClass(-1) != Other || IDClass(0x110000) != Other {
+		t.Errorf("IDClass didn't return Other outside the Unicode codespace")
+	}
+}
@@ -0,0 +1,83 @@
+package unicode_id_trie_rle
+
+import "unicode/utf8"
+
+// The kind of a token returned by Lexer.Next.
+type Kind byte
+
+const (
+	// The end of the input.
+	KindEOF Kind = iota
+	// A unicode identifier, as defined by IsIdent.
+	KindIdentifier
+	// A run of ASCII digits, optionally followed by a '.' and more digits.
+	KindNumber
+	// A run of ASCII whitespace.
+	KindWhitespace
+	// A single rune which begins no other kind of token, or a single byte of
+	// invalid UTF-8.
+	KindOther
+)
+
+// A Lexer splits a string into identifiers, numbers, whitespace and
+// everything else, for quick parsers which don't need a full tokenizer.
+// Identifiers are recognized using the identifier data, and numbers and
+// whitespace using simple ASCII rules.
+type Lexer struct {
+	src string
+	pos int
+}
+
+// Returns a Lexer over s.
+func NewLexer(s string) *Lexer {
+	return &Lexer{src: s}
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isASCIISpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+// Returns the next token, its text and its byte offset in the input. Once
+// the input is exhausted, KindEOF is returned along with an empty text and
+// the length of the input.
+func (l *Lexer) Next() (kind Kind, text string, start int) {
+	start = l.pos
+	rest := l.src[start:]
+	if rest == "" {
+		return KindEOF, "", start
+	}
+
+	n := 0
+	switch c := rest[0]; {
+	case isASCIIDigit(c):
+		kind = KindNumber
+		for n < len(rest) && isASCIIDigit(rest[n]) {
+			n++
+		}
+		if n+1 < len(rest) && rest[n] == '.' && isASCIIDigit(rest[n+1]) {
+			n++
+			for n < len(rest) && isASCIIDigit(rest[n]) {
+				n++
+			}
+		}
+	case isASCIISpace(c):
+		kind = KindWhitespace
+		for n < len(rest) && isASCIISpace(rest[n]) {
+			n++
+		}
+	default:
+		kind = KindIdentifier
+		n = Profile{}.identPrefixLen(rest)
+		if n == 0 {
+			kind = KindOther
+			_, n = utf8.DecodeRuneInString(rest)
+		}
+	}
+
+	l.pos += n
+	return kind, rest[:n], start
+}
@@ -0,0 +1,68 @@
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"testing"
+)
+
+type token struct {
+	kind  Kind
+	text  string
+	start int
+}
+
+func lexTokens(s string) []token {
+	var tokens []token
+	l := NewLexer(s)
+	for {
+		kind, text, start := l.Next()
+		tokens = append(tokens, token{kind, text, start})
+		if kind == KindEOF {
+			return tokens
+		}
+	}
+}
+
+func TestLexer(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected []token
+	}{
+		{"foo 123 + bar", []token{
+			{KindIdentifier, "foo", 0},
+			{KindWhitespace, " ", 3},
+			{KindNumber, "123", 4},
+			{KindWhitespace, " ", 7},
+			{KindOther, "+", 8},
+			{KindWhitespace, " ", 9},
+			{KindIdentifier, "bar", 10},
+			{KindEOF, "", 13},
+		}},
+		{"x1=3.14;", []token{
+			{KindIdentifier, "x1", 0},
+			{KindOther, "=", 2},
+			{KindNumber, "3.14", 3},
+			{KindOther, ";", 7},
+			{KindEOF, "", 8},
+		}},
+		{"été\t\n1.", []token{
+			{KindIdentifier, "été", 0},
+			{KindWhitespace, "\t\n", 5},
+			{KindNumber, "1", 7},
+			{KindOther, ".", 8},
+			{KindEOF, "", 9},
+		}},
+		{"a\xffπ", []token{
+			{KindIdentifier, "a", 0},
+			{KindOther, "\xff", 1},
+			{KindIdentifier, "π", 2},
+			{KindEOF, "", 4},
+		}},
+		{"", []token{{KindEOF, "", 0}}},
+	}
+	for _, c := range cases {
+		if got := lexTokens(c.s); !slices.Equal(got, c.expected) {
+			t.Errorf("lexing %q returned %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
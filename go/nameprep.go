@@ -0,0 +1,110 @@
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+import "unicode"
+
+// The runes Nameprep (RFC 3491) maps to nothing, from table B.1 of
+// Stringprep (RFC 3454), or prohibits, from tables C.1.2 and C.2.2 through
+// C.9. Most can't appear in an identifier anyway, but the join controls,
+// variation selectors and a few combining marks can.
+var nameprepProhibited = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0080, Hi: 0x00a0, Stride: 1},
+		{Lo: 0x00ad, Hi: 0x00ad, Stride: 1},
+		{Lo: 0x0340, Hi: 0x0341, Stride: 1},
+		{Lo: 0x034f, Hi: 0x034f, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
+		{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+		{Lo: 0x1680, Hi: 0x1680, Stride: 1},
+		{Lo: 0x1806, Hi: 0x1806, Stride: 1},
+		{Lo: 0x180b, Hi: 0x180e, Stride: 1},
+		{Lo: 0x2000, Hi: 0x200f, Stride: 1},
+		{Lo: 0x2028, Hi: 0x202f, Stride: 1},
+		{Lo: 0x205f, Hi: 0x2063, Stride: 1},
+		{Lo: 0x206a, Hi: 0x206f, Stride: 1},
+		{Lo: 0x2ff0, Hi: 0x2ffb, Stride: 1},
+		{Lo: 0x3000, Hi: 0x3000, Stride: 1},
+		{Lo: 0xd800, Hi: 0xf8ff, Stride: 1},
+		{Lo: 0xfdd0, Hi: 0xfdef, Stride: 1},
+		{Lo: 0xfe00, Hi: 0xfe0f, Stride: 1},
+		{Lo: 0xfeff, Hi: 0xfeff, Stride: 1},
+		{Lo: 0xfff9, Hi: 0xffff, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1d173, Hi: 0x1d17a, Stride: 1},
+		{Lo: 0x1fffe, Hi: 0x1ffff, Stride: 1},
+		{Lo: 0x2fffe, Hi: 0x2ffff, Stride: 1},
+		{Lo: 0x3fffe, Hi: 0x3ffff, Stride: 1},
+		{Lo: 0x4fffe, Hi: 0x4ffff, Stride: 1},
+		{Lo: 0x5fffe, Hi: 0x5ffff, Stride: 1},
+		{Lo: 0x6fffe, Hi: 0x6ffff, Stride: 1},
+		{Lo: 0x7fffe, Hi: 0x7ffff, Stride: 1},
+		{Lo: 0x8fffe, Hi: 0x8ffff, Stride: 1},
+		{Lo: 0x9fffe, Hi: 0x9ffff, Stride: 1},
+		{Lo: 0xafffe, Hi: 0xaffff, Stride: 1},
+		{Lo: 0xbfffe, Hi: 0xbffff, Stride: 1},
+		{Lo: 0xcfffe, Hi: 0xcffff, Stride: 1},
+		{Lo: 0xdfffe, Hi: 0xdffff, Stride: 1},
+		{Lo: 0xe0001, Hi: 0xe0001, Stride: 1},
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+		{Lo: 0xefffe, Hi: 0x10ffff, Stride: 1},
+	},
+}
+
+// The legacy rules of Nameprep (RFC 3491), the Stringprep profile used by
+// IDNA2003 and, through SASLprep, by older authentication protocols, for
+// interoperating with systems which predate IDNA2008. It's not recommended
+// for anything new.
+//
+// Runes which Nameprep prohibits or maps to nothing, such as ZWNJ, ZWJ and
+// the variation selectors, are rejected, and Key applies Nameprep's case
+// folding and NFKC. Stringprep's tables are for Unicode 3.2, but the current
+// Unicode data is used here, so characters assigned since then are allowed,
+// and case folding follows the current rules.
+//
+// Like IsNameprepIdent, it's only compiled in with the identnorm build tag,
+// since both need the normalization and bidi tables.
+var Nameprep = Profile{
+	CaseInsensitive: true,
+	Normalization:   NFKC,
+	AllowRune: func(cp rune) bool {
+		return !unicode.Is(nameprepProhibited, cp)
+	},
+}
+
+// Checks if s is an identifier under the legacy Nameprep profile, which
+// additionally requires, as Stringprep's bidi rule does, that an identifier
+// containing a right-to-left character has no left-to-right ones and both
+// begins and ends with a right-to-left character.
+func IsNameprepIdent(s string) bool {
+	if !Nameprep.IsIdentString(s) {
+		return false
+	}
+
+	var rtl, ltr bool
+	first, last := byte(bidiLeftToRight), byte(bidiLeftToRight)
+	for i, c := range s {
+		class := bidiClass(c)
+		if i == 0 {
+			first = class
+		}
+		last = class
+		switch class {
+		case bidiRightToLeft:
+			rtl = true
+		case bidiLeftToRight:
+			ltr = true
+		}
+	}
+	if !rtl {
+		return true
+	}
+	return !ltr && first == bidiRightToLeft && last == bidiRightToLeft
+}
+
+// Returns bidiLeftToRight if cp has the bidi class L, bidiRightToLeft if it
+// has R or AL, and bidiOther otherwise.
+func bidiClass(cp rune) byte {
+	return runValue(bidiRunStarts[:], bidiRunValues[:], cp)
+}
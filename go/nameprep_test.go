@@ -0,0 +1,45 @@
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestIsNameprepIdent(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected bool
+		comment  string
+	}{
+		{"m\u00fcller", true, "normalized Latin name"},
+		{"M\u00fcller", true, "case is folded by Key, not rejected"},
+		{"a\tb", false, "ASCII control"},
+		{"a b", false, "ASCII space"},
+		{"a\u3000b", false, "non-ASCII space"},
+		{"a\u00a0b", false, "no-break space"},
+		{"a\u200cb", false, "ZWNJ is mapped to nothing"},
+		{"a\u034fb", false, "combining grapheme joiner is mapped to nothing"},
+		{"a\ufe0f", false, "variation selector is mapped to nothing"},
+		{"a\u0340", false, "deprecated tone mark"},
+		{"\u05d0\u05d1", true, "right-to-left only"},
+		{"\u05d0a\u05d1", false, "right-to-left mixed with left-to-right"},
+		{"\u05d01", false, "right-to-left ending with a digit"},
+		{"\u05d01\u05d1", true, "right-to-left with a digit inside"},
+	}
+	for _, c := range cases {
+		if got := IsNameprepIdent(c.s); got != c.expected {
+			t.Errorf("%s: IsNameprepIdent(%q) = %v, expected %v", c.comment, c.s, got, c.expected)
+		}
+	}
+
+	a, err := Nameprep.Key("M\u00fcller")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Nameprep.Key("mu\u0308ller")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("Nameprep keys differ: %q and %q", a, b)
+	}
+}
@@ -0,0 +1,33 @@
+//go:build !identnorm
+
+package unicode_id_trie_rle
+
+// The message a profile which normalizes or is case insensitive panics with
+// when it needs the tables the identnorm build tag compiles in.
+const errNoNormalization = "unicode_id_trie_rle: normalization and case folding need the identnorm build tag"
+
+// Returns s in the normalization form f, which must be NoNormalization
+// without the identnorm build tag.
+func (f NormalizationForm) apply(s string) string {
+	if f != NoNormalization {
+		panic(errNoNormalization)
+	}
+	return s
+}
+
+// Appends the runes of s in the normalization form f to dst, which must be
+// NoNormalization without the identnorm build tag.
+func (f NormalizationForm) appendRunes(dst []rune, s string) []rune {
+	if f != NoNormalization {
+		panic(errNoNormalization)
+	}
+	for _, c := range s {
+		dst = append(dst, c)
+	}
+	return dst
+}
+
+// Case folding needs the identnorm build tag.
+func foldCase(string) string {
+	panic(errNoNormalization)
+}
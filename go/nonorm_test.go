@@ -0,0 +1,12 @@
+//go:build !identnorm
+
+package unicode_id_trie_rle
+
+import "testing"
+
+// Skips a test which normalizes or case folds, since the tables it needs are
+// only compiled in with the identnorm build tag.
+func requireNormalization(tb testing.TB) {
+	tb.Helper()
+	tb.Skip("normalization and case folding need the identnorm build tag")
+}
@@ -0,0 +1,203 @@
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+import "sort"
+
+// The constants of the algorithmic decomposition of the Hangul syllables,
+// from section 3.12 of the Unicode Standard.
+const (
+	hangulBase   = 0xac00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11a7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulCount  = hangulLCount * hangulNCount
+)
+
+// Returns the NFC form of s, and whether both s and its NFC form are unicode
+// identifiers, as defined by IsIdent. This is the single call a compiler
+// which compares identifiers in NFC needs to make for each one. If either
+// isn't an identifier, "" and false are returned.
+//
+// The normalization tables are only compiled in with the identnorm build tag.
+func ValidNFC(s string) (normalized string, ok bool) {
+	if !IsIdentString(s) {
+		return "", false
+	}
+
+	normalized = NFC.apply(s)
+	if !IsIdentString(normalized) {
+		return "", false
+	}
+	return normalized, true
+}
+
+// Returned by NormalizationOf for a string which is in neither NFC nor NFD,
+// such as one mixing precomposed and decomposed characters.
+const NotNormalized NormalizationForm = 0xff
+
+// Returns the normalization form s is already in, along with whether s is a
+// unicode identifier, as defined by IsIdent. This lets a tool warn about an
+// identifier which isn't in the form it recommends.
+//
+// The most composed form s satisfies is returned, so a string in both NFC
+// and NFD, like any ASCII string, is reported as NFC. Strings in NFKC are
+// always in NFC too, and likewise for NFKD and NFD, so only NFC, NFD or
+// NotNormalized are returned.
+func NormalizationOf(s string) (NormalizationForm, bool) {
+	form := NotNormalized
+	switch {
+	case NFC.apply(s) == s:
+		form = NFC
+	case NFD.apply(s) == s:
+		form = NFD
+	}
+	return form, IsIdentString(s)
+}
+
+// Returns s in the normalization form f.
+func (f NormalizationForm) apply(s string) string {
+	if f == NoNormalization || IsASCIIOnly(s) {
+		return s
+	}
+	return string(f.appendRunes(nil, s))
+}
+
+// Appends the runes of s in the normalization form f to dst. Normalizing
+// happens in place at the end of dst, so it doesn't allocate once dst has
+// room for the decomposed runes.
+func (f NormalizationForm) appendRunes(dst []rune, s string) []rune {
+	start := len(dst)
+	for _, c := range s {
+		switch f {
+		case NFD, NFC:
+			dst = appendDecomposition(dst, c, false)
+		case NFKD, NFKC:
+			dst = appendDecomposition(dst, c, true)
+		default:
+			dst = append(dst, c)
+		}
+	}
+
+	switch f {
+	case NFD, NFKD:
+		sortCombiningMarks(dst[start:])
+	case NFC, NFKC:
+		sortCombiningMarks(dst[start:])
+		dst = dst[:start+len(compose(dst[start:]))]
+	}
+	return dst
+}
+
+// Returns the sequence cp maps to in one of the generated mappings, which
+// hold the sorted codepoints with a mapping, the offset of each one's
+// sequence in runes, and the runes themselves.
+func lookupMapping(keys []rune, offsets []uint16, runes []rune, cp rune) ([]rune, bool) {
+	i := sort.Search(len(keys), func(i int) bool {
+		return keys[i] >= cp
+	})
+	if i == len(keys) || keys[i] != cp {
+		return nil, false
+	}
+	return runes[offsets[i]:offsets[i+1]], true
+}
+
+// Appends the full canonical decomposition of cp to dst, or with compat, its
+// full compatibility decomposition.
+func appendDecomposition(dst []rune, cp rune, compat bool) []rune {
+	if s := cp - hangulBase; s >= 0 && s < hangulCount {
+		dst = append(dst, hangulLBase+s/hangulNCount, hangulVBase+s%hangulNCount/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	}
+
+	if compat {
+		if d, ok := lookupMapping(compatDecompositionKeys[:], compatDecompositionOffsets[:], compatDecompositionRunes[:], cp); ok {
+			return append(dst, d...)
+		}
+	}
+	if d, ok := lookupMapping(canonicalDecompositionKeys[:], canonicalDecompositionOffsets[:], canonicalDecompositionRunes[:], cp); ok {
+		return append(dst, d...)
+	}
+	return append(dst, cp)
+}
+
+// Puts s in canonical order, stably sorting each run of runes with a
+// non-zero `Canonical_Combining_Class` by their class.
+func sortCombiningMarks(s []rune) {
+	for i := 1; i < len(s); i++ {
+		ccc := combiningClass(s[i])
+		if ccc == 0 {
+			continue
+		}
+		for j := i; j > 0 && combiningClass(s[j-1]) > ccc; j-- {
+			s[j-1], s[j] = s[j], s[j-1]
+		}
+	}
+}
+
+// Returns the primary composite a and b compose to under canonical
+// composition, if there is one.
+func composePair(a, b rune) (rune, bool) {
+	if l := a - hangulLBase; l >= 0 && l < hangulLCount {
+		if v := b - hangulVBase; v >= 0 && v < hangulVCount {
+			return hangulBase + (l*hangulVCount+v)*hangulTCount, true
+		}
+		return 0, false
+	}
+	if s := a - hangulBase; s >= 0 && s < hangulCount && s%hangulTCount == 0 {
+		if t := b - hangulTBase; t > 0 && t < hangulTCount {
+			return a + t, true
+		}
+		return 0, false
+	}
+
+	key := uint64(a)<<21 | uint64(b)
+	i := sort.Search(len(compositionPairs), func(i int) bool {
+		return compositionPairs[i] >= key
+	})
+	if i == len(compositionPairs) || compositionPairs[i] != key {
+		return 0, false
+	}
+	return compositionRunes[i], true
+}
+
+// Applies canonical composition to s, which must be decomposed and in
+// canonical order, in place, returning the composed prefix of s.
+func compose(s []rune) []rune {
+	if len(s) == 0 {
+		return s
+	}
+
+	// The last starter and its position in the output, and the
+	// `Canonical_Combining_Class` of the last rune output after it. A
+	// non-starter at the start of s has nothing to compose with, which
+	// lastClass above every class ensures.
+	starter, starterPos := s[0], 0
+	lastClass := int(combiningClass(starter))
+	if lastClass != 0 {
+		lastClass = 256
+	}
+	out := 1
+	for _, c := range s[1:] {
+		class := int(combiningClass(c))
+		if composite, ok := composePair(starter, c); ok && (lastClass < class || lastClass == 0) {
+			s[starterPos] = composite
+			starter = composite
+			continue
+		}
+		if class == 0 {
+			starter, starterPos = c, out
+		}
+		lastClass = class
+		s[out] = c
+		out++
+	}
+	return s[:out]
+}
@@ -0,0 +1,53 @@
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+import "testing"
+
+// Does nothing, since the normalization and case folding tables are compiled
+// in. See nonorm_test.go.
+func requireNormalization(testing.TB) {}
+
+func TestValidNFC(t *testing.T) {
+	cases := []struct {
+		s          string
+		normalized string
+		ok         bool
+	}{
+		{"caf\u00e9", "caf\u00e9", true},
+		{"cafe\u0301", "caf\u00e9", true},
+		{"\u212b", "\u00c5", true},
+		{"foo", "foo", true},
+		{"1foo", "", false},
+		{"cafe\u0301\u200d", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		normalized, ok := ValidNFC(c.s)
+		if normalized != c.normalized || ok != c.ok {
+			t.Errorf("ValidNFC(%q) = (%q, %v), expected (%q, %v)", c.s, normalized, ok, c.normalized, c.ok)
+		}
+	}
+}
+
+func TestNormalizationOf(t *testing.T) {
+	cases := []struct {
+		s    string
+		form NormalizationForm
+		ok   bool
+	}{
+		{"caf\u00e9", NFC, true},
+		{"cafe\u0301", NFD, true},
+		{"foo", NFC, true},
+		{"\ufb01le", NFC, true},
+		{"\u00e9e\u0301", NotNormalized, true},
+		{"1caf\u00e9", NFC, false},
+		{"1cafe\u0301", NFD, false},
+	}
+	for _, c := range cases {
+		form, ok := NormalizationOf(c.s)
+		if form != c.form || ok != c.ok {
+			t.Errorf("NormalizationOf(%q) = (%v, %v), expected (%v, %v)", c.s, form, ok, c.form, c.ok)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+//go:build identoracle
+
+package unicode_id_trie_rle
+
+import (
+	"testing"
+	"unicode"
+)
+
+// The tables of the properties `ID_Start` and `ID_Continue` are made of,
+// following their definitions in Unicode Standard Annex #31, so that the
+// standard library's tables serve as an oracle which doesn't depend on
+// DerivedCoreProperties.txt.
+var (
+	stdlibStartTables    = []*unicode.RangeTable{unicode.L, unicode.Nl, unicode.Other_ID_Start}
+	stdlibContinueTables = append([]*unicode.RangeTable{unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue}, stdlibStartTables...)
+)
+
+func isPatternChar(cp rune) bool {
+	return unicode.In(cp, unicode.Pattern_Syntax, unicode.Pattern_White_Space)
+}
+
+func TestUnicodeIdentifierClassMatchesStdlib(t *testing.T) {
+	if unicode.Version != UnicodeVersion {
+		t.Skipf("the standard library's tables are for Unicode %s, but the package's are for %s", unicode.Version, UnicodeVersion)
+	}
+	requireNormalization(t)
+	for cp := rune(0); cp <= maxCodepoint; cp++ {
+		class := UnicodeIdentifierClass(cp)
+		idStart := unicode.In(cp, stdlibStartTables...) && !isPatternChar(cp)
+		idContinue := unicode.In(cp, stdlibContinueTables...) && !isPatternChar(cp)
+
+		// The `XID_` properties are the `ID_` properties with the
+		// characters which aren't closed under NFKC removed, so any
+		// difference has to be one of those.
+		unstable := NFKC.apply(string(cp)) != string(cp)
+		if class&Start != 0 && !idStart {
+			t.Errorf("U+%04X is XID_Start but not ID_Start", cp)
+		}
+		if class&Start == 0 && idStart && !unstable {
+			t.Errorf("U+%04X is ID_Start but not XID_Start", cp)
+		}
+		if class&Continue != 0 && !idContinue {
+			t.Errorf("U+%04X is XID_Continue but not ID_Continue", cp)
+		}
+		if class&Continue == 0 && idContinue && !unstable {
+			t.Errorf("U+%04X is ID_Continue but not XID_Continue", cp)
+		}
+	}
+}
@@ -0,0 +1,674 @@
+package unicode_id_trie_rle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Returned, possibly wrapped, when a string which isn't an identifier is used
+// as one.
+var ErrInvalidIdentifier = errors.New("invalid identifier")
+
+// Returned, possibly wrapped, when an identifier contains a rune its profile
+// denies. It wraps ErrInvalidIdentifier.
+var ErrDenied = fmt.Errorf("%w: denied rune", ErrInvalidIdentifier)
+
+// The Canonical_Combining_Class value shared by every virama.
+const viramaCombiningClass = 9
+
+// A Profile tailors the "Default Identifiers" rules implemented by IsIdent.
+// The zero value is the default profile, which behaves exactly like IsIdent.
+type Profile struct {
+	// Additional runes which may begin an identifier.
+	ExtraStart []rune
+
+	// Additional runes which may continue an identifier.
+	ExtraContinue []rune
+
+	// Ranges of additional runes which may begin or continue an
+	// identifier, alongside ExtraStart and ExtraContinue, for sets too
+	// large to list rune by rune, like NewRuneSet([2]rune{0x3b1, 0x3c9}).
+	ExtraStartRanges, ExtraContinueRanges RuneSet
+
+	// Restricts ZWNJ and ZWJ to the contexts given by rules A1, A2 and B
+	// of Unicode Standard Annex #31. Both are allowed after a virama, and
+	// ZWNJ also between a rune which joins to the left and one which
+	// joins to the right, going by their `Joining_Type` and skipping
+	// transparent runes such as most combining marks. For the virama,
+	// only the immediately preceding rune is considered, so combining
+	// marks between the virama and the join control are not skipped.
+	StrictJoinControls bool
+
+	// Allows an identifier to end in ZWNJ or ZWJ. UAX #31 only allows them
+	// in the middle of an identifier, but ECMAScript's IdentifierPart
+	// accepts them anywhere after the first rune.
+	TrailingJoinControls bool
+
+	// The syntax characters which IsImmutable refuses. When nil, the
+	// characters with the `Pattern_Syntax` property are used.
+	SyntaxChars []rune
+
+	// Makes IsImmutable reject identifiers containing a character with
+	// the `Prepended_Concatenation_Mark` property, such as U+0600 ARABIC
+	// NUMBER SIGN, which can be used to disguise an identifier. The
+	// property is generated from PropList.txt. These characters are
+	// format characters, which never have `XID_Continue`, so IsIdent and
+	// IsIdentString reject them regardless.
+	RejectPrependedConcatenationMarks bool
+
+	// Allows an identifier to begin with a decimal digit, as some label
+	// syntaxes do.
+	AllowLeadingDigit bool
+
+	// Compares identifiers using full Unicode case folding, as done by
+	// IsIdentExcludingReserved and Key. The case folding tables are only
+	// compiled in with the identnorm build tag, and without it those
+	// methods panic for a case-insensitive profile.
+	CaseInsensitive bool
+
+	// The normalization form identifiers are compared in, as done by Key
+	// and IdentChecker. Like CaseInsensitive, anything but NoNormalization
+	// needs the identnorm build tag, without which those panic.
+	Normalization NormalizationForm
+
+	// Rejects identifiers containing a character with the
+	// `Default_Ignorable_Code_Point` property, such as a variation selector
+	// or U+034F COMBINING GRAPHEME JOINER, which are invisible when
+	// rendered. ZWNJ and ZWJ are left to StrictJoinControls.
+	RejectDefaultIgnorables bool
+
+	// Rejects identifiers containing a character with the `Deprecated`
+	// property, such as U+0149 LATIN SMALL LETTER N PRECEDED BY APOSTROPHE,
+	// which Unicode strongly discourages using, usually in favour of a
+	// sequence like U+02BC U+006E. The property is generated from
+	// PropList.txt.
+	RejectDeprecated bool
+
+	// Rejects identifiers containing a character whose `Identifier_Type`
+	// isn't Recommended or Inclusion, such as the Technical U+01C0 LATIN
+	// LETTER DENTAL CLICK, as Unicode Technical Standard #39 recommends.
+	// The types are generated from IdentifierType.txt, and have no effect
+	// if the tables were generated without it.
+	RequireRecommended bool
+
+	// When non-nil, called for every rune of an identifier, which is only
+	// valid if it returns true for each of them. This layers a custom
+	// restriction, such as an allow-list, on top of the other rules.
+	AllowRune func(rune) bool
+
+	// The most combining marks, of general category Mn or Mc, which may
+	// follow each other in an identifier, to keep out excessively stacked
+	// diacritics. Zero means there's no limit.
+	MaxCombiningRun int
+
+	// Runes which may not appear anywhere in an identifier, even though
+	// they're otherwise allowed, such as confusables a security policy
+	// forbids. This is the inverse of ExtraContinue.
+	Deny []rune
+
+	// Ranges of runes which may not appear anywhere in an identifier,
+	// alongside Deny.
+	DenyRanges RuneSet
+
+	// The most scripts an identifier may mix, not counting the Common and
+	// Inherited scripts shared between them, as a cheaper stand-in for
+	// the restriction levels of Unicode Technical Standard #39. Zero means
+	// there's no limit. A rune used by a few scripts, going by its
+	// `Script_Extensions`, counts towards whichever of them the rest of
+	// the identifier is written in.
+	MaxScripts int
+}
+
+// A Unicode normalization form, as used by Profile.Normalization.
+type NormalizationForm byte
+
+const (
+	// Identifiers are compared exactly as written.
+	NoNormalization NormalizationForm = iota
+	NFC
+	NFD
+	NFKC
+	NFKD
+)
+
+// Returns whether the profile refuses cp anywhere in an identifier,
+// regardless of its identifier class.
+func (p Profile) excluded(cp rune) bool {
+	if p.RejectPrependedConcatenationMarks &&
+		propertiesOf(cp)&propPrependedConcatenationMark != 0 {
+		return true
+	}
+	if p.AllowRune != nil && !p.AllowRune(cp) {
+		return true
+	}
+	if p.denied(cp) {
+		return true
+	}
+	if p.RejectDeprecated && propertiesOf(cp)&propDeprecated != 0 {
+		return true
+	}
+	if p.RequireRecommended && identifierTypeData && propertiesOf(cp)&propAllowed == 0 {
+		return true
+	}
+	return p.RejectDefaultIgnorables && !IsJoinControl(cp) && isDefaultIgnorable(cp)
+}
+
+// Returns whether cp is in p.Deny or p.DenyRanges.
+func (p Profile) denied(cp rune) bool {
+	return inRuneSet(cp, p.Deny, p.DenyRanges)
+}
+
+// Checks if cp is one of runes or in ranges, for the Profile fields which
+// can be given either way. The runes are searched in order, since they're
+// usually only a few.
+func inRuneSet(cp rune, runes []rune, ranges RuneSet) bool {
+	return slices.Contains(runes, cp) || ranges.Contains(cp)
+}
+
+// Returns whether cp has the `Default_Ignorable_Code_Point` property,
+// generated from DerivedCoreProperties.txt.
+func isDefaultIgnorable(cp rune) bool {
+	return propertiesOf(cp)&propDefaultIgnorable != 0
+}
+
+// Returns the identifier class of cp under the profile p.
+func (p Profile) class(cp rune) IdentifierClass {
+	if p.excluded(cp) {
+		return Other
+	}
+
+	class := UnicodeIdentifierClass(cp)
+	if inRuneSet(cp, p.ExtraStart, p.ExtraStartRanges) {
+		class |= Start
+	}
+	if inRuneSet(cp, p.ExtraContinue, p.ExtraContinueRanges) {
+		class |= Continue
+	}
+	if p.AllowLeadingDigit && class&Continue != 0 && unicode.IsDigit(cp) {
+		class |= Start
+	}
+	return class
+}
+
+func isVirama(cp rune) bool {
+	return combiningClass(cp) == viramaCombiningClass
+}
+
+// Returns whether cp joins to the rune after it, with a `Joining_Type` of
+// Left_Joining or Dual_Joining.
+func joinsLeft(cp rune) bool {
+	typ := joiningTypeOf(cp)
+	return typ == joiningLeft || typ == joiningDual
+}
+
+// Tracks the context rule A1 of Unicode Standard Annex #31 allows ZWNJ in
+// through an identifier, for StrictJoinControls.
+type joinContext struct {
+	// Whether the last rune which isn't transparent joins to the left.
+	leftJoining bool
+
+	// Whether a ZWNJ is still waiting for a rune which joins to the right.
+	needRightJoining bool
+}
+
+// Returns the context at the start of an identifier beginning with cp.
+func newJoinContext(cp rune) joinContext {
+	return joinContext{leftJoining: joinsLeft(cp)}
+}
+
+// Moves the context past cp, which follows prev, returning false if cp is
+// a ZWNJ in a context rule A1 doesn't allow, or follows one without joining
+// to the right.
+func (j *joinContext) next(prev, cp rune) bool {
+	typ := joiningTypeOf(cp)
+	switch {
+	case cp == ZWNJ:
+		if !isVirama(prev) {
+			if !j.leftJoining {
+				return false
+			}
+			j.needRightJoining = true
+		}
+		j.leftJoining = false
+		return true
+	case typ == joiningTransparent:
+		return true
+	case j.needRightJoining && typ != joiningRight && typ != joiningDual:
+		return false
+	}
+	j.needRightJoining = false
+	j.leftJoining = joinsLeft(cp)
+	return true
+}
+
+// Returns whether cp may continue an identifier after prev under the profile
+// p, as CanContinueAfter does, additionally applying the whole of rule A1
+// with join when p.StrictJoinControls is set.
+func (p Profile) continues(join *joinContext, prev, cp rune) bool {
+	if !p.CanContinueAfter(prev, cp) {
+		return false
+	}
+	return !p.StrictJoinControls || join.next(prev, cp)
+}
+
+// Returns the length of the run of combining marks ending at cp, given the
+// length of the run before it, and whether the run is within
+// p.MaxCombiningRun.
+func (p Profile) combiningRun(run int, cp rune) (int, bool) {
+	if p.MaxCombiningRun <= 0 {
+		return 0, true
+	}
+	if propertiesOf(cp)&propCombiningMark == 0 {
+		return 0, true
+	}
+	run++
+	return run, run <= p.MaxCombiningRun
+}
+
+// Adds the scripts of cp to seen, the scripts of an identifier so far,
+// returning false if the identifier now mixes more than p.MaxScripts.
+//
+// seen only keeps the sets which don't contain another, since a script
+// shared by a smaller set is also shared by any set containing it.
+func (p Profile) addScript(seen *[]scriptSet, cp rune) bool {
+	if p.MaxScripts <= 0 {
+		return true
+	}
+	set := scriptsOf(cp)
+	if set == allScripts {
+		return true
+	}
+	for _, other := range *seen {
+		if other.intersect(set) == other {
+			return true
+		}
+	}
+	kept := (*seen)[:0]
+	for _, other := range *seen {
+		if set.intersect(other) != set {
+			kept = append(kept, other)
+		}
+	}
+	*seen = append(kept, set)
+	return coverScripts(*seen, p.MaxScripts)
+}
+
+// Returns whether at most n scripts can be picked so that every set in sets
+// contains one of them, by trying each script of the first set in turn.
+// Identifiers rarely mix more than a couple of scripts, so this stays cheap.
+func coverScripts(sets []scriptSet, n int) bool {
+	if len(sets) == 0 {
+		return true
+	}
+	if n == 0 {
+		return false
+	}
+	for i := range len(sets[0]) * 64 {
+		if !sets[0].has(byte(i)) {
+			continue
+		}
+		var rest []scriptSet
+		for _, set := range sets[1:] {
+			if !set.has(byte(i)) {
+				rest = append(rest, set)
+			}
+		}
+		if coverScripts(rest, n-1) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether cp can begin an identifier under the profile p but not
+// continue one, such as a rune in p.ExtraStart but not p.ExtraContinue.
+func (p Profile) IsStartOnly(cp rune) bool {
+	return p.class(cp) == Start
+}
+
+// Returns whether cp may continue an identifier when it immediately follows
+// prev. This lets contextual rules be applied while scanning, without
+// buffering the whole identifier.
+//
+// The default profile ignores prev. Under p.StrictJoinControls, ZWJ is only
+// allowed after a virama, and ZWNJ after a virama or a rune which joins to
+// the left or is transparent. Rule A1 also depends on runes further away, so
+// IsIdent and the other checks of whole identifiers apply the rest of it.
+// Whether a join control is allowed at the end of an identifier is not
+// decided here, since that depends on what comes after cp rather than before
+// it, and on p.TrailingJoinControls.
+func (p Profile) CanContinueAfter(prev, cp rune) bool {
+	if p.excluded(cp) {
+		return false
+	}
+
+	// Join controls are checked first, since they also carry the
+	// `XID_Continue` property.
+	if IsJoinControl(cp) {
+		if !p.StrictJoinControls || isVirama(prev) {
+			return true
+		}
+		return cp == ZWNJ && (joinsLeft(prev) || joiningTypeOf(prev) == joiningTransparent)
+	}
+	return p.class(cp)&Continue != 0
+}
+
+// Checks if a codepoint array is a unicode identifier under the profile p.
+// See IsIdent for the rules used by the default profile.
+func (p Profile) IsIdent(s []rune) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	var scripts []scriptSet
+	if p.class(s[0])&Start == 0 || !p.addScript(&scripts, s[0]) {
+		return false
+	}
+
+	join := newJoinContext(s[0])
+	run := 0
+	for i := 1; i < len(s); i++ {
+		if !p.continues(&join, s[i-1], s[i]) || !p.addScript(&scripts, s[i]) {
+			return false
+		}
+		var ok bool
+		if run, ok = p.combiningRun(run, s[i]); !ok {
+			return false
+		}
+	}
+
+	// the two special characters are only allowed in the middle, not
+	// the end.
+	if join.needRightJoining {
+		return false
+	}
+	return p.TrailingJoinControls || !IsJoinControl(s[len(s)-1])
+}
+
+// Returns an iterator over the byte offset and value of each rune of the
+// longest identifier at the start of s under the profile p. See IdentRunes.
+func (p Profile) identRunes(s string) func(yield func(int, rune) bool) {
+	return func(yield func(int, rune) bool) {
+		if s == "" {
+			return
+		}
+
+		var scripts []scriptSet
+		first, size := utf8.DecodeRuneInString(s)
+		if p.class(first)&Start == 0 || !p.addScript(&scripts, first) {
+			return
+		}
+		if !yield(0, first) {
+			return
+		}
+
+		// The offset of the first rune not yet yielded, since the
+		// identifier can't end after it, or -1. This is a join control,
+		// or a rune following a ZWNJ which still needs a rune joining to
+		// the right.
+		pending := -1
+		prev := first
+		join := newJoinContext(first)
+		run := 0
+		for i := size; i < len(s); i += size {
+			var c rune
+			c, size = utf8.DecodeRuneInString(s[i:])
+			if !p.continues(&join, prev, c) || !p.addScript(&scripts, c) {
+				return
+			}
+			var ok bool
+			if run, ok = p.combiningRun(run, c); !ok {
+				return
+			}
+			prev = c
+			if IsJoinControl(c) || join.needRightJoining {
+				if pending < 0 {
+					pending = i
+				}
+				continue
+			}
+
+			if pending >= 0 {
+				for j, jc := range s[pending:i] {
+					if !yield(pending+j, jc) {
+						return
+					}
+				}
+				pending = -1
+			}
+			if !yield(i, c) {
+				return
+			}
+		}
+		if pending >= 0 && p.TrailingJoinControls && !join.needRightJoining {
+			for j, jc := range s[pending:] {
+				if !yield(pending+j, jc) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Returns the length in bytes of the longest identifier at the start of s
+// under the profile p, or 0 if s doesn't begin with one.
+func (p Profile) identPrefixLen(s string) int {
+	end := 0
+	p.identRunes(s)(func(off int, c rune) bool {
+		end = off + utf8.RuneLen(c)
+		return true
+	})
+	return end
+}
+
+// Checks if s is a unicode identifier under the profile p. Invalid UTF-8 is
+// never part of an identifier.
+func (p Profile) IsIdentString(s string) bool {
+	return s != "" && p.identPrefixLen(s) == len(s)
+}
+
+// Checks if s is a unicode identifier under the profile p which isn't one of
+// the reserved words, such as a language's keywords. When p.CaseInsensitive
+// is set, s is case folded before looking it up, so reserved must hold
+// case-folded words.
+func (p Profile) IsIdentExcludingReserved(s string, reserved map[string]struct{}) bool {
+	if !p.IsIdentString(s) {
+		return false
+	}
+
+	key := s
+	if p.CaseInsensitive {
+		key = foldCase(s)
+	}
+	_, ok := reserved[key]
+	return !ok
+}
+
+// Returns the key identifiers are compared by under the profile p, which is s
+// in the profile's normalization form and case folded if the profile is case
+// insensitive. Two identifiers with the same key are the same identifier,
+// which makes the key suitable for indexing a symbol table. An error
+// wrapping ErrInvalidIdentifier is returned if s isn't an identifier under
+// p, which wraps ErrDenied too if s contains a rune p.Deny or p.DenyRanges
+// holds.
+func (p Profile) Key(s string) (string, error) {
+	if !p.IsIdentString(s) {
+		if i := strings.IndexFunc(s, p.denied); i >= 0 {
+			c, _ := utf8.DecodeRuneInString(s[i:])
+			return "", fmt.Errorf("%w %U in %q", ErrDenied, c, s)
+		}
+		return "", fmt.Errorf("%w: %q", ErrInvalidIdentifier, s)
+	}
+
+	key := p.Normalization.apply(s)
+	if p.CaseInsensitive {
+		// Case folding doesn't preserve normalization, so the result
+		// is normalized again.
+		key = p.Normalization.apply(foldCase(key))
+	}
+	return key, nil
+}
+
+// Checks if a and b are the same identifier under the profile p, which is
+// when both are identifiers under p and their keys, as returned by Key, are
+// equal. This is what name resolution needs, such as Python3 treating a name
+// spelled with U+FB01 LATIN SMALL LIGATURE FI the same as one spelled "fi".
+func (p Profile) EqualIdent(a, b string) bool {
+	keyA, err := p.Key(a)
+	if err != nil {
+		return false
+	}
+	keyB, err := p.Key(b)
+	return err == nil && keyA == keyB
+}
+
+// Checks if a codepoint array is an immutable identifier, defined by
+// Unicode Standard Annex #31.
+//
+// Unlike IsIdent, this accepts any codepoint which isn't a syntax character
+// or `Pattern_White_Space`, so that the set of identifiers never shrinks as
+// new characters are assigned. The syntax characters come from
+// p.SyntaxChars, which lets a language reserve its own operators instead of
+// everything with the `Pattern_Syntax` property.
+func (p Profile) IsImmutable(s []rune) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, c := range s {
+		if unicode.Is(unicode.Pattern_White_Space, c) || p.excluded(c) {
+			return false
+		}
+		if p.SyntaxChars == nil {
+			if unicode.Is(unicode.Pattern_Syntax, c) {
+				return false
+			}
+		} else if slices.Contains(p.SyntaxChars, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Checks if s is a sequence of one or more identifiers under the profile p,
+// separated by sep, like "foo.bar.baz". Empty segments, such as those left
+// by a leading, trailing or doubled separator, make s invalid.
+func (p Profile) IsQualifiedName(s string, sep rune) bool {
+	for _, segment := range strings.Split(s, string(sep)) {
+		if !p.IsIdentString(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns a deterministic hash of the rules of the profile p and the Unicode
+// version of the tables, for keying caches of anything derived from them.
+// Profiles with the same rules have the same fingerprint, regardless of the
+// order or repetition of the runes in their sets.
+//
+// A function can't be hashed, so only whether p.AllowRune is set is taken
+// into account.
+func (p Profile) Fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "unicode %s\n", UnicodeVersion)
+
+	runeSet := func(name string, runes []rune) {
+		if runes == nil {
+			fmt.Fprintf(h, "%s default\n", name)
+			return
+		}
+		set := slices.Clone(runes)
+		slices.Sort(set)
+		set = slices.Compact(set)
+		fmt.Fprintf(h, "%s %q\n", name, string(set))
+	}
+	// Runes given one by one and as ranges are merged, so that the same
+	// set has the same fingerprint however it's written.
+	fmt.Fprintf(h, "start %s\n", RuneSetOf(p.ExtraStart...).Union(p.ExtraStartRanges))
+	fmt.Fprintf(h, "continue %s\n", RuneSetOf(p.ExtraContinue...).Union(p.ExtraContinueRanges))
+	runeSet("syntax", p.SyntaxChars)
+	fmt.Fprintf(h, "deny %s\n", RuneSetOf(p.Deny...).Union(p.DenyRanges))
+
+	fmt.Fprintf(h, "strict-join-controls %t\n", p.StrictJoinControls)
+	fmt.Fprintf(h, "trailing-join-controls %t\n", p.TrailingJoinControls)
+	fmt.Fprintf(h, "reject-prepended-concatenation-marks %t\n", p.RejectPrependedConcatenationMarks)
+	fmt.Fprintf(h, "allow-leading-digit %t\n", p.AllowLeadingDigit)
+	fmt.Fprintf(h, "case-insensitive %t\n", p.CaseInsensitive)
+	fmt.Fprintf(h, "normalization %d\n", p.Normalization)
+	fmt.Fprintf(h, "reject-default-ignorables %t\n", p.RejectDefaultIgnorables)
+	fmt.Fprintf(h, "reject-deprecated %t\n", p.RejectDeprecated)
+	fmt.Fprintf(h, "require-recommended %t\n", p.RequireRecommended)
+	fmt.Fprintf(h, "allow-rune %t\n", p.AllowRune != nil)
+	fmt.Fprintf(h, "max-combining-run %d\n", max(p.MaxCombiningRun, 0))
+	fmt.Fprintf(h, "max-scripts %d\n", max(p.MaxScripts, 0))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Returns a profile combining the rules of p and other, so that profiles can
+// be built up from others, like ECMAScript with a few more runes allowed.
+// ExtraStart, ExtraContinue and Deny, and their Ranges, hold the runes of
+// both. Flags are set if
+// either profile sets them, and for Normalization, MaxCombiningRun,
+// MaxScripts and SyntaxChars, other's value is used unless it's the zero
+// value. If both profiles have an AllowRune, a rune must be allowed by both.
+func (p Profile) Merge(other Profile) Profile {
+	union := func(a, b []rune) []rune {
+		merged := slices.Clone(a)
+		for _, c := range b {
+			if !slices.Contains(merged, c) {
+				merged = append(merged, c)
+			}
+		}
+		return merged
+	}
+
+	merged := Profile{
+		ExtraStart:                        union(p.ExtraStart, other.ExtraStart),
+		ExtraContinue:                     union(p.ExtraContinue, other.ExtraContinue),
+		ExtraStartRanges:                  p.ExtraStartRanges.Union(other.ExtraStartRanges),
+		ExtraContinueRanges:               p.ExtraContinueRanges.Union(other.ExtraContinueRanges),
+		Deny:                              union(p.Deny, other.Deny),
+		DenyRanges:                        p.DenyRanges.Union(other.DenyRanges),
+		StrictJoinControls:                p.StrictJoinControls || other.StrictJoinControls,
+		TrailingJoinControls:              p.TrailingJoinControls || other.TrailingJoinControls,
+		SyntaxChars:                       p.SyntaxChars,
+		RejectPrependedConcatenationMarks: p.RejectPrependedConcatenationMarks || other.RejectPrependedConcatenationMarks,
+		AllowLeadingDigit:                 p.AllowLeadingDigit || other.AllowLeadingDigit,
+		CaseInsensitive:                   p.CaseInsensitive || other.CaseInsensitive,
+		Normalization:                     p.Normalization,
+		RejectDefaultIgnorables:           p.RejectDefaultIgnorables || other.RejectDefaultIgnorables,
+		RejectDeprecated:                  p.RejectDeprecated || other.RejectDeprecated,
+		RequireRecommended:                p.RequireRecommended || other.RequireRecommended,
+		AllowRune:                         p.AllowRune,
+		MaxCombiningRun:                   p.MaxCombiningRun,
+		MaxScripts:                        p.MaxScripts,
+	}
+	if other.SyntaxChars != nil {
+		merged.SyntaxChars = other.SyntaxChars
+	}
+	if other.Normalization != NoNormalization {
+		merged.Normalization = other.Normalization
+	}
+	if other.MaxCombiningRun != 0 {
+		merged.MaxCombiningRun = other.MaxCombiningRun
+	}
+	if other.MaxScripts != 0 {
+		merged.MaxScripts = other.MaxScripts
+	}
+	switch {
+	case p.AllowRune == nil:
+		merged.AllowRune = other.AllowRune
+	case other.AllowRune != nil:
+		merged.AllowRune = func(c rune) bool {
+			return p.AllowRune(c) && other.AllowRune(c)
+		}
+	}
+	return merged
+}
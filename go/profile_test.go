@@ -0,0 +1,625 @@
+package unicode_id_trie_rle
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"unicode"
+)
+
+func TestCanContinueAfterDefaultIgnoresPrev(t *testing.T) {
+	var p Profile
+	for _, prev := range []rune{'a', 0x094d, ' '} {
+		if !p.CanContinueAfter(prev, ZWNJ) {
+			t.Errorf("default profile rejected ZWNJ after U+%04X", prev)
+		}
+		if !p.CanContinueAfter(prev, ZWJ) {
+			t.Errorf("default profile rejected ZWJ after U+%04X", prev)
+		}
+		if !p.CanContinueAfter(prev, '1') {
+			t.Errorf("default profile rejected '1' after U+%04X", prev)
+		}
+		if p.CanContinueAfter(prev, '-') {
+			t.Errorf("default profile accepted '-' after U+%04X", prev)
+		}
+	}
+}
+
+func TestCanContinueAfterStrictJoinControls(t *testing.T) {
+	p := Profile{StrictJoinControls: true}
+
+	// U+094D DEVANAGARI SIGN VIRAMA
+	if !p.CanContinueAfter(0x094d, ZWNJ) {
+		t.Errorf("strict profile rejected ZWNJ after a virama")
+	}
+	if !p.CanContinueAfter(0x094d, ZWJ) {
+		t.Errorf("strict profile rejected ZWJ after a virama")
+	}
+
+	// U+0628 ARABIC LETTER BEH joins on both sides, and U+A872 PHAGS-PA
+	// SUPERFIXED LETTER RA to the left.
+	for _, prev := range []rune{0x0628, 0xa872} {
+		if !p.CanContinueAfter(prev, ZWNJ) {
+			t.Errorf("strict profile rejected ZWNJ after U+%04X", prev)
+		}
+		if p.CanContinueAfter(prev, ZWJ) {
+			t.Errorf("strict profile accepted ZWJ after U+%04X", prev)
+		}
+	}
+
+	// U+0915 DEVANAGARI LETTER KA and U+0627 ARABIC LETTER ALEF, which
+	// only joins to the right.
+	for _, prev := range []rune{'a', 0x0915, 0x0627} {
+		if p.CanContinueAfter(prev, ZWNJ) {
+			t.Errorf("strict profile accepted ZWNJ after U+%04X", prev)
+		}
+		if p.CanContinueAfter(prev, ZWJ) {
+			t.Errorf("strict profile accepted ZWJ after U+%04X", prev)
+		}
+	}
+}
+
+func TestProfileIsIdent(t *testing.T) {
+	strict := Profile{StrictJoinControls: true}
+	cases := []struct {
+		s       []rune
+		def     bool
+		strict  bool
+		comment string
+	}{
+		{[]rune("id_42"), true, true, "ascii"},
+		{[]rune{0x0915, 0x094d, ZWNJ, 0x0937}, true, true, "ZWNJ after virama"},
+		{[]rune{'a', ZWNJ, 'b'}, true, false, "ZWNJ after latin letter"},
+		{[]rune{'a', ZWJ}, false, false, "trailing ZWJ"},
+		{[]rune{}, false, false, "empty"},
+		{[]rune("1a"), false, false, "leading digit"},
+	}
+	for _, c := range cases {
+		if got := (Profile{}).IsIdent(c.s); got != c.def {
+			t.Errorf("%s: default profile returned %v, expected %v", c.comment, got, c.def)
+		}
+		if got := strict.IsIdent(c.s); got != c.strict {
+			t.Errorf("%s: strict profile returned %v, expected %v", c.comment, got, c.strict)
+		}
+	}
+}
+
+func TestStrictJoinControlsJoiningType(t *testing.T) {
+	strict := Profile{StrictJoinControls: true}
+	cases := []struct {
+		s        string
+		expected bool
+		comment  string
+	}{
+		// U+0628 ARABIC LETTER BEH and U+0644 ARABIC LETTER LAM join on
+		// both sides, U+0627 ARABIC LETTER ALEF to the right, U+A872
+		// PHAGS-PA SUPERFIXED LETTER RA to the left, and U+0621 ARABIC
+		// LETTER HAMZA not at all. U+064E ARABIC FATHA is transparent.
+		{"\u0628\u200c\u0644", true, "dual joining on both sides"},
+		{"\u0628\u200c\u0627", true, "dual then right joining"},
+		{"\ua872\u200c\u0628", true, "left then dual joining"},
+		{"\u0628\u064e\u200c\u064e\u0644", true, "transparent marks on both sides"},
+		{"\u0627\u200c\u0628", false, "right joining before"},
+		{"\u0621\u200c\u0628", false, "non-joining before"},
+		{"\u0628\u200c\u0621", false, "non-joining after"},
+		{"\u0628\u200c\u064e", false, "only a transparent mark after"},
+		{"\u0628\u200c\u200c\u0644", false, "two ZWNJs"},
+		{"a\u200cb", false, "latin letters"},
+		{"\u0915\u094d\u200c\u0937", true, "after a virama"},
+	}
+	for _, c := range cases {
+		if got := strict.IsIdent([]rune(c.s)); got != c.expected {
+			t.Errorf("%s: IsIdent(%q) = %v, expected %v", c.comment, c.s, got, c.expected)
+		}
+		if got := strict.IsIdentString(c.s); got != c.expected {
+			t.Errorf("%s: IsIdentString(%q) = %v, expected %v", c.comment, c.s, got, c.expected)
+		}
+	}
+
+	// The identifier ends before a ZWNJ the rest of s doesn't complete.
+	if n := strict.identPrefixLen("\u0628\u200c\u064e\u0621"); n != 2 {
+		t.Errorf("identPrefixLen returned %d, expected 2", n)
+	}
+}
+
+func TestIsImmutable(t *testing.T) {
+	var p Profile
+	if !p.IsImmutable([]rune("foo")) {
+		t.Errorf("default profile rejected \"foo\"")
+	}
+	if p.IsImmutable([]rune("a+b")) {
+		t.Errorf("default profile accepted Pattern_Syntax character '+'")
+	}
+	if p.IsImmutable([]rune("a b")) {
+		t.Errorf("default profile accepted a space")
+	}
+	if p.IsImmutable(nil) {
+		t.Errorf("default profile accepted an empty identifier")
+	}
+
+	dsl := Profile{SyntaxChars: []rune{'+', '*'}}
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"foo", true},
+		{"foo-bar", true},
+		{"1.5", true},
+		{"a+b", false},
+		{"a*b", false},
+		{"a b", false},
+		{"a\tb", false},
+	}
+	for _, c := range cases {
+		if got := dsl.IsImmutable([]rune(c.s)); got != c.expected {
+			t.Errorf("IsImmutable(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestRejectPrependedConcatenationMarks(t *testing.T) {
+	// U+0600 ARABIC NUMBER SIGN
+	s := []rune{'a', 0x0600, 'b'}
+
+	var p Profile
+	if !p.IsImmutable(s) {
+		t.Errorf("default profile rejected U+0600 in an immutable identifier")
+	}
+
+	p.RejectPrependedConcatenationMarks = true
+	if p.IsImmutable(s) {
+		t.Errorf("profile accepted U+0600 in an immutable identifier")
+	}
+	if p.IsIdent(s) {
+		t.Errorf("profile accepted U+0600 in an identifier")
+	}
+	if p.CanContinueAfter('a', 0x0600) {
+		t.Errorf("profile allowed U+0600 to continue an identifier")
+	}
+	if !p.IsImmutable([]rune("ab")) {
+		t.Errorf("profile rejected \"ab\"")
+	}
+}
+
+func TestIsIdentExcludingReserved(t *testing.T) {
+	requireNormalization(t)
+	reserved := map[string]struct{}{"if": {}, "else": {}}
+
+	var sensitive Profile
+	insensitive := Profile{CaseInsensitive: true}
+	cases := []struct {
+		s           string
+		sensitive   bool
+		insensitive bool
+	}{
+		{"if", false, false},
+		{"IF", true, false},
+		{"If", true, false},
+		{"iffy", true, true},
+		{"ELSE", true, false},
+		{"1if", false, false},
+		{"", false, false},
+	}
+	for _, c := range cases {
+		if got := sensitive.IsIdentExcludingReserved(c.s, reserved); got != c.sensitive {
+			t.Errorf("case-sensitive IsIdentExcludingReserved(%q) = %v, expected %v", c.s, got, c.sensitive)
+		}
+		if got := insensitive.IsIdentExcludingReserved(c.s, reserved); got != c.insensitive {
+			t.Errorf("case-insensitive IsIdentExcludingReserved(%q) = %v, expected %v", c.s, got, c.insensitive)
+		}
+	}
+}
+
+func TestAllowLeadingDigit(t *testing.T) {
+	labels := Profile{AllowLeadingDigit: true}
+	cases := []struct {
+		s      string
+		def    bool
+		labels bool
+	}{
+		{"123abc", false, true},
+		{"123", false, true},
+		{"٣abc", false, true},
+		{"abc123", true, true},
+		{"_abc", false, false},
+		{"-1", false, false},
+	}
+	for _, c := range cases {
+		if got := (Profile{}).IsIdentString(c.s); got != c.def {
+			t.Errorf("default profile: %q returned %v, expected %v", c.s, got, c.def)
+		}
+		if got := labels.IsIdentString(c.s); got != c.labels {
+			t.Errorf("AllowLeadingDigit: %q returned %v, expected %v", c.s, got, c.labels)
+		}
+	}
+}
+
+func TestEqualIdent(t *testing.T) {
+	requireNormalization(t)
+	sql := Profile{CaseInsensitive: true, Normalization: NFC}
+	cases := []struct {
+		p        Profile
+		a, b     string
+		expected bool
+		comment  string
+	}{
+		// U+FB01 LATIN SMALL LIGATURE FI
+		{Python3, "ﬁle", "file", true, "NFKC-equal ligature"},
+		{Python3, "file", "File", false, "case-sensitive"},
+		{Python3, "_file", "_file", true, "identical"},
+		{Profile{}, "ﬁle", "file", false, "no normalization"},
+		{sql, "Café", "cafe\u0301", true, "case-insensitive NFC"},
+		{sql, "CAFÉ", "café", true, "case-insensitive"},
+		{sql, "cafe", "café", false, "different letters"},
+		{sql, "1abc", "1abc", false, "not an identifier"},
+		{sql, "abc", "", false, "empty"},
+	}
+	for _, c := range cases {
+		if got := c.p.EqualIdent(c.a, c.b); got != c.expected {
+			t.Errorf("%s: EqualIdent(%q, %q) = %v, expected %v", c.comment, c.a, c.b, got, c.expected)
+		}
+		if got := c.p.EqualIdent(c.b, c.a); got != c.expected {
+			t.Errorf("%s: EqualIdent(%q, %q) = %v, expected %v", c.comment, c.b, c.a, got, c.expected)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	requireNormalization(t)
+	// U+FB01 LATIN SMALL LIGATURE FI
+	a, err := Python3.Key("ﬁle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Python3.Key("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("Python3 keys differ: %q and %q", a, b)
+	}
+	if k, _ := Python3.Key("File"); k == b {
+		t.Errorf("Python3 is case insensitive")
+	}
+
+	sql := Profile{CaseInsensitive: true, Normalization: NFC}
+	var keys []string
+	for _, s := range []string{"Café", "CAFÉ", "cafe\u0301"} {
+		k, err := sql.Key(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, k)
+	}
+	if keys[0] != keys[1] || keys[1] != keys[2] {
+		t.Errorf("case-insensitive keys differ: %q", keys)
+	}
+
+	if _, err := Python3.Key("1abc"); !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("Key(\"1abc\") returned %v, expected ErrInvalidIdentifier", err)
+	}
+}
+
+func TestIsDefaultIgnorableMatchesDerivedData(t *testing.T) {
+	expected := make([]bool, maxScalar)
+	readUCDFile(t, "DerivedCoreProperties.txt", func(start, end rune, fields []string) {
+		if fields[0] != "Default_Ignorable_Code_Point" {
+			return
+		}
+		for cp := start; cp <= end; cp++ {
+			expected[cp] = true
+		}
+	})
+
+	for cp := rune(0); cp < maxScalar; cp++ {
+		if got := isDefaultIgnorable(cp); got != expected[cp] {
+			t.Fatalf("isDefaultIgnorable(U+%04X) = %v, expected %v", cp, got, expected[cp])
+		}
+	}
+}
+
+func TestRejectDefaultIgnorables(t *testing.T) {
+	p := Profile{RejectDefaultIgnorables: true}
+	cases := []struct {
+		s        []rune
+		def      bool
+		rejected bool
+		comment  string
+	}{
+		{[]rune("abc"), true, true, "ascii"},
+		// U+034F COMBINING GRAPHEME JOINER
+		{[]rune{'a', 0x034f, 'b'}, true, false, "grapheme joiner"},
+		// U+FE0F VARIATION SELECTOR-16
+		{[]rune{'a', 0xfe0f}, true, false, "variation selector"},
+		{[]rune{'a', ZWNJ, 'b'}, true, true, "ZWNJ"},
+	}
+	for _, c := range cases {
+		if got := (Profile{}).IsIdent(c.s); got != c.def {
+			t.Errorf("%s: default profile returned %v, expected %v", c.comment, got, c.def)
+		}
+		if got := p.IsIdent(c.s); got != c.rejected {
+			t.Errorf("%s: RejectDefaultIgnorables returned %v, expected %v", c.comment, got, c.rejected)
+		}
+	}
+}
+
+func TestRejectDeprecated(t *testing.T) {
+	p := Profile{RejectDeprecated: true}
+	cases := []struct {
+		s        []rune
+		def      bool
+		rejected bool
+		comment  string
+	}{
+		// U+0149 LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+		{[]rune{'a', 0x0149}, true, false, "U+0149"},
+		// U+02BC MODIFIER LETTER APOSTROPHE, its recommended replacement
+		{[]rune{'a', 0x02bc, 'n'}, true, true, "U+02BC U+006E"},
+		// U+0673 ARABIC LETTER ALEF WITH WAVY HAMZA BELOW
+		{[]rune{0x0673}, true, false, "U+0673"},
+		{[]rune("abc"), true, true, "ascii"},
+	}
+	for _, c := range cases {
+		if got := (Profile{}).IsIdent(c.s); got != c.def {
+			t.Errorf("%s: default profile returned %v, expected %v", c.comment, got, c.def)
+		}
+		if got := p.IsIdent(c.s); got != c.rejected {
+			t.Errorf("%s: RejectDeprecated returned %v, expected %v", c.comment, got, c.rejected)
+		}
+	}
+	if UTS55.IsIdent([]rune{0x0149}) {
+		t.Errorf("UTS55 accepted U+0149")
+	}
+}
+
+func TestAllowRune(t *testing.T) {
+	lower := Profile{AllowRune: func(r rune) bool { return !unicode.IsUpper(r) }}
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"foo", true},
+		{"foo_bar42", true},
+		{"Foo", false},
+		{"fooBar", false},
+		{"été", true},
+		{"Été", false},
+		{"1foo", false},
+	}
+	for _, c := range cases {
+		if got := lower.IsIdentString(c.s); got != c.expected {
+			t.Errorf("IsIdentString(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+	if lower.IsImmutable([]rune("Foo")) {
+		t.Errorf("IsImmutable accepted \"Foo\"")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a := Profile{ExtraStart: []rune{'$', '_'}, Normalization: NFC}
+	b := Profile{ExtraStart: []rune{'_', '$', '_'}, Normalization: NFC}
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Errorf("equivalent profiles have different fingerprints")
+	}
+	if a.Fingerprint() != a.Fingerprint() {
+		t.Errorf("Fingerprint isn't deterministic")
+	}
+
+	changed := []Profile{
+		{ExtraStart: []rune{'$'}, Normalization: NFC},
+		{ExtraStart: []rune{'$', '_'}, Normalization: NFKC},
+		{ExtraStart: []rune{'$', '_'}, ExtraContinue: []rune{'$'}, Normalization: NFC},
+		{ExtraStart: []rune{'$', '_'}, Normalization: NFC, StrictJoinControls: true},
+		{ExtraStart: []rune{'$', '_'}, Normalization: NFC, SyntaxChars: []rune{}},
+	}
+	for _, p := range changed {
+		if p.Fingerprint() == a.Fingerprint() {
+			t.Errorf("%+v has the same fingerprint as %+v", p, a)
+		}
+	}
+}
+
+func TestMaxCombiningRun(t *testing.T) {
+	p := Profile{MaxCombiningRun: 2}
+	cases := []struct {
+		s       []rune
+		def     bool
+		limited bool
+		comment string
+	}{
+		{[]rune{'e', 0x0301}, true, true, "one accent"},
+		{[]rune{'e', 0x0301, 0x0323}, true, true, "two accents"},
+		{[]rune{'e', 0x0301, 0x0323, 0x0308}, true, false, "three accents"},
+		{[]rune{'e', 0x0301, 0x0323, 'e', 0x0301, 0x0323}, true, true, "two runs of two accents"},
+		{[]rune{0x0915, 0x094d, 0x0937, 0x093e}, true, true, "virama and vowel sign"},
+	}
+	for _, c := range cases {
+		if got := (Profile{}).IsIdent(c.s); got != c.def {
+			t.Errorf("%s: default profile returned %v, expected %v", c.comment, got, c.def)
+		}
+		if got := p.IsIdent(c.s); got != c.limited {
+			t.Errorf("%s: MaxCombiningRun returned %v, expected %v", c.comment, got, c.limited)
+		}
+		if got := p.IsIdentString(string(c.s)); got != c.limited {
+			t.Errorf("%s: MaxCombiningRun IsIdentString returned %v, expected %v", c.comment, got, c.limited)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	overlay := Profile{
+		ExtraContinue:   []rune{'$', '-'},
+		CaseInsensitive: true,
+		Normalization:   NFKC,
+		AllowRune:       func(r rune) bool { return r != 'x' },
+	}
+	merged := ECMAScript.Merge(overlay)
+
+	if !slices.Equal(merged.ExtraStart, []rune{'$', '_'}) {
+		t.Errorf("ExtraStart is %q, expected \"$_\"", merged.ExtraStart)
+	}
+	if !slices.Equal(merged.ExtraContinue, []rune{'$', '-'}) {
+		t.Errorf("ExtraContinue is %q, expected \"$-\"", merged.ExtraContinue)
+	}
+	if !merged.CaseInsensitive || merged.Normalization != NFKC {
+		t.Errorf("overlay's CaseInsensitive and Normalization weren't applied")
+	}
+
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"$foo-bar", true},
+		{"_a", true},
+		{"-a", false},
+		{"a-x", false},
+	}
+	for _, c := range cases {
+		if got := merged.IsIdentString(c.s); got != c.expected {
+			t.Errorf("merged profile: IsIdentString(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+
+	// Both AllowRune funcs apply, and a zero value doesn't override.
+	both := merged.Merge(Profile{AllowRune: func(r rune) bool { return r != 'y' }})
+	if both.IsIdentString("ax") || both.IsIdentString("ay") || !both.IsIdentString("az") {
+		t.Errorf("merged AllowRune didn't require both funcs to allow a rune")
+	}
+	if both.Normalization != NFKC {
+		t.Errorf("a zero Normalization overrode NFKC")
+	}
+
+	// Merging doesn't modify either profile.
+	if len(ECMAScript.ExtraContinue) != 1 {
+		t.Errorf("Merge modified the receiver's ExtraContinue: %q", ECMAScript.ExtraContinue)
+	}
+}
+
+func TestDeny(t *testing.T) {
+	// U+0430 CYRILLIC SMALL LETTER A, a confusable of the Latin 'a'.
+	p := Profile{Deny: []rune{'\u0430'}}
+	if !p.IsIdentString("paypal") {
+		t.Errorf("IsIdentString(%q) = false, expected true", "paypal")
+	}
+	if p.IsIdentString("p\u0430ypal") {
+		t.Errorf("IsIdentString(%q) = true, expected false", "p\u0430ypal")
+	}
+	if p.IsIdent([]rune("\u0430")) {
+		t.Errorf("IsIdent allowed a denied rune at the start")
+	}
+
+	_, err := p.Key("p\u0430ypal")
+	if !errors.Is(err, ErrDenied) || !errors.Is(err, ErrInvalidIdentifier) {
+		t.Errorf("Key(%q) error = %v, expected one wrapping ErrDenied", "p\u0430ypal", err)
+	}
+	if _, err := p.Key("1paypal"); errors.Is(err, ErrDenied) {
+		t.Errorf("Key(%q) error = %v, expected one not wrapping ErrDenied", "1paypal", err)
+	}
+
+	// Deny overrides runes a profile adds.
+	extra := Profile{ExtraStart: []rune{'$'}, Deny: []rune{'$'}}
+	if extra.IsIdentString("$a") {
+		t.Errorf("a denied ExtraStart rune was allowed")
+	}
+}
+
+func TestProfileRanges(t *testing.T) {
+	// Greek capitals may begin an identifier, ASCII digits may not appear
+	// at all, and '$' may continue one, given both ways.
+	p := Profile{
+		ExtraStart:          []rune{'$'},
+		ExtraStartRanges:    NewRuneSet([2]rune{0x391, 0x3a9}),
+		ExtraContinueRanges: NewRuneSet([2]rune{'$', '$'}),
+		DenyRanges:          NewRuneSet([2]rune{'0', '9'}),
+	}
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"$a", true},
+		{"a$", true},
+		{"\u0391bc", true},
+		{"a1", false},
+		{"a9", false},
+		{"a_", true},
+	}
+	for _, c := range cases {
+		if got := p.IsIdentString(c.s); got != c.expected {
+			t.Errorf("IsIdentString(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+
+	// The same set has the same fingerprint however it's given.
+	asRunes := Profile{ExtraStart: []rune{'$', '_'}}
+	asRanges := Profile{ExtraStartRanges: NewRuneSet([2]rune{'_', '_'}, [2]rune{'$', '$'})}
+	if asRunes.Fingerprint() != asRanges.Fingerprint() {
+		t.Errorf("ExtraStart and ExtraStartRanges holding the same runes have different fingerprints")
+	}
+
+	merged := asRunes.Merge(Profile{DenyRanges: NewRuneSet([2]rune{'0', '9'})})
+	if merged.IsIdentString("a1") || !merged.IsIdentString("_a") {
+		t.Errorf("Merge didn't combine ExtraStart and DenyRanges")
+	}
+}
+
+func TestMaxScripts(t *testing.T) {
+	p := Profile{MaxScripts: 1}
+	cases := []struct {
+		s        string
+		expected bool
+		comment  string
+	}{
+		{"caf\u00e9", true, "Latin"},
+		{"cafe\u0301", true, "Latin with an Inherited combining mark"},
+		{"x1", true, "Latin with a Common digit"},
+		{"\u03b1\u03b2", true, "Greek"},
+		{"caf\u03b5", false, "Latin and Greek"},
+		{"\u03b1b", false, "Greek and Latin"},
+		{"\u65e5\u672c", true, "Han"},
+		{"\u65e5\u672c\u304b\u306a", false, "Han and Hiragana"},
+	}
+	for _, c := range cases {
+		if got := p.IsIdentString(c.s); got != c.expected {
+			t.Errorf("%s: IsIdentString(%q) = %v, expected %v", c.comment, c.s, got, c.expected)
+		}
+		if got := p.IsIdent([]rune(c.s)); got != c.expected {
+			t.Errorf("%s: IsIdent(%q) = %v, expected %v", c.comment, c.s, got, c.expected)
+		}
+		if !(Profile{}).IsIdentString(c.s) {
+			t.Errorf("%s: %q isn't an identifier under the default profile", c.comment, c.s)
+		}
+	}
+
+	if !(Profile{MaxScripts: 2}).IsIdentString("caf\u03b5") {
+		t.Errorf("MaxScripts 2 rejected two scripts")
+	}
+}
+
+func TestCoverScripts(t *testing.T) {
+	set := func(scripts ...byte) scriptSet {
+		var s scriptSet
+		for _, i := range scripts {
+			s.add(i)
+		}
+		return s
+	}
+	cases := []struct {
+		sets     []scriptSet
+		n        int
+		expected bool
+	}{
+		{nil, 0, true},
+		{[]scriptSet{set(1)}, 0, false},
+		{[]scriptSet{set(1), set(2)}, 1, false},
+		{[]scriptSet{set(1, 2), set(2, 3)}, 1, true},
+		{[]scriptSet{set(1, 2), set(3, 4), set(1, 3)}, 1, false},
+		{[]scriptSet{set(1, 2), set(3, 4), set(1, 3)}, 2, true},
+		{[]scriptSet{set(1), set(200, 2), set(200)}, 2, true},
+	}
+	for _, c := range cases {
+		if got := coverScripts(c.sets, c.n); got != c.expected {
+			t.Errorf("coverScripts(%v, %d) = %v, expected %v", c.sets, c.n, got, c.expected)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package unicode_id_trie_rle
+
+import gotoken "go/token"
+
+// The rules for an ECMAScript IdentifierName, which additionally allow '$'
+// anywhere and '_' at the start of an identifier, and ZWNJ and ZWJ anywhere
+// after the first rune, including at the end. ECMAScript is specified in
+// terms of `ID_Start` and `ID_Continue`, which only differ from the `XID_`
+// properties used here for a handful of characters which aren't closed under
+// normalization.
+var ECMAScript = Profile{
+	ExtraStart:    []rune{'$', '_'},
+	ExtraContinue: []rune{'$'},
+
+	TrailingJoinControls: true,
+}
+
+// The rules for an unquoted JSON5 member name, which is any ECMAScript
+// IdentifierName, reserved words included.
+var JSON5 = ECMAScript
+
+// Returns whether s can be written as an unquoted member name in JSON5, such
+// as "$ref" or "π". Names which aren't identifiers, such as "with space" or
+// "1key", must be quoted.
+func IsJSON5MemberName(s string) bool {
+	return JSON5.IsIdentString(s)
+}
+
+// The rules for Python 3 identifiers from PEP 3131, which allow '_' at the
+// start of an identifier and compare identifiers in NFKC.
+var Python3 = Profile{
+	ExtraStart:    []rune{'_'},
+	Normalization: NFKC,
+}
+
+// The rules for Go identifiers. Go doesn't use `XID_Start` and
+// `XID_Continue`: an identifier is a letter or '_' followed by letters,
+// decimal digits and '_', so unlike IsIdent it refuses combining marks, like
+// the U+0301 in "cafe\u0301". Its rules can't be expressed as a Profile, so
+// Go has its own methods.
+type Go struct{}
+
+// Checks if s is an identifier which can be declared and referred to in Go
+// source, which excludes keywords and the blank identifier "_".
+func (Go) IsIdent(s string) bool {
+	return s != "_" && gotoken.IsIdentifier(s)
+}
+
+// Checks if s is a Go identifier, as Go.IsIdent does, or the blank
+// identifier "_", for the contexts which accept it, like the left side of
+// an assignment.
+func (Go) IsIdentOrBlank(s string) bool {
+	return gotoken.IsIdentifier(s)
+}
+
+// The identifier rules recommended for programming languages by Unicode
+// Technical Standard #55, on top of the `XID_Start` and `XID_Continue`
+// properties. It enforces the following guidelines:
+//
+//   - Default ignorable characters, which render invisibly, are rejected.
+//   - Deprecated characters, such as U+0149, are rejected.
+//   - ZWNJ and ZWJ are only allowed in the contexts of UAX #31 rules A1, A2
+//     and B, where they change how the runes around them are rendered:
+//     after a virama, or for ZWNJ, between two runes which would otherwise
+//     join, as in Arabic. See Profile.StrictJoinControls.
+//   - Only characters with the Recommended or Inclusion `Identifier_Type`
+//     are allowed, which excludes characters such as the Technical U+01C0
+//     LATIN LETTER DENTAL CLICK. See Profile.RequireRecommended.
+//   - Identifiers are compared in NFC, as done by Key.
+var UTS55 = Profile{
+	StrictJoinControls:      true,
+	Normalization:           NFC,
+	RejectDefaultIgnorables: true,
+	RejectDeprecated:        true,
+	RequireRecommended:      true,
+}
+
+// The named profiles, keyed by the names ProfileByName accepts.
+var profiles = map[string]Profile{
+	"default":    {},
+	"ecmascript": ECMAScript,
+	"json5":      JSON5,
+	"python3":    Python3,
+	"uts55":      UTS55,
+}
+
+// Returns the profile registered under name, such as "default" or
+// "ecmascript".
+func ProfileByName(name string) (Profile, bool) {
+	p, ok := profiles[name]
+	return p, ok
+}
+
+// The IsIdentString method of each profile ProfileByName knows, keyed by the
+// same names, for validators which dispatch on a configured profile name.
+var ProfileFuncs = func() map[string]func(string) bool {
+	funcs := make(map[string]func(string) bool, len(profiles))
+	for name, p := range profiles {
+		funcs[name] = p.IsIdentString
+	}
+	return funcs
+}()
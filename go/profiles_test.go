@@ -0,0 +1,132 @@
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestIsJSON5MemberName(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"$ref", true},
+		{"_id", true},
+		{"π", true},
+		{"camelCase42", true},
+		{"if", true},
+		{"class", true},
+		{"null", true},
+		{"with space", false},
+		{"1key", false},
+		{"a-b", false},
+		{"", false},
+		{"a\u200d", true},
+		{"a\u200c", true},
+		{"\u200db", false},
+		{"a\xff", false},
+	}
+	for _, c := range cases {
+		if got := IsJSON5MemberName(c.s); got != c.expected {
+			t.Errorf("IsJSON5MemberName(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestProfileFuncs(t *testing.T) {
+	if len(ProfileFuncs) != len(profiles) {
+		t.Fatalf("ProfileFuncs has %d entries, expected %d", len(ProfileFuncs), len(profiles))
+	}
+	for name, valid := range ProfileFuncs {
+		p, ok := ProfileByName(name)
+		if !ok {
+			t.Errorf("ProfileByName(%q) failed", name)
+			continue
+		}
+		for _, s := range []string{"abc", "1abc", "a b", ""} {
+			if got, expected := valid(s), p.IsIdentString(s); got != expected {
+				t.Errorf("ProfileFuncs[%q](%q) = %v, expected %v", name, s, got, expected)
+			}
+		}
+		if !valid("abc") {
+			t.Errorf("ProfileFuncs[%q] rejected \"abc\"", name)
+		}
+		if valid("1abc") {
+			t.Errorf("ProfileFuncs[%q] accepted \"1abc\"", name)
+		}
+	}
+
+	if _, ok := ProfileByName("cobol"); ok {
+		t.Errorf("ProfileByName accepted an unknown name")
+	}
+}
+
+func TestUTS55(t *testing.T) {
+	cases := []struct {
+		s       string
+		def     bool
+		uts55   bool
+		comment string
+	}{
+		{"café", true, true, "accented letter"},
+		{"a\u034fb", true, false, "grapheme joiner"},
+		{"a\ufe00", true, false, "variation selector"},
+		{"a\u200cb", true, false, "ZWNJ after a latin letter"},
+		{"\u0915\u094d\u200c\u0937", true, true, "ZWNJ after a virama"},
+		{"1a", false, false, "leading digit"},
+	}
+	for _, c := range cases {
+		if got := IsIdentString(c.s); got != c.def {
+			t.Errorf("%s: IsIdentString returned %v, expected %v", c.comment, got, c.def)
+		}
+		if got := UTS55.IsIdentString(c.s); got != c.uts55 {
+			t.Errorf("%s: UTS55 returned %v, expected %v", c.comment, got, c.uts55)
+		}
+	}
+
+	requireNormalization(t)
+	a, _ := UTS55.Key("caf\u00e9")
+	b, _ := UTS55.Key("cafe\u0301")
+	if a != b {
+		t.Errorf("UTS55 keys differ for composed and decomposed forms: %q and %q", a, b)
+	}
+}
+
+func TestUTS55IdentifierType(t *testing.T) {
+	if !identifierTypeData {
+		t.Skip("the tables were generated without IdentifierType.txt")
+	}
+	if !IsIdent([]rune{'a', 0x01c0}) {
+		t.Errorf("IsIdent rejected U+01C0")
+	}
+	if UTS55.IsIdent([]rune{'a', 0x01c0}) {
+		t.Errorf("UTS55 accepted the Technical U+01C0")
+	}
+	if !UTS55.IsIdentString("abc") {
+		t.Errorf("UTS55 rejected \"abc\"")
+	}
+}
+
+func TestGo(t *testing.T) {
+	cases := []struct {
+		s       string
+		ident   bool
+		orBlank bool
+	}{
+		{"_", false, true},
+		{"x", true, true},
+		{"\u03b1\u03b2", true, true},
+		{"_x", true, true},
+		{"x\u0663", true, true},
+		{"1x", false, false},
+		{"foo-bar", false, false},
+		{"func", false, false},
+		{"cafe\u0301", false, false},
+		{"", false, false},
+	}
+	for _, c := range cases {
+		if got := (Go{}).IsIdent(c.s); got != c.ident {
+			t.Errorf("Go.IsIdent(%q) = %v, expected %v", c.s, got, c.ident)
+		}
+		if got := (Go{}).IsIdentOrBlank(c.s); got != c.orBlank {
+			t.Errorf("Go.IsIdentOrBlank(%q) = %v, expected %v", c.s, got, c.orBlank)
+		}
+	}
+}
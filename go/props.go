@@ -0,0 +1,29 @@
+package unicode_id_trie_rle
+
+import "sort"
+
+// Returns the value of the run holding cp in one of the tables of runs
+// generated from the UCD files, where starts holds the first codepoint of
+// each run, beginning with 0, and values the value of each run.
+func runValue(starts []rune, values []byte, cp rune) byte {
+	i := sort.Search(len(starts), func(i int) bool {
+		return starts[i] > cp
+	})
+	if i == 0 {
+		return 0
+	}
+	return values[i-1]
+}
+
+// Returns the bits of the properties of cp which aren't part of its
+// identifier class, such as propDeprecated, as read from the UCD files given
+// to the generator.
+func propertiesOf(cp rune) byte {
+	return runValue(propRunStarts[:], propRunValues[:], cp)
+}
+
+// Returns the `Joining_Type` of cp, one of the joining constants such as
+// joiningDual.
+func joiningTypeOf(cp rune) byte {
+	return runValue(joiningRunStarts[:], joiningRunValues[:], cp)
+}
@@ -0,0 +1,114 @@
+package unicode_id_trie_rle
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Calls f with the range and the trimmed fields after it of each line of the
+// UCD file with the given name, which sits beside DerivedCoreProperties.txt.
+func readUCDFile(t *testing.T, name string, f func(start, end rune, fields []string)) {
+	t.Helper()
+	file, err := os.Open(filepath.Join(filepath.Dir(derivedDataPath(t)), name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		parts := strings.Split(line, ";")
+		if len(parts) < 2 {
+			continue
+		}
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		start, end, err := parseRangeField(parts[0])
+		if err != nil {
+			t.Fatalf("%s: failed to parse %q: %v", name, parts[0], err)
+		}
+		f(rune(start), rune(end), parts[1:])
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPropertiesMatchDerivedData(t *testing.T) {
+	expected := make([]byte, maxScalar)
+	setBits := func(bits map[string]byte) func(start, end rune, fields []string) {
+		return func(start, end rune, fields []string) {
+			for cp := start; cp <= end; cp++ {
+				expected[cp] |= bits[fields[0]]
+			}
+		}
+	}
+	readUCDFile(t, "PropList.txt", setBits(map[string]byte{
+		"Deprecated":                   propDeprecated,
+		"Prepended_Concatenation_Mark": propPrependedConcatenationMark,
+	}))
+	readUCDFile(t, "DerivedCoreProperties.txt", setBits(map[string]byte{
+		"Default_Ignorable_Code_Point": propDefaultIgnorable,
+	}))
+	readUCDFile(t, "DerivedGeneralCategory.txt", func(start, end rune, fields []string) {
+		for cp := start; cp <= end; cp++ {
+			switch fields[0] {
+			case "Cn":
+			case "Mn", "Mc":
+				expected[cp] |= propAssigned | propCombiningMark
+			default:
+				expected[cp] |= propAssigned
+			}
+		}
+	})
+
+	for cp := rune(0); cp < maxScalar; cp++ {
+		if got := propertiesOf(cp) &^ propAllowed; got != expected[cp] {
+			t.Fatalf("U+%04X: propertiesOf returned %#02x, expected %#02x", cp, got, expected[cp])
+		}
+	}
+}
+
+func TestScriptsMatchDerivedData(t *testing.T) {
+	expected := make([]string, maxScalar)
+	readUCDFile(t, "Scripts.txt", func(start, end rune, fields []string) {
+		for cp := start; cp <= end; cp++ {
+			expected[cp] = fields[0]
+		}
+	})
+
+	for cp := rune(0); cp < maxScalar; cp++ {
+		name := scriptNames[runValue(scriptRunStarts[:], scriptRunValues[:], cp)]
+		if expected[cp] == "" {
+			expected[cp] = "Unknown"
+		}
+		if name != expected[cp] {
+			t.Fatalf("U+%04X: script is %s, expected %s", cp, name, expected[cp])
+		}
+	}
+}
+
+func TestCombiningClassMatchesDerivedData(t *testing.T) {
+	expected := make([]uint8, maxScalar)
+	readUCDFile(t, "DerivedCombiningClass.txt", func(start, end rune, fields []string) {
+		ccc, err := strconv.ParseUint(fields[0], 10, 8)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", fields[0], err)
+		}
+		for cp := start; cp <= end; cp++ {
+			expected[cp] = uint8(ccc)
+		}
+	})
+
+	for cp := rune(0); cp < maxScalar; cp++ {
+		if got := combiningClass(cp); got != expected[cp] {
+			t.Fatalf("combiningClass(U+%04X) = %d, expected %d", cp, got, expected[cp])
+		}
+	}
+}
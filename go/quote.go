@@ -0,0 +1,135 @@
+package unicode_id_trie_rle
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The identifier rules shared by C, Rust and SQL, which all allow an
+// identifier to begin with an underscore. Go has rules of its own; see Go.
+var underscoreStart = Profile{ExtraStart: []rune{'_'}}
+
+func wordSet(words string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, w := range strings.Fields(words) {
+		set[w] = struct{}{}
+	}
+	return set
+}
+
+var cKeywords = wordSet(`
+	alignas alignof auto bool break case char const constexpr continue
+	default do double else enum extern false float for goto if inline int
+	long nullptr register restrict return short signed sizeof static
+	static_assert struct switch thread_local true typedef typeof
+	typeof_unqual union unsigned void volatile while _Alignas _Alignof
+	_Atomic _BitInt _Bool _Complex _Decimal128 _Decimal32 _Decimal64
+	_Generic _Imaginary _Noreturn _Static_assert _Thread_local
+`)
+
+var rustKeywords = wordSet(`
+	as async await break const continue crate dyn else enum extern false
+	fn for if impl in let loop match mod move mut pub ref return self Self
+	static struct super trait true type unsafe use where while abstract
+	become box do final gen macro override priv try typeof unsized virtual
+	yield
+`)
+
+// Keywords which can't be written as raw identifiers in Rust.
+var rustNonRawKeywords = wordSet(`crate self Self super`)
+
+// The reserved words common to the major SQL dialects. Quoting a word which
+// doesn't need it is harmless, so this errs on the side of inclusion.
+var sqlKeywords = wordSet(`
+	add all alter and any as asc between by case cast check column
+	constraint create cross current current_date current_time
+	current_timestamp current_user default delete desc distinct drop else
+	end escape except exists false fetch for foreign from full grant group
+	having in index inner insert intersect into is join key left like limit
+	natural not null offset on or order outer primary references right
+	select session_user set some table then to true union unique update
+	user using values when where with
+`)
+
+func isReserved(words map[string]struct{}, s string) bool {
+	_, ok := words[s]
+	return ok
+}
+
+// Returns s written as an identifier in the target language lang, which is
+// one of "c", "go", "rust", "sql" or "mysql".
+//
+// Identifiers which are already safe are returned unchanged. Otherwise, s is
+// written as a Rust raw identifier (r#type), a double-quoted SQL identifier
+// or a backtick-quoted MySQL identifier. An error is returned when lang is
+// unknown or has no way to write s as an identifier, such as a keyword in C
+// or Go. Go identifiers are checked with Go.IsIdent, so combining marks and
+// the blank identifier "_" are refused.
+func QuoteIdent(s string, lang string) (string, error) {
+	valid := underscoreStart.IsIdentString(s)
+	switch lang {
+	case "c":
+		if !valid || isReserved(cKeywords, s) {
+			return "", fmt.Errorf("%q can't be used as an identifier in %s", s, lang)
+		}
+		return s, nil
+	case "go":
+		if !(Go{}).IsIdent(s) {
+			return "", fmt.Errorf("%q can't be used as an identifier in %s", s, lang)
+		}
+		return s, nil
+	case "rust":
+		if !valid || s == "_" || isReserved(rustNonRawKeywords, s) {
+			return "", fmt.Errorf("%q can't be used as an identifier in %s", s, lang)
+		}
+		if isReserved(rustKeywords, s) {
+			return "r#" + s, nil
+		}
+		return s, nil
+	case "sql", "mysql":
+		if valid && !isReserved(sqlKeywords, strings.ToLower(s)) {
+			return s, nil
+		}
+		if lang == "mysql" {
+			return "`" + strings.ReplaceAll(s, "`", "``") + "`", nil
+		}
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`, nil
+	default:
+		return "", fmt.Errorf("unknown language %q", lang)
+	}
+}
+
+// Returns whether writing cp into a comment or message could corrupt or
+// disguise the output around it.
+func unsafeInComment(cp rune) bool {
+	return unicode.IsControl(cp) || isBidiControl(cp) || isDefaultIgnorable(cp) ||
+		unicode.In(cp, unicode.Zl, unicode.Zp)
+}
+
+// Returns s with each control character, bidi control, line or paragraph
+// separator and other default ignorable character replaced by a visible
+// escape like \u202E, so that s can be embedded in a generated comment or an
+// error message without corrupting the text around it or, as in the Trojan
+// Source attacks, disguising it.
+// Invalid UTF-8 bytes are escaped like \xFF, and everything else, including
+// any backslashes, is left as it is.
+func SafeForComment(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case c == utf8.RuneError && size == 1:
+			fmt.Fprintf(&b, "\\x%02X", s[i])
+		case !unsafeInComment(c):
+			b.WriteString(s[i : i+size])
+		case c > 0xffff:
+			fmt.Fprintf(&b, "\\U%08X", c)
+		default:
+			fmt.Fprintf(&b, "\\u%04X", c)
+		}
+		i += size
+	}
+	return b.String()
+}
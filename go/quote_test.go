@@ -0,0 +1,64 @@
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestQuoteIdent(t *testing.T) {
+	cases := []struct {
+		s, lang  string
+		expected string
+		err      bool
+	}{
+		{"count", "sql", "count", false},
+		{"select", "sql", `"select"`, false},
+		{"SELECT", "sql", `"SELECT"`, false},
+		{"my col", "sql", `"my col"`, false},
+		{`a"b`, "sql", `"a""b"`, false},
+		{"order", "mysql", "`order`", false},
+		{"match", "rust", "r#match", false},
+		{"café", "rust", "café", false},
+		{"self", "rust", "", true},
+		{"_", "rust", "", true},
+		{"_tmp", "c", "_tmp", false},
+		{"int", "c", "", true},
+		{"func", "go", "", true},
+		{"x1", "go", "x1", false},
+		{"1x", "go", "", true},
+		{"café", "go", "café", false},
+		{"cafe\u0301", "go", "", true},
+		{"_", "go", "", true},
+		{"x", "cobol", "", true},
+	}
+	for _, c := range cases {
+		got, err := QuoteIdent(c.s, c.lang)
+		if (err != nil) != c.err {
+			t.Errorf("QuoteIdent(%q, %q) returned error %v", c.s, c.lang, err)
+			continue
+		}
+		if got != c.expected {
+			t.Errorf("QuoteIdent(%q, %q) = %q, expected %q", c.s, c.lang, got, c.expected)
+		}
+	}
+}
+
+func TestSafeForComment(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected string
+	}{
+		{"caf\u00e9", "caf\u00e9"},
+		{"cafe\u0301", "cafe\u0301"},
+		{"admin\u202e\u2066", `admin\u202E\u2066`},
+		{"a\x00b", `a\u0000b`},
+		{"line\nbreak\u2028", `line\u000Abreak\u2028`},
+		{"a\u200db", `a\u200Db`},
+		{"tag\U000e0041", `tag\U000E0041`},
+		{"bad\xffbyte", `bad\xFFbyte`},
+		{`back\slash`, `back\slash`},
+		{"", ""},
+	}
+	for _, c := range cases {
+		if got := SafeForComment(c.s); got != c.expected {
+			t.Errorf("SafeForComment(%q) = %q, expected %q", c.s, got, c.expected)
+		}
+	}
+}
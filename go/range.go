@@ -0,0 +1,42 @@
+package unicode_id_trie_rle
+
+import "unicode"
+
+// Calls f with each codepoint in lo..hi, inclusive and in ascending order,
+// whose class has every bit of class set, such as each Start character of
+// the Greek block, stopping early if f returns false. Since every class has
+// the bits of Other, passing Other visits every codepoint.
+//
+// Whole runs of the generated tables are checked at once, so runs of the
+// wrong class are skipped without looking at their codepoints.
+func EachRuneInRange(lo, hi rune, class IdentifierClass, f func(rune) bool) {
+	lo, hi = max(lo, 0), min(hi, unicode.MaxRune)
+	for cp := lo; cp <= hi; {
+		got, last := classRun(cp)
+		last = min(last, hi)
+		if got&class == class {
+			for c := cp; c <= last; c++ {
+				if !f(c) {
+					return
+				}
+			}
+		}
+		cp = last + 1
+	}
+}
+
+// Returns the class of cp, as UnicodeIdentifierClass does, along with the
+// last codepoint of the run of codepoints beginning at cp which share it.
+// The run may be cut short, such as at the end of a leaf's block.
+func classRun(cp rune) (IdentifierClass, rune) {
+	switch {
+	case cp < minCodepoint:
+		return Other, minCodepoint - 1
+	case cp > maxCodepoint:
+		return Other, unicode.MaxRune
+	case cp < startCodepoint:
+		return asciiTable[cp], cp
+	}
+
+	return sourceClassRun(compiledTables{}, cp)
+}
@@ -0,0 +1,80 @@
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"testing"
+)
+
+// Returns the codepoints EachRuneInRange visits.
+func collectRunes(lo, hi rune, class IdentifierClass) []rune {
+	var runes []rune
+	EachRuneInRange(lo, hi, class, func(c rune) bool {
+		runes = append(runes, c)
+		return true
+	})
+	return runes
+}
+
+// Returns the codepoints in lo..hi whose class has the bits of class, checking
+// each one.
+func scanRunes(lo, hi rune, class IdentifierClass) []rune {
+	var runes []rune
+	for c := lo; c <= hi; c++ {
+		if UnicodeIdentifierClass(c)&class == class {
+			runes = append(runes, c)
+		}
+	}
+	return runes
+}
+
+func TestEachRuneInRange(t *testing.T) {
+	greek := collectRunes(0x0370, 0x03ff, Start)
+	if expected := scanRunes(0x0370, 0x03ff, Start); !slices.Equal(greek, expected) {
+		t.Errorf("Start characters of U+0370..U+03FF are %U, expected %U", greek, expected)
+	}
+	if len(greek) == 0 || greek[0] != 0x0370 {
+		t.Errorf("U+0370 GREEK CAPITAL LETTER HETA wasn't visited: %U", greek)
+	}
+
+	cases := []struct {
+		lo, hi rune
+		class  IdentifierClass
+	}{
+		{0, 0x7f, Continue},
+		{0x20, 0x300, Start | Continue},
+		{0x3fe, 0x802, Start},
+		{0x1f000, 0x20100, Start},
+		{0xeffff, 0x10ffff, Start},
+		{0x10fff0, 0x10ffff, Other},
+		{0x41, 0x41, Start},
+		{0x42, 0x41, Start},
+	}
+	for _, c := range cases {
+		got := collectRunes(c.lo, c.hi, c.class)
+		if expected := scanRunes(c.lo, c.hi, c.class); !slices.Equal(got, expected) {
+			t.Errorf("EachRuneInRange(%U, %U, %d) visited %d runes, expected %d", c.lo, c.hi, c.class, len(got), len(expected))
+		}
+	}
+
+	if got := collectRunes(-5, 2, Other); !slices.Equal(got, []rune{0, 1, 2}) {
+		t.Errorf("EachRuneInRange(-5, 2, Other) visited %U", got)
+	}
+
+	var first []rune
+	EachRuneInRange(0x0370, 0x03ff, Start, func(c rune) bool {
+		first = append(first, c)
+		return len(first) < 3
+	})
+	if !slices.Equal(first, greek[:3]) {
+		t.Errorf("EachRuneInRange didn't stop when f returned false: %U", first)
+	}
+}
+
+func TestEachRuneInRangeWholeSpace(t *testing.T) {
+	for _, class := range []IdentifierClass{Start, Continue} {
+		got := collectRunes(0, maxScalar-1, class)
+		if expected := scanRunes(0, maxScalar-1, class); !slices.Equal(got, expected) {
+			t.Errorf("EachRuneInRange visited %d runes of class %d, expected %d", len(got), class, len(expected))
+		}
+	}
+}
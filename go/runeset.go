@@ -0,0 +1,91 @@
+package unicode_id_trie_rle
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// A set of runes, stored as sorted, disjoint ranges so that membership is a
+// binary search however many runes the set holds. The zero value is the empty
+// set.
+type RuneSet struct {
+	// Each range is first..last inclusive, sorted and neither overlapping
+	// nor adjacent to another.
+	ranges [][2]rune
+}
+
+// Returns the set of runes covered by ranges, each of which is first..last
+// inclusive, such as [2]rune{'a', 'z'}. The ranges may be given in any order
+// and may overlap. A range whose first rune is greater than its last is
+// empty.
+func NewRuneSet(ranges ...[2]rune) RuneSet {
+	sorted := make([][2]rune, 0, len(ranges))
+	for _, r := range ranges {
+		if r[0] <= r[1] {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b [2]rune) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	var merged [][2]rune
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && r[0] <= merged[n-1][1]+1 {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return RuneSet{ranges: merged}
+}
+
+// Returns the set holding each of runes, for sets which are easiest to write
+// out rune by rune, like RuneSetOf('$', '_').
+func RuneSetOf(runes ...rune) RuneSet {
+	ranges := make([][2]rune, len(runes))
+	for i, c := range runes {
+		ranges[i] = [2]rune{c, c}
+	}
+	return NewRuneSet(ranges...)
+}
+
+// Checks if cp is in the set.
+func (rs RuneSet) Contains(cp rune) bool {
+	i := sort.Search(len(rs.ranges), func(i int) bool {
+		return rs.ranges[i][1] >= cp
+	})
+	return i < len(rs.ranges) && rs.ranges[i][0] <= cp
+}
+
+// Returns whether the set holds no runes.
+func (rs RuneSet) IsEmpty() bool {
+	return len(rs.ranges) == 0
+}
+
+// Returns the ranges of the set, sorted and merged so that no two overlap or
+// touch, which makes them the same for any two equal sets.
+func (rs RuneSet) Ranges() [][2]rune {
+	return slices.Clone(rs.ranges)
+}
+
+// Returns the set of runes in either rs or other.
+func (rs RuneSet) Union(other RuneSet) RuneSet {
+	return NewRuneSet(slices.Concat(rs.ranges, other.ranges)...)
+}
+
+// Returns the set's ranges in the form "U+0024 U+0041..U+005A".
+func (rs RuneSet) String() string {
+	parts := make([]string, len(rs.ranges))
+	for i, r := range rs.ranges {
+		if r[0] == r[1] {
+			parts[i] = fmt.Sprintf("%U", r[0])
+		} else {
+			parts[i] = fmt.Sprintf("%U..%U", r[0], r[1])
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,96 @@
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRuneSetContains(t *testing.T) {
+	rs := NewRuneSet([2]rune{'a', 'f'}, [2]rune{'0', '9'}, [2]rune{'$', '$'})
+	cases := []struct {
+		cp       rune
+		expected bool
+	}{
+		{'a', true},
+		{'f', true},
+		{'c', true},
+		{'`', false},
+		{'g', false},
+		{'0', true},
+		{'9', true},
+		{'/', false},
+		{':', false},
+		{'$', true},
+		{'#', false},
+		{'%', false},
+		{0, false},
+		{0x10ffff, false},
+	}
+	for _, c := range cases {
+		if got := rs.Contains(c.cp); got != c.expected {
+			t.Errorf("Contains(%q) = %v, expected %v", c.cp, got, c.expected)
+		}
+	}
+
+	var empty RuneSet
+	if !empty.IsEmpty() || empty.Contains(0) {
+		t.Errorf("the zero RuneSet isn't empty")
+	}
+}
+
+func TestNewRuneSetMergesRanges(t *testing.T) {
+	rs := NewRuneSet(
+		[2]rune{'m', 'p'},
+		[2]rune{'a', 'c'},
+		[2]rune{'d', 'f'},
+		[2]rune{'b', 'e'},
+		[2]rune{'z', 'x'},
+		[2]rune{'o', 'q'},
+	)
+	expected := [][2]rune{{'a', 'f'}, {'m', 'q'}}
+	if got := rs.Ranges(); !slices.Equal(got, expected) {
+		t.Errorf("Ranges() = %q, expected %q", got, expected)
+	}
+	if rs.Contains('x') || rs.Contains('z') {
+		t.Errorf("an inverted range wasn't treated as empty")
+	}
+
+	if got := RuneSetOf('$', '_', '$', '#').String(); got != "U+0023..U+0024 U+005F" {
+		t.Errorf("RuneSetOf String() = %q", got)
+	}
+	union := RuneSetOf('a').Union(NewRuneSet([2]rune{'b', 'c'}))
+	if got := union.Ranges(); !slices.Equal(got, [][2]rune{{'a', 'c'}}) {
+		t.Errorf("Union Ranges() = %q, expected [a c]", got)
+	}
+}
+
+func BenchmarkRuneSet(b *testing.B) {
+	var ranges [][2]rune
+	m := make(map[rune]bool)
+	for cp := rune(0x100); cp < 0x2000; cp += 16 {
+		ranges = append(ranges, [2]rune{cp, cp + 3})
+		for c := cp; c <= cp+3; c++ {
+			m[c] = true
+		}
+	}
+	rs := NewRuneSet(ranges...)
+
+	b.Run("RuneSet", func(b *testing.B) {
+		n := 0
+		for i := 0; i < b.N; i++ {
+			if rs.Contains(rune(i & 0x1fff)) {
+				n++
+			}
+		}
+		_ = n
+	})
+	b.Run("map", func(b *testing.B) {
+		n := 0
+		for i := 0; i < b.N; i++ {
+			if m[rune(i&0x1fff)] {
+				n++
+			}
+		}
+		_ = n
+	})
+}
@@ -0,0 +1,104 @@
+package unicode_id_trie_rle
+
+import "slices"
+
+// A set of scripts, with a bit for each index in scriptNames.
+type scriptSet [4]uint64
+
+func (s *scriptSet) add(i byte) {
+	s[i/64] |= 1 << (i % 64)
+}
+
+func (s scriptSet) has(i byte) bool {
+	return s[i/64]&(1<<(i%64)) != 0
+}
+
+func (s scriptSet) intersect(other scriptSet) scriptSet {
+	for i := range s {
+		s[i] &= other[i]
+	}
+	return s
+}
+
+func (s scriptSet) isEmpty() bool {
+	return s == scriptSet{}
+}
+
+// Every script, which is the set of scripts of a rune shared by all of
+// them.
+var allScripts = scriptSet{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}
+
+// The indexes in scriptNames of the Common and Inherited scripts.
+var commonScript, inheritedScript = scriptIndex("Common"), scriptIndex("Inherited")
+
+// Returns the index in scriptNames of the script with the given name, or 0,
+// the index of Unknown, if there's no such script.
+func scriptIndex(name string) byte {
+	return byte(max(slices.Index(scriptNames[:], name), 0))
+}
+
+// Returns the scripts cp is used with, as given by its `Script_Extensions`,
+// or allScripts if that's just Common or Inherited, like for digits and most
+// combining marks. This is the augmented script set of Unicode Technical
+// Standard #39, except that Han isn't expanded to the scripts which use it.
+func scriptsOf(cp rune) scriptSet {
+	var set scriptSet
+	if ext := runValue(scriptExtensionRunStarts[:], scriptExtensionRunValues[:], cp); ext != 0 {
+		for _, i := range scriptSets[ext] {
+			set.add(i)
+		}
+		return set
+	}
+
+	script := runValue(scriptRunStarts[:], scriptRunValues[:], cp)
+	if script == commonScript || script == inheritedScript {
+		return allScripts
+	}
+	set.add(script)
+	return set
+}
+
+// Returns the byte offset of the first rune in the identifier at the start of
+// s which shares no script with the runes before it, or -1 if the identifier
+// is written in a single script. Runes in the Common and Inherited scripts,
+// such as digits and combining marks, belong to every script, and a rune
+// used by several scripts, like U+30FC KATAKANA-HIRAGANA PROLONGED SOUND
+// MARK, belongs to each of those given by its `Script_Extensions`.
+func FirstScriptBoundary(s string) int {
+	boundary := -1
+	resolved := allScripts
+	Profile{}.identRunes(s)(func(off int, c rune) bool {
+		next := resolved.intersect(scriptsOf(c))
+		if next.isEmpty() {
+			boundary = off
+			return false
+		}
+		resolved = next
+		return true
+	})
+	return boundary
+}
+
+// Checks if s is a unicode identifier under the profile p whose runes all
+// belong to one of the allowed scripts, named as in Scripts.txt, such as
+// "Latin" or "Han". Runes in the Common and Inherited scripts, such as
+// digits and combining marks, are allowed regardless, and a rune used by
+// several scripts is allowed if one of them is.
+func (p Profile) IsIdentInScripts(s string, allowed []string) bool {
+	if !p.IsIdentString(s) {
+		return false
+	}
+
+	var set scriptSet
+	for _, name := range allowed {
+		if i := scriptIndex(name); i != 0 {
+			set.add(i)
+		}
+	}
+	for _, c := range s {
+		if set.intersect(scriptsOf(c)).isEmpty() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestFirstScriptBoundary(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected int
+	}{
+		{"café", -1},
+		{"cafe\u0301", -1},
+		{"x1", -1},
+		{"", -1},
+		{"abcд", 3},
+		{"a1б", 2},
+		{"1abcд", -1},
+		{"abc дом", -1},
+		{"αβγabc", 6},
+	}
+	for _, c := range cases {
+		if got := FirstScriptBoundary(c.s); got != c.expected {
+			t.Errorf("FirstScriptBoundary(%q) = %d, expected %d", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestIsIdentInScripts(t *testing.T) {
+	latin := []string{"Latin"}
+	cases := []struct {
+		s        string
+		allowed  []string
+		expected bool
+	}{
+		{"cafe", latin, true},
+		{"café_42", latin, true},
+		{"πλ", latin, false},
+		{"caféπ", latin, false},
+		{"πλ", []string{"Latin", "Greek"}, true},
+		{"名前abc", []string{"Latin", "Han"}, true},
+		{"1cafe", latin, false},
+		{"", latin, false},
+	}
+	for _, c := range cases {
+		if got := (Profile{}).IsIdentInScripts(c.s, c.allowed); got != c.expected {
+			t.Errorf("IsIdentInScripts(%q, %q) = %v, expected %v", c.s, c.allowed, got, c.expected)
+		}
+	}
+
+	if !ECMAScript.IsIdentInScripts("$cafe", latin) {
+		t.Errorf("ECMAScript rejected \"$cafe\"")
+	}
+}
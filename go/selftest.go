@@ -0,0 +1,142 @@
+package unicode_id_trie_rle
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Checks the internal invariants of the compiled-in tables: that every index
+// stays in bounds, that the leaf offsets and the run starts within each leaf
+// are ordered, that both leaf lookups agree on every block, that the varint
+// run stream agrees with the run arrays, and that the hand-written ASCII
+// fast path agrees with the ASCII classes the generator emitted.
+//
+// This is an invariant check, not a data-correctness check: it can't tell
+// whether the tables match the Unicode data they were generated from, only
+// that UnicodeIdentifierClass can't misbehave while reading them. It needs
+// nothing but the package itself, so it can run where
+// DerivedCoreProperties.txt isn't available.
+func SelfTest() error {
+	if len(leafRunStarts) != len(leafRunValues) {
+		return fmt.Errorf("leafRunStarts has %d entries but leafRunValues has %d", len(leafRunStarts), len(leafRunValues))
+	}
+	if len(leafOffsets) < 2 {
+		return fmt.Errorf("leafOffsets has %d entries, expected at least 2", len(leafOffsets))
+	}
+	if leafOffsets[0] != 0 {
+		return fmt.Errorf("leafOffsets[0] is %d, expected 0", leafOffsets[0])
+	}
+	if int(leafOffsets[len(leafOffsets)-1]) != len(leafRunStarts) {
+		return fmt.Errorf("leafOffsets ends at %d but there are %d leaf runs", leafOffsets[len(leafOffsets)-1], len(leafRunStarts))
+	}
+
+	leafCount := len(leafOffsets) - 1
+	for i := 0; i < leafCount; i++ {
+		start, end := leafOffsets[i], leafOffsets[i+1]
+		if start >= end {
+			return fmt.Errorf("leaf %d is empty or leafOffsets decreases (%d..%d)", i, start, end)
+		}
+
+		runs := leafRunStarts[start:end]
+		if i > 0 && runs[0] != 0 {
+			return fmt.Errorf("leaf %d starts at %#x, expected 0", i, runs[0])
+		}
+		for j := 1; j < len(runs); j++ {
+			if runs[j] < runs[j-1] {
+				return fmt.Errorf("leaf %d run starts decrease at run %d (%#x < %#x)", i, j, runs[j], runs[j-1])
+			}
+		}
+		if runs[len(runs)-1] != 1<<shift {
+			return fmt.Errorf("leaf %d ends at %#x, expected %#x", i, runs[len(runs)-1], 1<<shift)
+		}
+
+		for _, v := range leafRunValues[start:end] {
+			if v&^(Start|Continue|customBits) != 0 {
+				return fmt.Errorf("leaf %d has invalid class %#x", i, v)
+			}
+		}
+	}
+
+	if len(level1Table)*lowerSize != blockCount {
+		return fmt.Errorf("level1Table covers %d blocks, expected %d", len(level1Table)*lowerSize, blockCount)
+	}
+	for top, idx := range level1Table {
+		if (int(idx)+1)*lowerSize > len(level2Tables) {
+			return fmt.Errorf("level1Table[%d] = %d is out of bounds", top, idx)
+		}
+	}
+	for i, leafIdx := range level2Tables {
+		if int(leafIdx) >= leafCount {
+			return fmt.Errorf("level2Tables[%d] = %d is out of bounds", i, leafIdx)
+		}
+	}
+
+	if len(mphKeys) != len(mphSeeds) || len(mphLeaves) != len(mphSeeds) {
+		return fmt.Errorf("perfect hash tables have mismatched lengths")
+	}
+	for block := uint32(0); block < blockCount; block++ {
+		if trie, mph := trieBlockLeaf(block), mphBlockLeaf(block); trie != mph {
+			return fmt.Errorf("block %d maps to leaf %d through the trie but %d through the perfect hash", block, trie, mph)
+		}
+	}
+
+	if useVarintRuns {
+		if err := checkRunStream(leafCount); err != nil {
+			return err
+		}
+	}
+
+	// ASCII is handled only by asciiTable, and the trie begins at
+	// startCodepoint, so asciiTable is compared with the classes the
+	// generator emitted for ASCII instead.
+	if leafRunStarts[0] != startCodepoint {
+		return fmt.Errorf("the first leaf starts at %#x, expected %#x", leafRunStarts[0], startCodepoint)
+	}
+	for c, class := range asciiTable {
+		if class&Start != 0 && class&Continue == 0 {
+			return fmt.Errorf("asciiTable marks %q as Start but not Continue", rune(c))
+		}
+		if rune(c) < minCodepoint || rune(c) > maxCodepoint {
+			continue
+		}
+		if expected := asciiClasses[c] & (Start | Continue); class != expected {
+			return fmt.Errorf("asciiTable gives %q class %d, but the data gives %d", rune(c), class, expected)
+		}
+	}
+
+	return nil
+}
+
+// Checks that decoding each leaf's runs from the varint run stream, as
+// streamLeafValue does, gives the same runs as leafRunStarts and
+// leafRunValues, less the final run which only marks the end of the block.
+func checkRunStream(leafCount int) error {
+	if len(runStreamOffsets) != leafCount+1 || int(runStreamOffsets[leafCount]) != len(runStream) {
+		return fmt.Errorf("runStreamOffsets doesn't span the run stream")
+	}
+	for i := 0; i < leafCount; i++ {
+		if runStreamOffsets[i] > runStreamOffsets[i+1] {
+			return fmt.Errorf("runStreamOffsets decreases at leaf %d", i)
+		}
+		stream := runStream[runStreamOffsets[i]:runStreamOffsets[i+1]]
+		runs := leafRunStarts[leafOffsets[i] : leafOffsets[i+1]-1]
+		values := leafRunValues[leafOffsets[i] : leafOffsets[i+1]-1]
+		start, j := uint16(0), 0
+		for len(stream) > 0 {
+			delta, n := binary.Uvarint(stream)
+			if n <= 0 || n == len(stream) || delta > 1<<shift {
+				return fmt.Errorf("leaf %d's run stream is malformed", i)
+			}
+			start += uint16(delta)
+			if j == len(runs) || start != runs[j] || IdentifierClass(stream[n]) != values[j] {
+				return fmt.Errorf("leaf %d's run stream differs from its run arrays at run %d", i, j)
+			}
+			stream = stream[n+1:]
+			j++
+		}
+		if j != len(runs) {
+			return fmt.Errorf("leaf %d's run stream has %d runs, expected %d", i, j, len(runs))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+//go:build go1.23
+
+package unicode_id_trie_rle
+
+import (
+	"iter"
+	"unicode/utf8"
+)
+
+// Returns an iterator over the byte offset and value of each rune of s, for
+// as long as s remains a valid identifier. Iteration stops at the first rune
+// which can't continue the identifier, so the runes yielded are always the
+// longest identifier at the start of s.
+//
+// ZWNJ and ZWJ are yielded once a rune which continues the identifier follows
+// them, since they can't end one. Invalid UTF-8 ends the identifier.
+func IdentRunes(s string) iter.Seq2[int, rune] {
+	return Profile{}.identRunes(s)
+}
+
+// Returns an iterator which splits s into alternating spans of identifiers
+// and non-identifiers under the profile p, reporting whether each span is an
+// identifier. Every byte of s belongs to exactly one span, and each
+// identifier is as long as possible, which suits syntax highlighters.
+func (p Profile) Tokenize(s string) iter.Seq2[string, bool] {
+	return func(yield func(string, bool) bool) {
+		other := 0
+		for i := 0; i < len(s); {
+			n := p.identPrefixLen(s[i:])
+			if n == 0 {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				i += size
+				continue
+			}
+
+			if other < i && !yield(s[other:i], false) {
+				return
+			}
+			if !yield(s[i:i+n], true) {
+				return
+			}
+			i += n
+			other = i
+		}
+		if other < len(s) {
+			yield(s[other:], false)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+//go:build go1.23
+
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"testing"
+)
+
+type offsetRune struct {
+	off int
+	r   rune
+}
+
+func collectIdentRunes(s string) []offsetRune {
+	var out []offsetRune
+	for off, r := range IdentRunes(s) {
+		out = append(out, offsetRune{off, r})
+	}
+	return out
+}
+
+func TestIdentRunes(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected []offsetRune
+	}{
+		{"abc-def", []offsetRune{{0, 'a'}, {1, 'b'}, {2, 'c'}}},
+		{"1abc", nil},
+		{"", nil},
+		{"été!", []offsetRune{{0, 'é'}, {2, 't'}, {3, 'é'}}},
+		{"a\u200cb", []offsetRune{{0, 'a'}, {1, ZWNJ}, {4, 'b'}}},
+		{"a\u200c\u200d", []offsetRune{{0, 'a'}}},
+		{"a\u200c-b", []offsetRune{{0, 'a'}}},
+		{"ab\xffc", []offsetRune{{0, 'a'}, {1, 'b'}}},
+	}
+	for _, c := range cases {
+		got := collectIdentRunes(c.s)
+		if !slices.Equal(got, c.expected) {
+			t.Errorf("IdentRunes(%q) yielded %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestIdentRunesStopsEarly(t *testing.T) {
+	n := 0
+	for range IdentRunes("abcdef") {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Fatalf("expected iteration to stop after 2 runes, got %d", n)
+	}
+}
+
+type span struct {
+	text  string
+	ident bool
+}
+
+func collectTokens(p Profile, s string) []span {
+	var out []span
+	for text, ident := range p.Tokenize(s) {
+		out = append(out, span{text, ident})
+	}
+	return out
+}
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		p        Profile
+		s        string
+		expected []span
+	}{
+		{ECMAScript, "let $x = 1;", []span{
+			{"let", true}, {" ", false}, {"$x", true}, {" = 1;", false},
+		}},
+		{Profile{}, "let $x = 1;", []span{
+			{"let", true}, {" $", false}, {"x", true}, {" = 1;", false},
+		}},
+		{ECMAScript, "_a+b", []span{{"_a", true}, {"+", false}, {"b", true}}},
+		{Profile{}, "a\u200c b", []span{{"a", true}, {"\u200c ", false}, {"b", true}}},
+		{Profile{}, "", nil},
+		{Profile{}, "  ", []span{{"  ", false}}},
+	}
+	for _, c := range cases {
+		got := collectTokens(c.p, c.s)
+		if !slices.Equal(got, c.expected) {
+			t.Errorf("Tokenize(%q) yielded %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
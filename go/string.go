@@ -0,0 +1,331 @@
+package unicode_id_trie_rle
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Returns whether every byte of s is ASCII.
+func IsASCIIOnly(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= startCodepoint {
+			return false
+		}
+	}
+	return true
+}
+
+// Checks if s is a unicode identifier made up only of ASCII characters,
+// looking each byte up in the ASCII table directly. Any non-ASCII byte makes
+// it return false, so callers should fall back to a full check when
+// IsASCIIOnly(s) is false.
+func IsASCIIIdentifier(s string) bool {
+	if s == "" || !IsASCIIOnly(s) {
+		return false
+	}
+	if asciiTable[s[0]]&Start == 0 {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if asciiTable[s[i]]&Continue == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Counts the runes of s in each identifier class: those which are neither
+// Start nor Continue, those which are only Start, those which are only
+// Continue, and those which are both. Invalid UTF-8 is counted as Other, one
+// byte at a time.
+func ClassCounts(s string) (other, start, continueOnly, both int) {
+	for _, c := range s {
+		switch UnicodeIdentifierClass(c) {
+		case Start:
+			start++
+		case Continue:
+			continueOnly++
+		case Start | Continue:
+			both++
+		default:
+			other++
+		}
+	}
+	return other, start, continueOnly, both
+}
+
+// Checks if s is a sequence of one or more identifiers separated by sep, like
+// "foo.bar.baz". See Profile.IsQualifiedName.
+func IsQualifiedName(s string, sep rune) bool {
+	return Profile{}.IsQualifiedName(s, sep)
+}
+
+// Checks if s is a unicode identifier, as defined by IsIdent. Invalid UTF-8
+// is never part of an identifier, and neither is U+FEFF, so a byte order mark
+// read from the start of a file must be removed with TrimBOM first.
+func IsIdentString(s string) bool {
+	return Profile{}.IsIdentString(s)
+}
+
+// Checks if s is a unicode identifier, as defined by IsIdent, once its escape
+// sequences are resolved. Before each rune, decode is called with the rest of
+// s; if an escape sequence begins there, it returns the rune it stands for,
+// the number of bytes it spans and true. Otherwise, the next rune is decoded
+// from s as UTF-8. This keeps the escape syntax, such as C's \u00e9 or CSS's
+// \E9, up to the caller.
+func IsEscapedIdent(s string, decode func(string) (rune, int, bool)) bool {
+	var v identScanner
+	for i := 0; i < len(s); {
+		c, n, ok := decode(s[i:])
+		if !ok || n <= 0 {
+			c, n = utf8.DecodeRuneInString(s[i:])
+		}
+		if !v.next(c) {
+			return false
+		}
+		i += n
+	}
+	return v.valid()
+}
+
+// Returns the longest prefix shared by every string in idents which is itself
+// a unicode identifier, as defined by IsIdent, or "" if there's none. The
+// strings are compared rune by rune, so a prefix never ends in the middle of
+// a multibyte rune, which suits completing an identifier from candidates.
+func CommonIdentPrefix(idents []string) string {
+	if len(idents) == 0 {
+		return ""
+	}
+
+	prefix := idents[0]
+	for _, s := range idents[1:] {
+		n := 0
+		for n < len(prefix) && n < len(s) {
+			a, size := utf8.DecodeRuneInString(prefix[n:])
+			b, _ := utf8.DecodeRuneInString(s[n:])
+			if a != b || a == utf8.RuneError {
+				break
+			}
+			n += size
+		}
+		prefix = prefix[:n]
+	}
+	return prefix[:Profile{}.identPrefixLen(prefix)]
+}
+
+// Returns the byte offset just past the identifier beginning at s[i], as
+// defined by IsIdent, and whether that identifier is complete: neither
+// preceded nor followed by a rune which could continue it, so that it isn't
+// part of a longer identifier. This lets a parser enforce maximal munch at
+// both ends of a token. If no identifier begins at s[i], i and false are
+// returned.
+//
+// A join control following the identifier makes it incomplete, since a
+// longer identifier could follow it.
+func IsCompleteIdent(s string, i int) (end int, ok bool) {
+	if i < 0 || i > len(s) {
+		return i, false
+	}
+
+	end = i + Profile{}.identPrefixLen(s[i:])
+	if end == i {
+		return i, false
+	}
+
+	if i > 0 {
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		if UnicodeIdentifierClass(prev)&Continue != 0 {
+			return end, false
+		}
+	}
+	if end < len(s) {
+		last, _ := utf8.DecodeLastRuneInString(s[i:end])
+		next, _ := utf8.DecodeRuneInString(s[end:])
+		if (Profile{}).CanContinueAfter(last, next) {
+			return end, false
+		}
+	}
+	return end, true
+}
+
+// Returns whether c is in the unreserved set of RFC 3986, which never needs
+// percent-encoding in a URL.
+func isURLUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// Checks if s is a unicode identifier, as defined by IsIdent, made up only of
+// characters from the unreserved set of RFC 3986, so that it can be used in a
+// URL without percent-encoding. Unreserved characters which can't continue
+// an identifier, like '.', are rejected, and so is anything outside ASCII.
+func IsURLSafeIdent(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isURLUnreserved(s[i]) {
+			return false
+		}
+	}
+	return IsASCIIIdentifier(s)
+}
+
+// The byte order mark, U+FEFF ZERO WIDTH NO-BREAK SPACE, encoded in UTF-8.
+const utf8BOM = "\ufeff"
+
+// Returns s without a leading byte order mark, U+FEFF, such as one at the
+// start of a source file. Only the first rune is considered, since U+FEFF
+// elsewhere is a ZERO WIDTH NO-BREAK SPACE, which is classified like any
+// other codepoint.
+func TrimBOM(s string) string {
+	return strings.TrimPrefix(s, utf8BOM)
+}
+
+// Returns s with every ZWNJ and ZWJ removed, and whether s is a unicode
+// identifier, as defined by IsIdent. The join controls only affect how an
+// identifier is rendered, so systems which store it for display with them
+// can compare it without them. If s isn't an identifier, "" and false are
+// returned.
+func StripJoinControls(s string) (stripped string, valid bool) {
+	if !IsIdentString(s) {
+		return "", false
+	}
+	return strings.Map(func(c rune) rune {
+		if IsJoinControl(c) {
+			return -1
+		}
+		return c
+	}, s), true
+}
+
+// Returns whether s can begin a unicode identifier, as defined by IsIdent:
+// whether it's empty, an identifier, or an identifier followed by join
+// controls, which another rune could complete.
+func isIdentPrefix(s string) bool {
+	n := Profile{}.identPrefixLen(s)
+	if n == 0 {
+		return s == ""
+	}
+	for _, c := range s[n:] {
+		if !IsJoinControl(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the half-open range sorted[lo:hi] of the strings in sorted which
+// begin with prefix, found by binary search without allocating. sorted must
+// be in increasing order, as sort.Strings leaves it. If prefix can't begin
+// an identifier, the range is empty.
+func PrefixRange(sorted []string, prefix string) (lo, hi int) {
+	if !isIdentPrefix(prefix) {
+		return 0, 0
+	}
+
+	lo = sort.SearchStrings(sorted, prefix)
+	hi = lo + sort.Search(len(sorted)-lo, func(i int) bool {
+		return !strings.HasPrefix(sorted[lo+i], prefix)
+	})
+	return lo, hi
+}
+
+// Returns the first rune in s which can continue an identifier but not begin
+// one, such as a digit or a combining mark, along with its byte offset, or
+// -1 and -1 if there's none. This is meant for diagnostics like "identifiers
+// can't begin with the digit '5'".
+func FirstContinueOnlyRune(s string) (rune, int) {
+	for i, c := range s {
+		if IsContinueOnly(c) {
+			return c, i
+		}
+	}
+	return -1, -1
+}
+
+// Returns the byte offset of every rune in s which breaks the rules of
+// IsIdent, rather than just the first, so that an editor can underline each
+// one: a first rune which can't begin an identifier, a later rune which
+// can't continue one, or a join control at the end. Each byte of invalid
+// UTF-8 is reported too. A non-empty s is an identifier exactly when none
+// are returned.
+func InvalidIdentPositions(s string) []int {
+	var positions []int
+	last := -1
+	if c, size := utf8.DecodeLastRuneInString(s); size > 0 && IsJoinControl(c) {
+		last = len(s) - size
+	}
+	for i, c := range s {
+		want := Continue
+		if i == 0 {
+			want = Start
+		}
+		if UnicodeIdentifierClass(c)&want == 0 || i == last {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// The kinds of rune SplitIdentWords tells apart.
+const (
+	wordLower = iota
+	wordUpper
+	wordDigit
+)
+
+// Splits the identifier s into its words, at underscores, where a lowercase
+// letter is followed by an uppercase one, before the last of a run of
+// uppercase letters followed by a lowercase one, and between digits and
+// letters. For example, "parseHTTPRequest_v2" splits into "parse", "HTTP",
+// "Request", "v" and "2". Case is taken from the general category, so this
+// works for any cased script, while letters without case never begin a
+// word. Combining marks stay with the rune they follow. If s isn't a unicode
+// identifier, as defined by IsIdent, nil is returned.
+func SplitIdentWords(s string) []string {
+	if !IsIdentString(s) {
+		return nil
+	}
+
+	var words []string
+	start, prevOff, prevKind := -1, 0, wordLower
+	for off, c := range s {
+		if c == '_' {
+			if start >= 0 {
+				words = append(words, s[start:off])
+			}
+			start = -1
+			continue
+		}
+
+		kind := prevKind
+		switch {
+		case unicode.IsDigit(c):
+			kind = wordDigit
+		case unicode.IsUpper(c) || unicode.IsTitle(c):
+			kind = wordUpper
+		case unicode.IsLetter(c):
+			kind = wordLower
+		}
+
+		switch {
+		case start < 0:
+			start = off
+		case (kind == wordDigit) != (prevKind == wordDigit),
+			prevKind == wordLower && kind == wordUpper:
+			words = append(words, s[start:off])
+			start = off
+		case prevKind == wordUpper && kind == wordLower && prevOff > start:
+			words = append(words, s[start:prevOff])
+			start = prevOff
+		}
+		if !unicode.Is(unicode.M, c) {
+			prevOff = off
+		}
+		prevKind = kind
+	}
+	if start >= 0 {
+		words = append(words, s[start:])
+	}
+	return words
+}
@@ -0,0 +1,346 @@
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestIsASCIIIdentifier(t *testing.T) {
+	cases := []struct {
+		s     string
+		ascii bool
+		ident bool
+	}{
+		{"foo", true, true},
+		{"foo_42", true, true},
+		{"café", false, false},
+		{"", true, false},
+		{"42", true, false},
+		{"foo bar", true, false},
+	}
+	for _, c := range cases {
+		if got := IsASCIIOnly(c.s); got != c.ascii {
+			t.Errorf("IsASCIIOnly(%q) = %v, expected %v", c.s, got, c.ascii)
+		}
+		if got := IsASCIIIdentifier(c.s); got != c.ident {
+			t.Errorf("IsASCIIIdentifier(%q) = %v, expected %v", c.s, got, c.ident)
+		}
+	}
+}
+
+func TestClassCounts(t *testing.T) {
+	other, start, continueOnly, both := ClassCounts("héllo_42 + \xff\xfeñ\u0301")
+	// h é l l o ñ are both, _ 4 2 U+0301 are continue only, and the
+	// two spaces, '+' and two invalid bytes are other.
+	if other != 5 || start != 0 || continueOnly != 4 || both != 6 {
+		t.Fatalf("ClassCounts returned (%d, %d, %d, %d), expected (5, 0, 4, 6)", other, start, continueOnly, both)
+	}
+}
+
+func TestIsQualifiedName(t *testing.T) {
+	cases := []struct {
+		s        string
+		sep      rune
+		expected bool
+	}{
+		{"a.b.c", '.', true},
+		{"foo", '.', true},
+		{"fmt.Println", '.', true},
+		{".a", '.', false},
+		{"a.", '.', false},
+		{"a..b", '.', false},
+		{"", '.', false},
+		{"a.1b", '.', false},
+		{"std/io/fs", '/', true},
+		{"std/io/", '/', false},
+		{"a.b", '/', false},
+		{"α∷β", '∷', true},
+	}
+	for _, c := range cases {
+		if got := IsQualifiedName(c.s, c.sep); got != c.expected {
+			t.Errorf("IsQualifiedName(%q, %q) = %v, expected %v", c.s, c.sep, got, c.expected)
+		}
+	}
+
+	if !ECMAScript.IsQualifiedName("$.foo.$bar", '.') {
+		t.Errorf("ECMAScript rejected \"$.foo.$bar\"")
+	}
+}
+
+// Decodes a \uXXXX escape sequence.
+func decodeUEscape(s string) (rune, int, bool) {
+	if len(s) < 6 || s[0] != '\\' || s[1] != 'u' {
+		return 0, 0, false
+	}
+	v, err := strconv.ParseUint(s[2:6], 16, 16)
+	if err != nil {
+		return 0, 0, false
+	}
+	return rune(v), 6, true
+}
+
+func TestIsEscapedIdent(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{`caf\u00e9`, true},
+		{`\u00e9t\u00e9`, true},
+		{`caf\u002d`, false},
+		{`\u0031abc`, false},
+		{`a\u200d`, false},
+		{`a\u200db`, true},
+		{`a\u00`, false},
+		{`abc`, true},
+		{``, false},
+	}
+	for _, c := range cases {
+		if got := IsEscapedIdent(c.s, decodeUEscape); got != c.expected {
+			t.Errorf("IsEscapedIdent(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestCommonIdentPrefix(t *testing.T) {
+	cases := []struct {
+		idents   []string
+		expected string
+	}{
+		{[]string{"foobar", "foobaz", "foo"}, "foo"},
+		{[]string{"foobar"}, "foobar"},
+		// é and è share their first byte, 0xC3.
+		{[]string{"café", "cafè"}, "caf"},
+		{[]string{"é1", "è1"}, ""},
+		{[]string{"foo-bar", "foo-baz"}, "foo"},
+		{[]string{"a_1", "a_2"}, "a_"},
+		{[]string{"1abc", "1abd"}, ""},
+		{[]string{"abc", "xyz"}, ""},
+		{nil, ""},
+	}
+	for _, c := range cases {
+		if got := CommonIdentPrefix(c.idents); got != c.expected {
+			t.Errorf("CommonIdentPrefix(%q) = %q, expected %q", c.idents, got, c.expected)
+		}
+	}
+}
+
+func TestIsCompleteIdent(t *testing.T) {
+	cases := []struct {
+		s   string
+		i   int
+		end int
+		ok  bool
+	}{
+		{"foo;", 0, 3, true},
+		{"foo", 0, 3, true},
+		{"x = foo;", 4, 7, true},
+		{"foobar", 3, 6, false},
+		{"foobar", 0, 6, true},
+		{"a\u200c;", 0, 1, false},
+		{"a\u200cb", 4, 5, false},
+		{"été+1", 0, 5, true},
+		{";foo", 0, 0, false},
+		{"foo", 3, 3, false},
+		{"foo", -1, -1, false},
+	}
+	for _, c := range cases {
+		end, ok := IsCompleteIdent(c.s, c.i)
+		if end != c.end || ok != c.ok {
+			t.Errorf("IsCompleteIdent(%q, %d) = (%d, %v), expected (%d, %v)", c.s, c.i, end, ok, c.end, c.ok)
+		}
+	}
+}
+
+func TestIsURLSafeIdent(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected bool
+	}{
+		{"foo_bar", true},
+		{"Foo42", true},
+		{"foo.bar", false},
+		{"foo-bar", false},
+		{"foo~", false},
+		{"café", false},
+		{"foo bar", false},
+		{"_foo", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := IsURLSafeIdent(c.s); got != c.expected {
+			t.Errorf("IsURLSafeIdent(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestTrimBOM(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected string
+	}{
+		{"\ufefffoo", "foo"},
+		{"foo", "foo"},
+		{"\ufeff\ufefffoo", "\ufefffoo"},
+		{"foo\ufeff", "foo\ufeff"},
+		{"\ufeff", ""},
+		{"", ""},
+	}
+	for _, c := range cases {
+		if got := TrimBOM(c.s); got != c.expected {
+			t.Errorf("TrimBOM(%q) = %q, expected %q", c.s, got, c.expected)
+		}
+	}
+
+	if IsIdentString("\ufefffoo") {
+		t.Errorf("IsIdentString accepted a leading BOM")
+	}
+	if !IsIdentString(TrimBOM("\ufefffoo")) {
+		t.Errorf("IsIdentString rejected an identifier after its BOM was trimmed")
+	}
+	if IsIdentString(TrimBOM("foo\ufeffbar")) {
+		t.Errorf("IsIdentString accepted U+FEFF inside an identifier")
+	}
+}
+
+func TestStripJoinControls(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected string
+		valid    bool
+	}{
+		// The Persian "mikhaham", whose ZWNJ keeps the first two letters
+		// from joining the rest.
+		{"\u0645\u06cc\u200c\u062e\u0648\u0627\u0647\u0645", "\u0645\u06cc\u062e\u0648\u0627\u0647\u0645", true},
+		{"\u0915\u094d\u200d\u0937", "\u0915\u094d\u0937", true},
+		{"a\u200c\u200db", "ab", true},
+		{"abc", "abc", true},
+		{"a\u200c", "", false},
+		{"1abc", "", false},
+		{"", "", false},
+	}
+	for _, c := range cases {
+		got, valid := StripJoinControls(c.s)
+		if got != c.expected || valid != c.valid {
+			t.Errorf("StripJoinControls(%q) = (%q, %v), expected (%q, %v)", c.s, got, valid, c.expected, c.valid)
+		}
+	}
+}
+
+func TestPrefixRange(t *testing.T) {
+	sorted := []string{"apple", "foo", "foo_bar", "foobar", "foobaz", "fop", "zeta"}
+	cases := []struct {
+		prefix string
+		lo, hi int
+	}{
+		{"foo", 1, 5},
+		{"foob", 3, 5},
+		{"fo", 1, 6},
+		{"apple", 0, 1},
+		{"bar", 1, 1},
+		{"zz", 7, 7},
+		{"", 0, 7},
+		{"f\u200c", 6, 6},
+		{"1", 0, 0},
+		{"foo.", 0, 0},
+	}
+	for _, c := range cases {
+		lo, hi := PrefixRange(sorted, c.prefix)
+		if lo != c.lo || hi != c.hi {
+			t.Errorf("PrefixRange(%q) = (%d, %d), expected (%d, %d)", c.prefix, lo, hi, c.lo, c.hi)
+		}
+	}
+
+}
+
+// PrefixRange's doc promises it doesn't allocate, whether or not the prefix
+// can begin an identifier.
+func TestPrefixRangeDoesNotAllocate(t *testing.T) {
+	sorted := []string{"apple", "caf\u00e9", "foo", "foo_bar", "foobar", "zeta"}
+	prefixes := []string{"foo", "caf\u00e9", "", "f\u200c", "1", "foo.", "zz"}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, prefix := range prefixes {
+			PrefixRange(sorted, prefix)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("PrefixRange allocated %v times per run, expected 0", allocs)
+	}
+}
+
+func TestFirstContinueOnlyRune(t *testing.T) {
+	cases := []struct {
+		s      string
+		r      rune
+		offset int
+	}{
+		{"5abc", '5', 0},
+		{"abc", -1, -1},
+		{"ab_c", '_', 2},
+		{"été1", '1', 5},
+		{"\u0301a", 0x0301, 0},
+		{"a b-c", -1, -1},
+		{"", -1, -1},
+	}
+	for _, c := range cases {
+		r, offset := FirstContinueOnlyRune(c.s)
+		if r != c.r || offset != c.offset {
+			t.Errorf("FirstContinueOnlyRune(%q) = (%q, %d), expected (%q, %d)", c.s, r, offset, c.r, c.offset)
+		}
+	}
+}
+
+func TestSplitIdentWords(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected []string
+	}{
+		{"fooBarBaz", []string{"foo", "Bar", "Baz"}},
+		{"HTTP_server", []string{"HTTP", "server"}},
+		{"parseHTTPRequest_v2", []string{"parse", "HTTP", "Request", "v", "2"}},
+		{"snake__case_", []string{"snake", "case"}},
+		{"utf8Decode", []string{"utf", "8", "Decode"}},
+		{"ID", []string{"ID"}},
+		{"größeÄndern", []string{"größe", "Ändern"}},
+		{"величинаСкорости", []string{"величина", "Скорости"}},
+		{"caféNoir", []string{"café", "Noir"}},
+		{"ÉTAT", []string{"ÉTAT"}},
+		{"ÉTat", []string{"É", "Tat"}},
+		{"名前Foo", []string{"名前", "Foo"}},
+		{"cafe\u0301Noir", []string{"cafe\u0301", "Noir"}},
+		{"E\u0301Tat", []string{"E\u0301", "Tat"}},
+		{"1abc", nil},
+		{"", nil},
+	}
+	for _, c := range cases {
+		if got := SplitIdentWords(c.s); !slices.Equal(got, c.expected) {
+			t.Errorf("SplitIdentWords(%q) = %q, expected %q", c.s, got, c.expected)
+		}
+	}
+}
+
+func TestInvalidIdentPositions(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected []int
+	}{
+		{"1a b!", []int{0, 2, 4}},
+		{"abc", nil},
+		{"caf\u00e9", nil},
+		{"a\u200cb", nil},
+		{"a\u200c", []int{1}},
+		{"\u200c", []int{0}},
+		{"\u0301a-", []int{0, 3}},
+		{"a\xffb\xfe", []int{1, 3}},
+		{"", nil},
+	}
+	for _, c := range cases {
+		got := InvalidIdentPositions(c.s)
+		if !slices.Equal(got, c.expected) {
+			t.Errorf("InvalidIdentPositions(%q) = %v, expected %v", c.s, got, c.expected)
+		}
+		if c.s != "" && (len(got) == 0) != IsIdentString(c.s) {
+			t.Errorf("InvalidIdentPositions(%q) disagrees with IsIdentString", c.s)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package unicode_id_trie_rle
+
+import "slices"
+
+// The layout of the tables returned by LeafRuns. A codepoint cp in
+// U+0080..TableBlockCount<<TableShift-1 lies in block cp>>TableShift, at
+// offset cp&(1<<TableShift-1) within it. The block's leaf is found at
+// level2[level1[block>>TableLowerBits]<<TableLowerBits+block&(1<<TableLowerBits-1)].
+const (
+	TableShift      = shift
+	TableLowerBits  = lowerBits
+	TableBlockCount = blockCount
+)
+
+// Returns copies of the generated tables, for tools which reimplement the
+// lookup done by UnicodeIdentifierClass elsewhere. See TableShift for how a
+// codepoint's leaf is found.
+//
+// Leaf i spans the runs offsets[i]..offsets[i+1]. Each run begins at the
+// block offset given by starts and holds the class given by values, until
+// the next run begins; the final run of every leaf is a sentinel beginning
+// at 1<<TableShift. Codepoints below U+0080 aren't covered by the tables.
+func LeafRuns() (offsets []uint16, starts []uint16, values []IdentifierClass, level1, level2 []uint16) {
+	return slices.Clone(leafOffsets[:]),
+		slices.Clone(leafRunStarts[:]),
+		slices.Clone(leafRunValues[:]),
+		slices.Clone(level1Table[:]),
+		slices.Clone(level2Tables[:])
+}
+
+// Returns the distinct identifier classes held by at least one codepoint, in
+// ascending order, so that tests can enumerate the classes without
+// hardcoding them.
+func PresentClasses() []IdentifierClass {
+	var present [Start | Continue + 1]bool
+	for _, class := range asciiTable {
+		present[class] = true
+	}
+	for _, class := range leafRunValues {
+		present[class&(Start|Continue)] = true
+	}
+
+	var classes []IdentifierClass
+	for class, ok := range present {
+		if ok {
+			classes = append(classes, IdentifierClass(class))
+		}
+	}
+	return classes
+}
@@ -0,0 +1,61 @@
+package unicode_id_trie_rle
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLeafRunsMatchesUnicodeIdentifierClass(t *testing.T) {
+	offsets, starts, values, level1, level2 := LeafRuns()
+
+	lookup := func(cp rune) IdentifierClass {
+		block := int(cp) >> TableShift
+		top := block >> TableLowerBits
+		bottom := block & (1<<TableLowerBits - 1)
+		leaf := level2[int(level1[top])<<TableLowerBits+bottom]
+		offset := uint16(int(cp) & (1<<TableShift - 1))
+
+		class := Other
+		for i := offsets[leaf]; i < offsets[leaf+1] && starts[i] <= offset; i++ {
+			class = values[i]
+		}
+		return class
+	}
+
+	for cp := rune(startCodepoint); cp < TableBlockCount<<TableShift; cp++ {
+		if got, expected := lookup(cp), UnicodeIdentifierClass(cp); got != expected {
+			t.Fatalf("U+%04X: reference lookup returned %d, expected %d", cp, got, expected)
+		}
+	}
+
+	offsets[0] = 0xffff
+	if leafOffsets[0] == 0xffff {
+		t.Errorf("LeafRuns returned the package's own leafOffsets")
+	}
+}
+
+func TestPresentClasses(t *testing.T) {
+	classes := PresentClasses()
+	if !slices.IsSorted(classes) {
+		t.Errorf("PresentClasses returned %v, which isn't sorted", classes)
+	}
+	if !slices.Contains(classes, Other) || !slices.Contains(classes, Start|Continue) {
+		t.Errorf("PresentClasses returned %v, expected it to contain Other and Start|Continue", classes)
+	}
+
+	seen := make(map[IdentifierClass]bool)
+	for cp := rune(0); cp <= 0x10ffff; cp++ {
+		seen[UnicodeIdentifierClass(cp)] = true
+	}
+	for _, class := range classes {
+		if class&^(Start|Continue) != 0 {
+			t.Errorf("PresentClasses returned invalid class %#x", class)
+		}
+		if !seen[class] {
+			t.Errorf("PresentClasses returned %d, which no codepoint has", class)
+		}
+	}
+	if len(seen) != len(classes) {
+		t.Errorf("PresentClasses returned %v, but %d classes are in use", classes, len(seen))
+	}
+}
@@ -0,0 +1,59 @@
+package unicode_id_trie_rle
+
+import "sort"
+
+// The storage behind the lookup. The package's own tables, compiled in or
+// decoded from the embedded blob, and the tables NewClassifierFromBlob
+// loads are both read through one of these, so that trieClass, classRun and
+// a Classifier share a single copy of the lookup.
+type tableSource interface {
+	// Returns the first and last codepoints the tables cover.
+	codepointRange() (lo, hi rune)
+
+	// Returns the starts and classes of the runs of the leaf for the block
+	// holding cp, along with cp's offset within the block.
+	runsOf(cp rune) (runs []uint16, values []IdentifierClass, offset uint16)
+}
+
+// The package's own tables, as generated beside ident.go.
+type compiledTables struct{}
+
+func (compiledTables) codepointRange() (lo, hi rune) {
+	return minCodepoint, maxCodepoint
+}
+
+func (compiledTables) runsOf(cp rune) ([]uint16, []IdentifierClass, uint16) {
+	l := loadLeaf(compiledTables{}.blockLeaf(uint32(cp) >> shift))
+	start, end := int(l.offset), int(l.offset)+int(l.len)
+	return leafRunStarts[start:end], leafRunValues[start:end], uint16(uint32(cp) & blockMask)
+}
+
+func (compiledTables) blockLeaf(block uint32) uint16 {
+	// The generator's -mph flag chooses how a block's leaf is found.
+	if useMPH {
+		return mphBlockLeaf(block)
+	}
+	return trieBlockLeaf(block)
+}
+
+// Looks up the class of cp, which must be in startCodepoint up to the end of
+// the range src covers, in src.
+func sourceClass(src tableSource, cp rune) IdentifierClass {
+	return runsValue(src.runsOf(cp))
+}
+
+// Returns the class of cp, which must be in startCodepoint up to the end of
+// the range src covers, along with the last codepoint of the run of
+// codepoints beginning at cp which share it. The run may be cut short, such
+// as at the end of a leaf's block.
+func sourceClassRun(src tableSource, cp rune) (IdentifierClass, rune) {
+	runs, values, offset := src.runsOf(cp)
+	i := sort.Search(len(runs), func(i int) bool {
+		return runs[i] > offset
+	})
+	// As in runsValue, a leaf's first run also covers any offsets before
+	// it, and the sentinel at the block's size ends the last run.
+	i = max(i, 1)
+	_, hi := src.codepointRange()
+	return values[i-1] & (Start | Continue), min(cp+rune(runs[i]-offset)-1, hi)
+}
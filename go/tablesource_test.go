@@ -0,0 +1,36 @@
+package unicode_id_trie_rle
+
+import (
+	"os"
+	"testing"
+)
+
+// Checks that every run sourceClassRun reports holds codepoints of the class
+// it reports, for the compiled-in tables and for the same tables decoded from
+// the blob.
+func TestSourceClassRun(t *testing.T) {
+	blob, err := os.ReadFile("ident_blob_generated.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeTrieTables(string(blob))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, src := range map[string]tableSource{"compiled": compiledTables{}, "decoded": decoded} {
+		_, hi := src.codepointRange()
+		for cp := rune(startCodepoint); cp <= hi; {
+			class, last := sourceClassRun(src, cp)
+			if last < cp || last > hi {
+				t.Fatalf("%s: run at U+%04X ends at U+%04X", name, cp, last)
+			}
+			for c := cp; c <= last; c++ {
+				if got := sourceClass(src, c) & (Start | Continue); got != class {
+					t.Fatalf("%s: U+%04X has class %d, but the run at U+%04X has %d", name, c, got, cp, class)
+				}
+			}
+			cp = last + 1
+		}
+	}
+}
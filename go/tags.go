@@ -0,0 +1,21 @@
+package unicode_id_trie_rle
+
+// The Tags block, U+E0000..U+E007F, whose characters are invisible and
+// mirror ASCII, so that they can hide arbitrary text.
+const (
+	firstTagCharacter = 0xe0000
+	lastTagCharacter  = 0xe007f
+)
+
+// Returns whether s contains a character from the Tags block,
+// U+E0000..U+E007F. None of them can be part of an identifier, so IsIdent
+// already rejects them, but they can smuggle hidden text into comments and
+// string literals, which auditors may want to flag.
+func ContainsTagCharacters(s []rune) bool {
+	for _, c := range s {
+		if c >= firstTagCharacter && c <= lastTagCharacter {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package unicode_id_trie_rle
+
+import "testing"
+
+func TestContainsTagCharacters(t *testing.T) {
+	// "hi" followed by TAG LATIN SMALL LETTER X and CANCEL TAG.
+	hidden := []rune{'h', 'i', 0xe0078, 0xe007f}
+	if !ContainsTagCharacters(hidden) {
+		t.Errorf("ContainsTagCharacters missed a tag character")
+	}
+	if ContainsTagCharacters([]rune("hi there")) {
+		t.Errorf("ContainsTagCharacters reported a string without tag characters")
+	}
+	// U+E0100 VARIATION SELECTOR-17 follows the Tags block.
+	if ContainsTagCharacters([]rune{'a', 0xe0100}) {
+		t.Errorf("ContainsTagCharacters reported a variation selector")
+	}
+
+	for cp := rune(firstTagCharacter); cp <= lastTagCharacter; cp++ {
+		if IsIdent([]rune{'a', cp}) {
+			t.Errorf("IsIdent accepted tag character U+%04X", cp)
+		}
+	}
+}
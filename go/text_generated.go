@@ -0,0 +1,3931 @@
+// Code generated by "generate -i ../../DerivedCoreProperties.txt -i ../../PropList.txt -i ../../Scripts.txt -i ../../DerivedGeneralCategory.txt -i ../../DerivedJoiningType.txt -i ../../DerivedCombiningClass.txt -o ../ident_generated.go -blob ../ident_blob_generated.go -full ../ident_full_generated.go -text ../text_generated.go"; DO NOT EDIT.
+
+//go:build identnorm
+
+package unicode_id_trie_rle
+
+// The values of bidiRunValues.
+const (
+	bidiOther = 0
+	bidiLeftToRight = 1
+	bidiRightToLeft = 2
+)
+
+var canonicalDecompositionKeys = [...]rune{
+	0x000c0, 0x000c1, 0x000c2, 0x000c3, 0x000c4, 0x000c5, 0x000c7, 0x000c8,
+	0x000c9, 0x000ca, 0x000cb, 0x000cc, 0x000cd, 0x000ce, 0x000cf, 0x000d1,
+	0x000d2, 0x000d3, 0x000d4, 0x000d5, 0x000d6, 0x000d9, 0x000da, 0x000db,
+	0x000dc, 0x000dd, 0x000e0, 0x000e1, 0x000e2, 0x000e3, 0x000e4, 0x000e5,
+	0x000e7, 0x000e8, 0x000e9, 0x000ea, 0x000eb, 0x000ec, 0x000ed, 0x000ee,
+	0x000ef, 0x000f1, 0x000f2, 0x000f3, 0x000f4, 0x000f5, 0x000f6, 0x000f9,
+	0x000fa, 0x000fb, 0x000fc, 0x000fd, 0x000ff, 0x00100, 0x00101, 0x00102,
+	0x00103, 0x00104, 0x00105, 0x00106, 0x00107, 0x00108, 0x00109, 0x0010a,
+	0x0010b, 0x0010c, 0x0010d, 0x0010e, 0x0010f, 0x00112, 0x00113, 0x00114,
+	0x00115, 0x00116, 0x00117, 0x00118, 0x00119, 0x0011a, 0x0011b, 0x0011c,
+	0x0011d, 0x0011e, 0x0011f, 0x00120, 0x00121, 0x00122, 0x00123, 0x00124,
+	0x00125, 0x00128, 0x00129, 0x0012a, 0x0012b, 0x0012c, 0x0012d, 0x0012e,
+	0x0012f, 0x00130, 0x00134, 0x00135, 0x00136, 0x00137, 0x00139, 0x0013a,
+	0x0013b, 0x0013c, 0x0013d, 0x0013e, 0x00143, 0x00144, 0x00145, 0x00146,
+	0x00147, 0x00148, 0x0014c, 0x0014d, 0x0014e, 0x0014f, 0x00150, 0x00151,
+	0x00154, 0x00155, 0x00156, 0x00157, 0x00158, 0x00159, 0x0015a, 0x0015b,
+	0x0015c, 0x0015d, 0x0015e, 0x0015f, 0x00160, 0x00161, 0x00162, 0x00163,
+	0x00164, 0x00165, 0x00168, 0x00169, 0x0016a, 0x0016b, 0x0016c, 0x0016d,
+	0x0016e, 0x0016f, 0x00170, 0x00171, 0x00172, 0x00173, 0x00174, 0x00175,
+	0x00176, 0x00177, 0x00178, 0x00179, 0x0017a, 0x0017b, 0x0017c, 0x0017d,
+	0x0017e, 0x001a0, 0x001a1, 0x001af, 0x001b0, 0x001cd, 0x001ce, 0x001cf,
+	0x001d0, 0x001d1, 0x001d2, 0x001d3, 0x001d4, 0x001d5, 0x001d6, 0x001d7,
+	0x001d8, 0x001d9, 0x001da, 0x001db, 0x001dc, 0x001de, 0x001df, 0x001e0,
+	0x001e1, 0x001e2, 0x001e3, 0x001e6, 0x001e7, 0x001e8, 0x001e9, 0x001ea,
+	0x001eb, 0x001ec, 0x001ed, 0x001ee, 0x001ef, 0x001f0, 0x001f4, 0x001f5,
+	0x001f8, 0x001f9, 0x001fa, 0x001fb, 0x001fc, 0x001fd, 0x001fe, 0x001ff,
+	0x00200, 0x00201, 0x00202, 0x00203, 0x00204, 0x00205, 0x00206, 0x00207,
+	0x00208, 0x00209, 0x0020a, 0x0020b, 0x0020c, 0x0020d, 0x0020e, 0x0020f,
+	0x00210, 0x00211, 0x00212, 0x00213, 0x00214, 0x00215, 0x00216, 0x00217,
+	0x00218, 0x00219, 0x0021a, 0x0021b, 0x0021e, 0x0021f, 0x00226, 0x00227,
+	0x00228, 0x00229, 0x0022a, 0x0022b, 0x0022c, 0x0022d, 0x0022e, 0x0022f,
+	0x00230, 0x00231, 0x00232, 0x00233, 0x00340, 0x00341, 0x00343, 0x00344,
+	0x00374, 0x0037e, 0x00385, 0x00386, 0x00387, 0x00388, 0x00389, 0x0038a,
+	0x0038c, 0x0038e, 0x0038f, 0x00390, 0x003aa, 0x003ab, 0x003ac, 0x003ad,
+	0x003ae, 0x003af, 0x003b0, 0x003ca, 0x003cb, 0x003cc, 0x003cd, 0x003ce,
+	0x003d3, 0x003d4, 0x00400, 0x00401, 0x00403, 0x00407, 0x0040c, 0x0040d,
+	0x0040e, 0x00419, 0x00439, 0x00450, 0x00451, 0x00453, 0x00457, 0x0045c,
+	0x0045d, 0x0045e, 0x00476, 0x00477, 0x004c1, 0x004c2, 0x004d0, 0x004d1,
+	0x004d2, 0x004d3, 0x004d6, 0x004d7, 0x004da, 0x004db, 0x004dc, 0x004dd,
+	0x004de, 0x004df, 0x004e2, 0x004e3, 0x004e4, 0x004e5, 0x004e6, 0x004e7,
+	0x004ea, 0x004eb, 0x004ec, 0x004ed, 0x004ee, 0x004ef, 0x004f0, 0x004f1,
+	0x004f2, 0x004f3, 0x004f4, 0x004f5, 0x004f8, 0x004f9, 0x00622, 0x00623,
+	0x00624, 0x00625, 0x00626, 0x006c0, 0x006c2, 0x006d3, 0x00929, 0x00931,
+	0x00934, 0x00958, 0x00959, 0x0095a, 0x0095b, 0x0095c, 0x0095d, 0x0095e,
+	0x0095f, 0x009cb, 0x009cc, 0x009dc, 0x009dd, 0x009df, 0x00a33, 0x00a36,
+	0x00a59, 0x00a5a, 0x00a5b, 0x00a5e, 0x00b48, 0x00b4b, 0x00b4c, 0x00b5c,
+	0x00b5d, 0x00b94, 0x00bca, 0x00bcb, 0x00bcc, 0x00c48, 0x00cc0, 0x00cc7,
+	0x00cc8, 0x00cca, 0x00ccb, 0x00d4a, 0x00d4b, 0x00d4c, 0x00dda, 0x00ddc,
+	0x00ddd, 0x00dde, 0x00f43, 0x00f4d, 0x00f52, 0x00f57, 0x00f5c, 0x00f69,
+	0x00f73, 0x00f75, 0x00f76, 0x00f78, 0x00f81, 0x00f93, 0x00f9d, 0x00fa2,
+	0x00fa7, 0x00fac, 0x00fb9, 0x01026, 0x01b06, 0x01b08, 0x01b0a, 0x01b0c,
+	0x01b0e, 0x01b12, 0x01b3b, 0x01b3d, 0x01b40, 0x01b41, 0x01b43, 0x01e00,
+	0x01e01, 0x01e02, 0x01e03, 0x01e04, 0x01e05, 0x01e06, 0x01e07, 0x01e08,
+	0x01e09, 0x01e0a, 0x01e0b, 0x01e0c, 0x01e0d, 0x01e0e, 0x01e0f, 0x01e10,
+	0x01e11, 0x01e12, 0x01e13, 0x01e14, 0x01e15, 0x01e16, 0x01e17, 0x01e18,
+	0x01e19, 0x01e1a, 0x01e1b, 0x01e1c, 0x01e1d, 0x01e1e, 0x01e1f, 0x01e20,
+	0x01e21, 0x01e22, 0x01e23, 0x01e24, 0x01e25, 0x01e26, 0x01e27, 0x01e28,
+	0x01e29, 0x01e2a, 0x01e2b, 0x01e2c, 0x01e2d, 0x01e2e, 0x01e2f, 0x01e30,
+	0x01e31, 0x01e32, 0x01e33, 0x01e34, 0x01e35, 0x01e36, 0x01e37, 0x01e38,
+	0x01e39, 0x01e3a, 0x01e3b, 0x01e3c, 0x01e3d, 0x01e3e, 0x01e3f, 0x01e40,
+	0x01e41, 0x01e42, 0x01e43, 0x01e44, 0x01e45, 0x01e46, 0x01e47, 0x01e48,
+	0x01e49, 0x01e4a, 0x01e4b, 0x01e4c, 0x01e4d, 0x01e4e, 0x01e4f, 0x01e50,
+	0x01e51, 0x01e52, 0x01e53, 0x01e54, 0x01e55, 0x01e56, 0x01e57, 0x01e58,
+	0x01e59, 0x01e5a, 0x01e5b, 0x01e5c, 0x01e5d, 0x01e5e, 0x01e5f, 0x01e60,
+	0x01e61, 0x01e62, 0x01e63, 0x01e64, 0x01e65, 0x01e66, 0x01e67, 0x01e68,
+	0x01e69, 0x01e6a, 0x01e6b, 0x01e6c, 0x01e6d, 0x01e6e, 0x01e6f, 0x01e70,
+	0x01e71, 0x01e72, 0x01e73, 0x01e74, 0x01e75, 0x01e76, 0x01e77, 0x01e78,
+	0x01e79, 0x01e7a, 0x01e7b, 0x01e7c, 0x01e7d, 0x01e7e, 0x01e7f, 0x01e80,
+	0x01e81, 0x01e82, 0x01e83, 0x01e84, 0x01e85, 0x01e86, 0x01e87, 0x01e88,
+	0x01e89, 0x01e8a, 0x01e8b, 0x01e8c, 0x01e8d, 0x01e8e, 0x01e8f, 0x01e90,
+	0x01e91, 0x01e92, 0x01e93, 0x01e94, 0x01e95, 0x01e96, 0x01e97, 0x01e98,
+	0x01e99, 0x01e9b, 0x01ea0, 0x01ea1, 0x01ea2, 0x01ea3, 0x01ea4, 0x01ea5,
+	0x01ea6, 0x01ea7, 0x01ea8, 0x01ea9, 0x01eaa, 0x01eab, 0x01eac, 0x01ead,
+	0x01eae, 0x01eaf, 0x01eb0, 0x01eb1, 0x01eb2, 0x01eb3, 0x01eb4, 0x01eb5,
+	0x01eb6, 0x01eb7, 0x01eb8, 0x01eb9, 0x01eba, 0x01ebb, 0x01ebc, 0x01ebd,
+	0x01ebe, 0x01ebf, 0x01ec0, 0x01ec1, 0x01ec2, 0x01ec3, 0x01ec4, 0x01ec5,
+	0x01ec6, 0x01ec7, 0x01ec8, 0x01ec9, 0x01eca, 0x01ecb, 0x01ecc, 0x01ecd,
+	0x01ece, 0x01ecf, 0x01ed0, 0x01ed1, 0x01ed2, 0x01ed3, 0x01ed4, 0x01ed5,
+	0x01ed6, 0x01ed7, 0x01ed8, 0x01ed9, 0x01eda, 0x01edb, 0x01edc, 0x01edd,
+	0x01ede, 0x01edf, 0x01ee0, 0x01ee1, 0x01ee2, 0x01ee3, 0x01ee4, 0x01ee5,
+	0x01ee6, 0x01ee7, 0x01ee8, 0x01ee9, 0x01eea, 0x01eeb, 0x01eec, 0x01eed,
+	0x01eee, 0x01eef, 0x01ef0, 0x01ef1, 0x01ef2, 0x01ef3, 0x01ef4, 0x01ef5,
+	0x01ef6, 0x01ef7, 0x01ef8, 0x01ef9, 0x01f00, 0x01f01, 0x01f02, 0x01f03,
+	0x01f04, 0x01f05, 0x01f06, 0x01f07, 0x01f08, 0x01f09, 0x01f0a, 0x01f0b,
+	0x01f0c, 0x01f0d, 0x01f0e, 0x01f0f, 0x01f10, 0x01f11, 0x01f12, 0x01f13,
+	0x01f14, 0x01f15, 0x01f18, 0x01f19, 0x01f1a, 0x01f1b, 0x01f1c, 0x01f1d,
+	0x01f20, 0x01f21, 0x01f22, 0x01f23, 0x01f24, 0x01f25, 0x01f26, 0x01f27,
+	0x01f28, 0x01f29, 0x01f2a, 0x01f2b, 0x01f2c, 0x01f2d, 0x01f2e, 0x01f2f,
+	0x01f30, 0x01f31, 0x01f32, 0x01f33, 0x01f34, 0x01f35, 0x01f36, 0x01f37,
+	0x01f38, 0x01f39, 0x01f3a, 0x01f3b, 0x01f3c, 0x01f3d, 0x01f3e, 0x01f3f,
+	0x01f40, 0x01f41, 0x01f42, 0x01f43, 0x01f44, 0x01f45, 0x01f48, 0x01f49,
+	0x01f4a, 0x01f4b, 0x01f4c, 0x01f4d, 0x01f50, 0x01f51, 0x01f52, 0x01f53,
+	0x01f54, 0x01f55, 0x01f56, 0x01f57, 0x01f59, 0x01f5b, 0x01f5d, 0x01f5f,
+	0x01f60, 0x01f61, 0x01f62, 0x01f63, 0x01f64, 0x01f65, 0x01f66, 0x01f67,
+	0x01f68, 0x01f69, 0x01f6a, 0x01f6b, 0x01f6c, 0x01f6d, 0x01f6e, 0x01f6f,
+	0x01f70, 0x01f71, 0x01f72, 0x01f73, 0x01f74, 0x01f75, 0x01f76, 0x01f77,
+	0x01f78, 0x01f79, 0x01f7a, 0x01f7b, 0x01f7c, 0x01f7d, 0x01f80, 0x01f81,
+	0x01f82, 0x01f83, 0x01f84, 0x01f85, 0x01f86, 0x01f87, 0x01f88, 0x01f89,
+	0x01f8a, 0x01f8b, 0x01f8c, 0x01f8d, 0x01f8e, 0x01f8f, 0x01f90, 0x01f91,
+	0x01f92, 0x01f93, 0x01f94, 0x01f95, 0x01f96, 0x01f97, 0x01f98, 0x01f99,
+	0x01f9a, 0x01f9b, 0x01f9c, 0x01f9d, 0x01f9e, 0x01f9f, 0x01fa0, 0x01fa1,
+	0x01fa2, 0x01fa3, 0x01fa4, 0x01fa5, 0x01fa6, 0x01fa7, 0x01fa8, 0x01fa9,
+	0x01faa, 0x01fab, 0x01fac, 0x01fad, 0x01fae, 0x01faf, 0x01fb0, 0x01fb1,
+	0x01fb2, 0x01fb3, 0x01fb4, 0x01fb6, 0x01fb7, 0x01fb8, 0x01fb9, 0x01fba,
+	0x01fbb, 0x01fbc, 0x01fbe, 0x01fc1, 0x01fc2, 0x01fc3, 0x01fc4, 0x01fc6,
+	0x01fc7, 0x01fc8, 0x01fc9, 0x01fca, 0x01fcb, 0x01fcc, 0x01fcd, 0x01fce,
+	0x01fcf, 0x01fd0, 0x01fd1, 0x01fd2, 0x01fd3, 0x01fd6, 0x01fd7, 0x01fd8,
+	0x01fd9, 0x01fda, 0x01fdb, 0x01fdd, 0x01fde, 0x01fdf, 0x01fe0, 0x01fe1,
+	0x01fe2, 0x01fe3, 0x01fe4, 0x01fe5, 0x01fe6, 0x01fe7, 0x01fe8, 0x01fe9,
+	0x01fea, 0x01feb, 0x01fec, 0x01fed, 0x01fee, 0x01fef, 0x01ff2, 0x01ff3,
+	0x01ff4, 0x01ff6, 0x01ff7, 0x01ff8, 0x01ff9, 0x01ffa, 0x01ffb, 0x01ffc,
+	0x01ffd, 0x02000, 0x02001, 0x02126, 0x0212a, 0x0212b, 0x0219a, 0x0219b,
+	0x021ae, 0x021cd, 0x021ce, 0x021cf, 0x02204, 0x02209, 0x0220c, 0x02224,
+	0x02226, 0x02241, 0x02244, 0x02247, 0x02249, 0x02260, 0x02262, 0x0226d,
+	0x0226e, 0x0226f, 0x02270, 0x02271, 0x02274, 0x02275, 0x02278, 0x02279,
+	0x02280, 0x02281, 0x02284, 0x02285, 0x02288, 0x02289, 0x022ac, 0x022ad,
+	0x022ae, 0x022af, 0x022e0, 0x022e1, 0x022e2, 0x022e3, 0x022ea, 0x022eb,
+	0x022ec, 0x022ed, 0x02329, 0x0232a, 0x02adc, 0x0304c, 0x0304e, 0x03050,
+	0x03052, 0x03054, 0x03056, 0x03058, 0x0305a, 0x0305c, 0x0305e, 0x03060,
+	0x03062, 0x03065, 0x03067, 0x03069, 0x03070, 0x03071, 0x03073, 0x03074,
+	0x03076, 0x03077, 0x03079, 0x0307a, 0x0307c, 0x0307d, 0x03094, 0x0309e,
+	0x030ac, 0x030ae, 0x030b0, 0x030b2, 0x030b4, 0x030b6, 0x030b8, 0x030ba,
+	0x030bc, 0x030be, 0x030c0, 0x030c2, 0x030c5, 0x030c7, 0x030c9, 0x030d0,
+	0x030d1, 0x030d3, 0x030d4, 0x030d6, 0x030d7, 0x030d9, 0x030da, 0x030dc,
+	0x030dd, 0x030f4, 0x030f7, 0x030f8, 0x030f9, 0x030fa, 0x030fe, 0x0f900,
+	0x0f901, 0x0f902, 0x0f903, 0x0f904, 0x0f905, 0x0f906, 0x0f907, 0x0f908,
+	0x0f909, 0x0f90a, 0x0f90b, 0x0f90c, 0x0f90d, 0x0f90e, 0x0f90f, 0x0f910,
+	0x0f911, 0x0f912, 0x0f913, 0x0f914, 0x0f915, 0x0f916, 0x0f917, 0x0f918,
+	0x0f919, 0x0f91a, 0x0f91b, 0x0f91c, 0x0f91d, 0x0f91e, 0x0f91f, 0x0f920,
+	0x0f921, 0x0f922, 0x0f923, 0x0f924, 0x0f925, 0x0f926, 0x0f927, 0x0f928,
+	0x0f929, 0x0f92a, 0x0f92b, 0x0f92c, 0x0f92d, 0x0f92e, 0x0f92f, 0x0f930,
+	0x0f931, 0x0f932, 0x0f933, 0x0f934, 0x0f935, 0x0f936, 0x0f937, 0x0f938,
+	0x0f939, 0x0f93a, 0x0f93b, 0x0f93c, 0x0f93d, 0x0f93e, 0x0f93f, 0x0f940,
+	0x0f941, 0x0f942, 0x0f943, 0x0f944, 0x0f945, 0x0f946, 0x0f947, 0x0f948,
+	0x0f949, 0x0f94a, 0x0f94b, 0x0f94c, 0x0f94d, 0x0f94e, 0x0f94f, 0x0f950,
+	0x0f951, 0x0f952, 0x0f953, 0x0f954, 0x0f955, 0x0f956, 0x0f957, 0x0f958,
+	0x0f959, 0x0f95a, 0x0f95b, 0x0f95c, 0x0f95d, 0x0f95e, 0x0f95f, 0x0f960,
+	0x0f961, 0x0f962, 0x0f963, 0x0f964, 0x0f965, 0x0f966, 0x0f967, 0x0f968,
+	0x0f969, 0x0f96a, 0x0f96b, 0x0f96c, 0x0f96d, 0x0f96e, 0x0f96f, 0x0f970,
+	0x0f971, 0x0f972, 0x0f973, 0x0f974, 0x0f975, 0x0f976, 0x0f977, 0x0f978,
+	0x0f979, 0x0f97a, 0x0f97b, 0x0f97c, 0x0f97d, 0x0f97e, 0x0f97f, 0x0f980,
+	0x0f981, 0x0f982, 0x0f983, 0x0f984, 0x0f985, 0x0f986, 0x0f987, 0x0f988,
+	0x0f989, 0x0f98a, 0x0f98b, 0x0f98c, 0x0f98d, 0x0f98e, 0x0f98f, 0x0f990,
+	0x0f991, 0x0f992, 0x0f993, 0x0f994, 0x0f995, 0x0f996, 0x0f997, 0x0f998,
+	0x0f999, 0x0f99a, 0x0f99b, 0x0f99c, 0x0f99d, 0x0f99e, 0x0f99f, 0x0f9a0,
+	0x0f9a1, 0x0f9a2, 0x0f9a3, 0x0f9a4, 0x0f9a5, 0x0f9a6, 0x0f9a7, 0x0f9a8,
+	0x0f9a9, 0x0f9aa, 0x0f9ab, 0x0f9ac, 0x0f9ad, 0x0f9ae, 0x0f9af, 0x0f9b0,
+	0x0f9b1, 0x0f9b2, 0x0f9b3, 0x0f9b4, 0x0f9b5, 0x0f9b6, 0x0f9b7, 0x0f9b8,
+	0x0f9b9, 0x0f9ba, 0x0f9bb, 0x0f9bc, 0x0f9bd, 0x0f9be, 0x0f9bf, 0x0f9c0,
+	0x0f9c1, 0x0f9c2, 0x0f9c3, 0x0f9c4, 0x0f9c5, 0x0f9c6, 0x0f9c7, 0x0f9c8,
+	0x0f9c9, 0x0f9ca, 0x0f9cb, 0x0f9cc, 0x0f9cd, 0x0f9ce, 0x0f9cf, 0x0f9d0,
+	0x0f9d1, 0x0f9d2, 0x0f9d3, 0x0f9d4, 0x0f9d5, 0x0f9d6, 0x0f9d7, 0x0f9d8,
+	0x0f9d9, 0x0f9da, 0x0f9db, 0x0f9dc, 0x0f9dd, 0x0f9de, 0x0f9df, 0x0f9e0,
+	0x0f9e1, 0x0f9e2, 0x0f9e3, 0x0f9e4, 0x0f9e5, 0x0f9e6, 0x0f9e7, 0x0f9e8,
+	0x0f9e9, 0x0f9ea, 0x0f9eb, 0x0f9ec, 0x0f9ed, 0x0f9ee, 0x0f9ef, 0x0f9f0,
+	0x0f9f1, 0x0f9f2, 0x0f9f3, 0x0f9f4, 0x0f9f5, 0x0f9f6, 0x0f9f7, 0x0f9f8,
+	0x0f9f9, 0x0f9fa, 0x0f9fb, 0x0f9fc, 0x0f9fd, 0x0f9fe, 0x0f9ff, 0x0fa00,
+	0x0fa01, 0x0fa02, 0x0fa03, 0x0fa04, 0x0fa05, 0x0fa06, 0x0fa07, 0x0fa08,
+	0x0fa09, 0x0fa0a, 0x0fa0b, 0x0fa0c, 0x0fa0d, 0x0fa10, 0x0fa12, 0x0fa15,
+	0x0fa16, 0x0fa17, 0x0fa18, 0x0fa19, 0x0fa1a, 0x0fa1b, 0x0fa1c, 0x0fa1d,
+	0x0fa1e, 0x0fa20, 0x0fa22, 0x0fa25, 0x0fa26, 0x0fa2a, 0x0fa2b, 0x0fa2c,
+	0x0fa2d, 0x0fa2e, 0x0fa2f, 0x0fa30, 0x0fa31, 0x0fa32, 0x0fa33, 0x0fa34,
+	0x0fa35, 0x0fa36, 0x0fa37, 0x0fa38, 0x0fa39, 0x0fa3a, 0x0fa3b, 0x0fa3c,
+	0x0fa3d, 0x0fa3e, 0x0fa3f, 0x0fa40, 0x0fa41, 0x0fa42, 0x0fa43, 0x0fa44,
+	0x0fa45, 0x0fa46, 0x0fa47, 0x0fa48, 0x0fa49, 0x0fa4a, 0x0fa4b, 0x0fa4c,
+	0x0fa4d, 0x0fa4e, 0x0fa4f, 0x0fa50, 0x0fa51, 0x0fa52, 0x0fa53, 0x0fa54,
+	0x0fa55, 0x0fa56, 0x0fa57, 0x0fa58, 0x0fa59, 0x0fa5a, 0x0fa5b, 0x0fa5c,
+	0x0fa5d, 0x0fa5e, 0x0fa5f, 0x0fa60, 0x0fa61, 0x0fa62, 0x0fa63, 0x0fa64,
+	0x0fa65, 0x0fa66, 0x0fa67, 0x0fa68, 0x0fa69, 0x0fa6a, 0x0fa6b, 0x0fa6c,
+	0x0fa6d, 0x0fa70, 0x0fa71, 0x0fa72, 0x0fa73, 0x0fa74, 0x0fa75, 0x0fa76,
+	0x0fa77, 0x0fa78, 0x0fa79, 0x0fa7a, 0x0fa7b, 0x0fa7c, 0x0fa7d, 0x0fa7e,
+	0x0fa7f, 0x0fa80, 0x0fa81, 0x0fa82, 0x0fa83, 0x0fa84, 0x0fa85, 0x0fa86,
+	0x0fa87, 0x0fa88, 0x0fa89, 0x0fa8a, 0x0fa8b, 0x0fa8c, 0x0fa8d, 0x0fa8e,
+	0x0fa8f, 0x0fa90, 0x0fa91, 0x0fa92, 0x0fa93, 0x0fa94, 0x0fa95, 0x0fa96,
+	0x0fa97, 0x0fa98, 0x0fa99, 0x0fa9a, 0x0fa9b, 0x0fa9c, 0x0fa9d, 0x0fa9e,
+	0x0fa9f, 0x0faa0, 0x0faa1, 0x0faa2, 0x0faa3, 0x0faa4, 0x0faa5, 0x0faa6,
+	0x0faa7, 0x0faa8, 0x0faa9, 0x0faaa, 0x0faab, 0x0faac, 0x0faad, 0x0faae,
+	0x0faaf, 0x0fab0, 0x0fab1, 0x0fab2, 0x0fab3, 0x0fab4, 0x0fab5, 0x0fab6,
+	0x0fab7, 0x0fab8, 0x0fab9, 0x0faba, 0x0fabb, 0x0fabc, 0x0fabd, 0x0fabe,
+	0x0fabf, 0x0fac0, 0x0fac1, 0x0fac2, 0x0fac3, 0x0fac4, 0x0fac5, 0x0fac6,
+	0x0fac7, 0x0fac8, 0x0fac9, 0x0faca, 0x0facb, 0x0facc, 0x0facd, 0x0face,
+	0x0facf, 0x0fad0, 0x0fad1, 0x0fad2, 0x0fad3, 0x0fad4, 0x0fad5, 0x0fad6,
+	0x0fad7, 0x0fad8, 0x0fad9, 0x0fb1d, 0x0fb1f, 0x0fb2a, 0x0fb2b, 0x0fb2c,
+	0x0fb2d, 0x0fb2e, 0x0fb2f, 0x0fb30, 0x0fb31, 0x0fb32, 0x0fb33, 0x0fb34,
+	0x0fb35, 0x0fb36, 0x0fb38, 0x0fb39, 0x0fb3a, 0x0fb3b, 0x0fb3c, 0x0fb3e,
+	0x0fb40, 0x0fb41, 0x0fb43, 0x0fb44, 0x0fb46, 0x0fb47, 0x0fb48, 0x0fb49,
+	0x0fb4a, 0x0fb4b, 0x0fb4c, 0x0fb4d, 0x0fb4e, 0x105c9, 0x105e4, 0x1109a,
+	0x1109c, 0x110ab, 0x1112e, 0x1112f, 0x1134b, 0x1134c, 0x11383, 0x11385,
+	0x1138e, 0x11391, 0x113c5, 0x113c7, 0x113c8, 0x114bb, 0x114bc, 0x114be,
+	0x115ba, 0x115bb, 0x11938, 0x16121, 0x16122, 0x16123, 0x16124, 0x16125,
+	0x16126, 0x16127, 0x16128, 0x16d68, 0x16d69, 0x16d6a, 0x1d15e, 0x1d15f,
+	0x1d160, 0x1d161, 0x1d162, 0x1d163, 0x1d164, 0x1d1bb, 0x1d1bc, 0x1d1bd,
+	0x1d1be, 0x1d1bf, 0x1d1c0, 0x2f800, 0x2f801, 0x2f802, 0x2f803, 0x2f804,
+	0x2f805, 0x2f806, 0x2f807, 0x2f808, 0x2f809, 0x2f80a, 0x2f80b, 0x2f80c,
+	0x2f80d, 0x2f80e, 0x2f80f, 0x2f810, 0x2f811, 0x2f812, 0x2f813, 0x2f814,
+	0x2f815, 0x2f816, 0x2f817, 0x2f818, 0x2f819, 0x2f81a, 0x2f81b, 0x2f81c,
+	0x2f81d, 0x2f81e, 0x2f81f, 0x2f820, 0x2f821, 0x2f822, 0x2f823, 0x2f824,
+	0x2f825, 0x2f826, 0x2f827, 0x2f828, 0x2f829, 0x2f82a, 0x2f82b, 0x2f82c,
+	0x2f82d, 0x2f82e, 0x2f82f, 0x2f830, 0x2f831, 0x2f832, 0x2f833, 0x2f834,
+	0x2f835, 0x2f836, 0x2f837, 0x2f838, 0x2f839, 0x2f83a, 0x2f83b, 0x2f83c,
+	0x2f83d, 0x2f83e, 0x2f83f, 0x2f840, 0x2f841, 0x2f842, 0x2f843, 0x2f844,
+	0x2f845, 0x2f846, 0x2f847, 0x2f848, 0x2f849, 0x2f84a, 0x2f84b, 0x2f84c,
+	0x2f84d, 0x2f84e, 0x2f84f, 0x2f850, 0x2f851, 0x2f852, 0x2f853, 0x2f854,
+	0x2f855, 0x2f856, 0x2f857, 0x2f858, 0x2f859, 0x2f85a, 0x2f85b, 0x2f85c,
+	0x2f85d, 0x2f85e, 0x2f85f, 0x2f860, 0x2f861, 0x2f862, 0x2f863, 0x2f864,
+	0x2f865, 0x2f866, 0x2f867, 0x2f868, 0x2f869, 0x2f86a, 0x2f86b, 0x2f86c,
+	0x2f86d, 0x2f86e, 0x2f86f, 0x2f870, 0x2f871, 0x2f872, 0x2f873, 0x2f874,
+	0x2f875, 0x2f876, 0x2f877, 0x2f878, 0x2f879, 0x2f87a, 0x2f87b, 0x2f87c,
+	0x2f87d, 0x2f87e, 0x2f87f, 0x2f880, 0x2f881, 0x2f882, 0x2f883, 0x2f884,
+	0x2f885, 0x2f886, 0x2f887, 0x2f888, 0x2f889, 0x2f88a, 0x2f88b, 0x2f88c,
+	0x2f88d, 0x2f88e, 0x2f88f, 0x2f890, 0x2f891, 0x2f892, 0x2f893, 0x2f894,
+	0x2f895, 0x2f896, 0x2f897, 0x2f898, 0x2f899, 0x2f89a, 0x2f89b, 0x2f89c,
+	0x2f89d, 0x2f89e, 0x2f89f, 0x2f8a0, 0x2f8a1, 0x2f8a2, 0x2f8a3, 0x2f8a4,
+	0x2f8a5, 0x2f8a6, 0x2f8a7, 0x2f8a8, 0x2f8a9, 0x2f8aa, 0x2f8ab, 0x2f8ac,
+	0x2f8ad, 0x2f8ae, 0x2f8af, 0x2f8b0, 0x2f8b1, 0x2f8b2, 0x2f8b3, 0x2f8b4,
+	0x2f8b5, 0x2f8b6, 0x2f8b7, 0x2f8b8, 0x2f8b9, 0x2f8ba, 0x2f8bb, 0x2f8bc,
+	0x2f8bd, 0x2f8be, 0x2f8bf, 0x2f8c0, 0x2f8c1, 0x2f8c2, 0x2f8c3, 0x2f8c4,
+	0x2f8c5, 0x2f8c6, 0x2f8c7, 0x2f8c8, 0x2f8c9, 0x2f8ca, 0x2f8cb, 0x2f8cc,
+	0x2f8cd, 0x2f8ce, 0x2f8cf, 0x2f8d0, 0x2f8d1, 0x2f8d2, 0x2f8d3, 0x2f8d4,
+	0x2f8d5, 0x2f8d6, 0x2f8d7, 0x2f8d8, 0x2f8d9, 0x2f8da, 0x2f8db, 0x2f8dc,
+	0x2f8dd, 0x2f8de, 0x2f8df, 0x2f8e0, 0x2f8e1, 0x2f8e2, 0x2f8e3, 0x2f8e4,
+	0x2f8e5, 0x2f8e6, 0x2f8e7, 0x2f8e8, 0x2f8e9, 0x2f8ea, 0x2f8eb, 0x2f8ec,
+	0x2f8ed, 0x2f8ee, 0x2f8ef, 0x2f8f0, 0x2f8f1, 0x2f8f2, 0x2f8f3, 0x2f8f4,
+	0x2f8f5, 0x2f8f6, 0x2f8f7, 0x2f8f8, 0x2f8f9, 0x2f8fa, 0x2f8fb, 0x2f8fc,
+	0x2f8fd, 0x2f8fe, 0x2f8ff, 0x2f900, 0x2f901, 0x2f902, 0x2f903, 0x2f904,
+	0x2f905, 0x2f906, 0x2f907, 0x2f908, 0x2f909, 0x2f90a, 0x2f90b, 0x2f90c,
+	0x2f90d, 0x2f90e, 0x2f90f, 0x2f910, 0x2f911, 0x2f912, 0x2f913, 0x2f914,
+	0x2f915, 0x2f916, 0x2f917, 0x2f918, 0x2f919, 0x2f91a, 0x2f91b, 0x2f91c,
+	0x2f91d, 0x2f91e, 0x2f91f, 0x2f920, 0x2f921, 0x2f922, 0x2f923, 0x2f924,
+	0x2f925, 0x2f926, 0x2f927, 0x2f928, 0x2f929, 0x2f92a, 0x2f92b, 0x2f92c,
+	0x2f92d, 0x2f92e, 0x2f92f, 0x2f930, 0x2f931, 0x2f932, 0x2f933, 0x2f934,
+	0x2f935, 0x2f936, 0x2f937, 0x2f938, 0x2f939, 0x2f93a, 0x2f93b, 0x2f93c,
+	0x2f93d, 0x2f93e, 0x2f93f, 0x2f940, 0x2f941, 0x2f942, 0x2f943, 0x2f944,
+	0x2f945, 0x2f946, 0x2f947, 0x2f948, 0x2f949, 0x2f94a, 0x2f94b, 0x2f94c,
+	0x2f94d, 0x2f94e, 0x2f94f, 0x2f950, 0x2f951, 0x2f952, 0x2f953, 0x2f954,
+	0x2f955, 0x2f956, 0x2f957, 0x2f958, 0x2f959, 0x2f95a, 0x2f95b, 0x2f95c,
+	0x2f95d, 0x2f95e, 0x2f95f, 0x2f960, 0x2f961, 0x2f962, 0x2f963, 0x2f964,
+	0x2f965, 0x2f966, 0x2f967, 0x2f968, 0x2f969, 0x2f96a, 0x2f96b, 0x2f96c,
+	0x2f96d, 0x2f96e, 0x2f96f, 0x2f970, 0x2f971, 0x2f972, 0x2f973, 0x2f974,
+	0x2f975, 0x2f976, 0x2f977, 0x2f978, 0x2f979, 0x2f97a, 0x2f97b, 0x2f97c,
+	0x2f97d, 0x2f97e, 0x2f97f, 0x2f980, 0x2f981, 0x2f982, 0x2f983, 0x2f984,
+	0x2f985, 0x2f986, 0x2f987, 0x2f988, 0x2f989, 0x2f98a, 0x2f98b, 0x2f98c,
+	0x2f98d, 0x2f98e, 0x2f98f, 0x2f990, 0x2f991, 0x2f992, 0x2f993, 0x2f994,
+	0x2f995, 0x2f996, 0x2f997, 0x2f998, 0x2f999, 0x2f99a, 0x2f99b, 0x2f99c,
+	0x2f99d, 0x2f99e, 0x2f99f, 0x2f9a0, 0x2f9a1, 0x2f9a2, 0x2f9a3, 0x2f9a4,
+	0x2f9a5, 0x2f9a6, 0x2f9a7, 0x2f9a8, 0x2f9a9, 0x2f9aa, 0x2f9ab, 0x2f9ac,
+	0x2f9ad, 0x2f9ae, 0x2f9af, 0x2f9b0, 0x2f9b1, 0x2f9b2, 0x2f9b3, 0x2f9b4,
+	0x2f9b5, 0x2f9b6, 0x2f9b7, 0x2f9b8, 0x2f9b9, 0x2f9ba, 0x2f9bb, 0x2f9bc,
+	0x2f9bd, 0x2f9be, 0x2f9bf, 0x2f9c0, 0x2f9c1, 0x2f9c2, 0x2f9c3, 0x2f9c4,
+	0x2f9c5, 0x2f9c6, 0x2f9c7, 0x2f9c8, 0x2f9c9, 0x2f9ca, 0x2f9cb, 0x2f9cc,
+	0x2f9cd, 0x2f9ce, 0x2f9cf, 0x2f9d0, 0x2f9d1, 0x2f9d2, 0x2f9d3, 0x2f9d4,
+	0x2f9d5, 0x2f9d6, 0x2f9d7, 0x2f9d8, 0x2f9d9, 0x2f9da, 0x2f9db, 0x2f9dc,
+	0x2f9dd, 0x2f9de, 0x2f9df, 0x2f9e0, 0x2f9e1, 0x2f9e2, 0x2f9e3, 0x2f9e4,
+	0x2f9e5, 0x2f9e6, 0x2f9e7, 0x2f9e8, 0x2f9e9, 0x2f9ea, 0x2f9eb, 0x2f9ec,
+	0x2f9ed, 0x2f9ee, 0x2f9ef, 0x2f9f0, 0x2f9f1, 0x2f9f2, 0x2f9f3, 0x2f9f4,
+	0x2f9f5, 0x2f9f6, 0x2f9f7, 0x2f9f8, 0x2f9f9, 0x2f9fa, 0x2f9fb, 0x2f9fc,
+	0x2f9fd, 0x2f9fe, 0x2f9ff, 0x2fa00, 0x2fa01, 0x2fa02, 0x2fa03, 0x2fa04,
+	0x2fa05, 0x2fa06, 0x2fa07, 0x2fa08, 0x2fa09, 0x2fa0a, 0x2fa0b, 0x2fa0c,
+	0x2fa0d, 0x2fa0e, 0x2fa0f, 0x2fa10, 0x2fa11, 0x2fa12, 0x2fa13, 0x2fa14,
+	0x2fa15, 0x2fa16, 0x2fa17, 0x2fa18, 0x2fa19, 0x2fa1a, 0x2fa1b, 0x2fa1c,
+	0x2fa1d,
+}
+
+var canonicalDecompositionOffsets = [...]uint16{
+	0x0000, 0x0002, 0x0004, 0x0006, 0x0008, 0x000a, 0x000c, 0x000e,
+	0x0010, 0x0012, 0x0014, 0x0016, 0x0018, 0x001a, 0x001c, 0x001e,
+	0x0020, 0x0022, 0x0024, 0x0026, 0x0028, 0x002a, 0x002c, 0x002e,
+	0x0030, 0x0032, 0x0034, 0x0036, 0x0038, 0x003a, 0x003c, 0x003e,
+	0x0040, 0x0042, 0x0044, 0x0046, 0x0048, 0x004a, 0x004c, 0x004e,
+	0x0050, 0x0052, 0x0054, 0x0056, 0x0058, 0x005a, 0x005c, 0x005e,
+	0x0060, 0x0062, 0x0064, 0x0066, 0x0068, 0x006a, 0x006c, 0x006e,
+	0x0070, 0x0072, 0x0074, 0x0076, 0x0078, 0x007a, 0x007c, 0x007e,
+	0x0080, 0x0082, 0x0084, 0x0086, 0x0088, 0x008a, 0x008c, 0x008e,
+	0x0090, 0x0092, 0x0094, 0x0096, 0x0098, 0x009a, 0x009c, 0x009e,
+	0x00a0, 0x00a2, 0x00a4, 0x00a6, 0x00a8, 0x00aa, 0x00ac, 0x00ae,
+	0x00b0, 0x00b2, 0x00b4, 0x00b6, 0x00b8, 0x00ba, 0x00bc, 0x00be,
+	0x00c0, 0x00c2, 0x00c4, 0x00c6, 0x00c8, 0x00ca, 0x00cc, 0x00ce,
+	0x00d0, 0x00d2, 0x00d4, 0x00d6, 0x00d8, 0x00da, 0x00dc, 0x00de,
+	0x00e0, 0x00e2, 0x00e4, 0x00e6, 0x00e8, 0x00ea, 0x00ec, 0x00ee,
+	0x00f0, 0x00f2, 0x00f4, 0x00f6, 0x00f8, 0x00fa, 0x00fc, 0x00fe,
+	0x0100, 0x0102, 0x0104, 0x0106, 0x0108, 0x010a, 0x010c, 0x010e,
+	0x0110, 0x0112, 0x0114, 0x0116, 0x0118, 0x011a, 0x011c, 0x011e,
+	0x0120, 0x0122, 0x0124, 0x0126, 0x0128, 0x012a, 0x012c, 0x012e,
+	0x0130, 0x0132, 0x0134, 0x0136, 0x0138, 0x013a, 0x013c, 0x013e,
+	0x0140, 0x0142, 0x0144, 0x0146, 0x0148, 0x014a, 0x014c, 0x014e,
+	0x0150, 0x0152, 0x0154, 0x0156, 0x0158, 0x015a, 0x015d, 0x0160,
+	0x0163, 0x0166, 0x0169, 0x016c, 0x016f, 0x0172, 0x0175, 0x0178,
+	0x017b, 0x017e, 0x0180, 0x0182, 0x0184, 0x0186, 0x0188, 0x018a,
+	0x018c, 0x018e, 0x0191, 0x0194, 0x0196, 0x0198, 0x019a, 0x019c,
+	0x019e, 0x01a0, 0x01a2, 0x01a5, 0x01a8, 0x01aa, 0x01ac, 0x01ae,
+	0x01b0, 0x01b2, 0x01b4, 0x01b6, 0x01b8, 0x01ba, 0x01bc, 0x01be,
+	0x01c0, 0x01c2, 0x01c4, 0x01c6, 0x01c8, 0x01ca, 0x01cc, 0x01ce,
+	0x01d0, 0x01d2, 0x01d4, 0x01d6, 0x01d8, 0x01da, 0x01dc, 0x01de,
+	0x01e0, 0x01e2, 0x01e4, 0x01e6, 0x01e8, 0x01ea, 0x01ec, 0x01ee,
+	0x01f0, 0x01f2, 0x01f4, 0x01f7, 0x01fa, 0x01fd, 0x0200, 0x0202,
+	0x0204, 0x0207, 0x020a, 0x020c, 0x020e, 0x020f, 0x0210, 0x0211,
+	0x0213, 0x0214, 0x0215, 0x0217, 0x0219, 0x021a, 0x021c, 0x021e,
+	0x0220, 0x0222, 0x0224, 0x0226, 0x0229, 0x022b, 0x022d, 0x022f,
+	0x0231, 0x0233, 0x0235, 0x0238, 0x023a, 0x023c, 0x023e, 0x0240,
+	0x0242, 0x0244, 0x0246, 0x0248, 0x024a, 0x024c, 0x024e, 0x0250,
+	0x0252, 0x0254, 0x0256, 0x0258, 0x025a, 0x025c, 0x025e, 0x0260,
+	0x0262, 0x0264, 0x0266, 0x0268, 0x026a, 0x026c, 0x026e, 0x0270,
+	0x0272, 0x0274, 0x0276, 0x0278, 0x027a, 0x027c, 0x027e, 0x0280,
+	0x0282, 0x0284, 0x0286, 0x0288, 0x028a, 0x028c, 0x028e, 0x0290,
+	0x0292, 0x0294, 0x0296, 0x0298, 0x029a, 0x029c, 0x029e, 0x02a0,
+	0x02a2, 0x02a4, 0x02a6, 0x02a8, 0x02aa, 0x02ac, 0x02ae, 0x02b0,
+	0x02b2, 0x02b4, 0x02b6, 0x02b8, 0x02ba, 0x02bc, 0x02be, 0x02c0,
+	0x02c2, 0x02c4, 0x02c6, 0x02c8, 0x02ca, 0x02cc, 0x02ce, 0x02d0,
+	0x02d2, 0x02d4, 0x02d6, 0x02d8, 0x02da, 0x02dc, 0x02de, 0x02e0,
+	0x02e2, 0x02e4, 0x02e6, 0x02e8, 0x02ea, 0x02ec, 0x02ee, 0x02f0,
+	0x02f2, 0x02f4, 0x02f6, 0x02f8, 0x02fa, 0x02fc, 0x02fe, 0x0300,
+	0x0302, 0x0304, 0x0306, 0x0309, 0x030b, 0x030d, 0x030f, 0x0311,
+	0x0313, 0x0316, 0x0318, 0x031a, 0x031c, 0x031e, 0x0320, 0x0322,
+	0x0324, 0x0326, 0x0328, 0x032a, 0x032c, 0x032e, 0x0330, 0x0332,
+	0x0334, 0x0336, 0x0338, 0x033a, 0x033c, 0x033e, 0x0340, 0x0342,
+	0x0344, 0x0346, 0x0348, 0x034a, 0x034c, 0x034e, 0x0350, 0x0352,
+	0x0354, 0x0356, 0x0358, 0x035a, 0x035c, 0x035e, 0x0360, 0x0362,
+	0x0365, 0x0368, 0x036a, 0x036c, 0x036e, 0x0370, 0x0372, 0x0374,
+	0x0376, 0x0378, 0x037a, 0x037c, 0x037f, 0x0382, 0x0385, 0x0388,
+	0x038a, 0x038c, 0x038e, 0x0390, 0x0393, 0x0396, 0x0398, 0x039a,
+	0x039c, 0x039e, 0x03a0, 0x03a2, 0x03a4, 0x03a6, 0x03a8, 0x03aa,
+	0x03ac, 0x03ae, 0x03b0, 0x03b2, 0x03b4, 0x03b6, 0x03b9, 0x03bc,
+	0x03be, 0x03c0, 0x03c2, 0x03c4, 0x03c6, 0x03c8, 0x03ca, 0x03cc,
+	0x03cf, 0x03d2, 0x03d4, 0x03d6, 0x03d8, 0x03da, 0x03dc, 0x03de,
+	0x03e0, 0x03e2, 0x03e4, 0x03e6, 0x03e8, 0x03ea, 0x03ec, 0x03ee,
+	0x03f0, 0x03f2, 0x03f4, 0x03f6, 0x03f9, 0x03fc, 0x03ff, 0x0402,
+	0x0405, 0x0408, 0x040b, 0x040e, 0x0410, 0x0412, 0x0414, 0x0416,
+	0x0418, 0x041a, 0x041c, 0x041e, 0x0421, 0x0424, 0x0426, 0x0428,
+	0x042a, 0x042c, 0x042e, 0x0430, 0x0433, 0x0436, 0x0439, 0x043c,
+	0x043f, 0x0442, 0x0444, 0x0446, 0x0448, 0x044a, 0x044c, 0x044e,
+	0x0450, 0x0452, 0x0454, 0x0456, 0x0458, 0x045a, 0x045c, 0x045e,
+	0x0461, 0x0464, 0x0467, 0x046a, 0x046c, 0x046e, 0x0470, 0x0472,
+	0x0474, 0x0476, 0x0478, 0x047a, 0x047c, 0x047e, 0x0480, 0x0482,
+	0x0484, 0x0486, 0x0488, 0x048a, 0x048c, 0x048e, 0x0490, 0x0492,
+	0x0494, 0x0496, 0x0498, 0x049a, 0x049c, 0x049e, 0x04a0, 0x04a2,
+	0x04a4, 0x04a6, 0x04a8, 0x04aa, 0x04ac, 0x04ae, 0x04b0, 0x04b3,
+	0x04b6, 0x04b9, 0x04bc, 0x04bf, 0x04c2, 0x04c5, 0x04c8, 0x04cb,
+	0x04ce, 0x04d1, 0x04d4, 0x04d7, 0x04da, 0x04dd, 0x04e0, 0x04e3,
+	0x04e6, 0x04e9, 0x04ec, 0x04ee, 0x04f0, 0x04f2, 0x04f4, 0x04f6,
+	0x04f8, 0x04fb, 0x04fe, 0x0501, 0x0504, 0x0507, 0x050a, 0x050d,
+	0x0510, 0x0513, 0x0516, 0x0518, 0x051a, 0x051c, 0x051e, 0x0520,
+	0x0522, 0x0524, 0x0526, 0x0529, 0x052c, 0x052f, 0x0532, 0x0535,
+	0x0538, 0x053b, 0x053e, 0x0541, 0x0544, 0x0547, 0x054a, 0x054d,
+	0x0550, 0x0553, 0x0556, 0x0559, 0x055c, 0x055f, 0x0562, 0x0564,
+	0x0566, 0x0568, 0x056a, 0x056d, 0x0570, 0x0573, 0x0576, 0x0579,
+	0x057c, 0x057f, 0x0582, 0x0585, 0x0588, 0x058a, 0x058c, 0x058e,
+	0x0590, 0x0592, 0x0594, 0x0596, 0x0598, 0x059a, 0x059c, 0x059f,
+	0x05a2, 0x05a5, 0x05a8, 0x05ab, 0x05ae, 0x05b0, 0x05b2, 0x05b5,
+	0x05b8, 0x05bb, 0x05be, 0x05c1, 0x05c4, 0x05c6, 0x05c8, 0x05cb,
+	0x05ce, 0x05d1, 0x05d4, 0x05d6, 0x05d8, 0x05db, 0x05de, 0x05e1,
+	0x05e4, 0x05e6, 0x05e8, 0x05eb, 0x05ee, 0x05f1, 0x05f4, 0x05f7,
+	0x05fa, 0x05fc, 0x05fe, 0x0601, 0x0604, 0x0607, 0x060a, 0x060d,
+	0x0610, 0x0612, 0x0614, 0x0617, 0x061a, 0x061d, 0x0620, 0x0623,
+	0x0626, 0x0628, 0x062a, 0x062d, 0x0630, 0x0633, 0x0636, 0x0639,
+	0x063c, 0x063e, 0x0640, 0x0643, 0x0646, 0x0649, 0x064c, 0x064e,
+	0x0650, 0x0653, 0x0656, 0x0659, 0x065c, 0x065e, 0x0660, 0x0663,
+	0x0666, 0x0669, 0x066c, 0x066f, 0x0672, 0x0674, 0x0677, 0x067a,
+	0x067d, 0x067f, 0x0681, 0x0684, 0x0687, 0x068a, 0x068d, 0x0690,
+	0x0693, 0x0695, 0x0697, 0x069a, 0x069d, 0x06a0, 0x06a3, 0x06a6,
+	0x06a9, 0x06ab, 0x06ad, 0x06af, 0x06b1, 0x06b3, 0x06b5, 0x06b7,
+	0x06b9, 0x06bb, 0x06bd, 0x06bf, 0x06c1, 0x06c3, 0x06c5, 0x06c8,
+	0x06cb, 0x06cf, 0x06d3, 0x06d7, 0x06db, 0x06df, 0x06e3, 0x06e6,
+	0x06e9, 0x06ed, 0x06f1, 0x06f5, 0x06f9, 0x06fd, 0x0701, 0x0704,
+	0x0707, 0x070b, 0x070f, 0x0713, 0x0717, 0x071b, 0x071f, 0x0722,
+	0x0725, 0x0729, 0x072d, 0x0731, 0x0735, 0x0739, 0x073d, 0x0740,
+	0x0743, 0x0747, 0x074b, 0x074f, 0x0753, 0x0757, 0x075b, 0x075e,
+	0x0761, 0x0765, 0x0769, 0x076d, 0x0771, 0x0775, 0x0779, 0x077b,
+	0x077d, 0x0780, 0x0782, 0x0785, 0x0787, 0x078a, 0x078c, 0x078e,
+	0x0790, 0x0792, 0x0794, 0x0795, 0x0797, 0x079a, 0x079c, 0x079f,
+	0x07a1, 0x07a4, 0x07a6, 0x07a8, 0x07aa, 0x07ac, 0x07ae, 0x07b0,
+	0x07b2, 0x07b4, 0x07b6, 0x07b8, 0x07bb, 0x07be, 0x07c0, 0x07c3,
+	0x07c5, 0x07c7, 0x07c9, 0x07cb, 0x07cd, 0x07cf, 0x07d1, 0x07d3,
+	0x07d5, 0x07d8, 0x07db, 0x07dd, 0x07df, 0x07e1, 0x07e4, 0x07e6,
+	0x07e8, 0x07ea, 0x07ec, 0x07ee, 0x07f0, 0x07f2, 0x07f3, 0x07f6,
+	0x07f8, 0x07fb, 0x07fd, 0x0800, 0x0802, 0x0804, 0x0806, 0x0808,
+	0x080a, 0x080b, 0x080c, 0x080d, 0x080e, 0x080f, 0x0811, 0x0813,
+	0x0815, 0x0817, 0x0819, 0x081b, 0x081d, 0x081f, 0x0821, 0x0823,
+	0x0825, 0x0827, 0x0829, 0x082b, 0x082d, 0x082f, 0x0831, 0x0833,
+	0x0835, 0x0837, 0x0839, 0x083b, 0x083d, 0x083f, 0x0841, 0x0843,
+	0x0845, 0x0847, 0x0849, 0x084b, 0x084d, 0x084f, 0x0851, 0x0853,
+	0x0855, 0x0857, 0x0859, 0x085b, 0x085d, 0x085f, 0x0861, 0x0863,
+	0x0865, 0x0867, 0x0869, 0x086a, 0x086b, 0x086d, 0x086f, 0x0871,
+	0x0873, 0x0875, 0x0877, 0x0879, 0x087b, 0x087d, 0x087f, 0x0881,
+	0x0883, 0x0885, 0x0887, 0x0889, 0x088b, 0x088d, 0x088f, 0x0891,
+	0x0893, 0x0895, 0x0897, 0x0899, 0x089b, 0x089d, 0x089f, 0x08a1,
+	0x08a3, 0x08a5, 0x08a7, 0x08a9, 0x08ab, 0x08ad, 0x08af, 0x08b1,
+	0x08b3, 0x08b5, 0x08b7, 0x08b9, 0x08bb, 0x08bd, 0x08bf, 0x08c1,
+	0x08c3, 0x08c5, 0x08c7, 0x08c9, 0x08cb, 0x08cd, 0x08cf, 0x08d1,
+	0x08d3, 0x08d5, 0x08d7, 0x08d9, 0x08db, 0x08dd, 0x08df, 0x08e1,
+	0x08e2, 0x08e3, 0x08e4, 0x08e5, 0x08e6, 0x08e7, 0x08e8, 0x08e9,
+	0x08ea, 0x08eb, 0x08ec, 0x08ed, 0x08ee, 0x08ef, 0x08f0, 0x08f1,
+	0x08f2, 0x08f3, 0x08f4, 0x08f5, 0x08f6, 0x08f7, 0x08f8, 0x08f9,
+	0x08fa, 0x08fb, 0x08fc, 0x08fd, 0x08fe, 0x08ff, 0x0900, 0x0901,
+	0x0902, 0x0903, 0x0904, 0x0905, 0x0906, 0x0907, 0x0908, 0x0909,
+	0x090a, 0x090b, 0x090c, 0x090d, 0x090e, 0x090f, 0x0910, 0x0911,
+	0x0912, 0x0913, 0x0914, 0x0915, 0x0916, 0x0917, 0x0918, 0x0919,
+	0x091a, 0x091b, 0x091c, 0x091d, 0x091e, 0x091f, 0x0920, 0x0921,
+	0x0922, 0x0923, 0x0924, 0x0925, 0x0926, 0x0927, 0x0928, 0x0929,
+	0x092a, 0x092b, 0x092c, 0x092d, 0x092e, 0x092f, 0x0930, 0x0931,
+	0x0932, 0x0933, 0x0934, 0x0935, 0x0936, 0x0937, 0x0938, 0x0939,
+	0x093a, 0x093b, 0x093c, 0x093d, 0x093e, 0x093f, 0x0940, 0x0941,
+	0x0942, 0x0943, 0x0944, 0x0945, 0x0946, 0x0947, 0x0948, 0x0949,
+	0x094a, 0x094b, 0x094c, 0x094d, 0x094e, 0x094f, 0x0950, 0x0951,
+	0x0952, 0x0953, 0x0954, 0x0955, 0x0956, 0x0957, 0x0958, 0x0959,
+	0x095a, 0x095b, 0x095c, 0x095d, 0x095e, 0x095f, 0x0960, 0x0961,
+	0x0962, 0x0963, 0x0964, 0x0965, 0x0966, 0x0967, 0x0968, 0x0969,
+	0x096a, 0x096b, 0x096c, 0x096d, 0x096e, 0x096f, 0x0970, 0x0971,
+	0x0972, 0x0973, 0x0974, 0x0975, 0x0976, 0x0977, 0x0978, 0x0979,
+	0x097a, 0x097b, 0x097c, 0x097d, 0x097e, 0x097f, 0x0980, 0x0981,
+	0x0982, 0x0983, 0x0984, 0x0985, 0x0986, 0x0987, 0x0988, 0x0989,
+	0x098a, 0x098b, 0x098c, 0x098d, 0x098e, 0x098f, 0x0990, 0x0991,
+	0x0992, 0x0993, 0x0994, 0x0995, 0x0996, 0x0997, 0x0998, 0x0999,
+	0x099a, 0x099b, 0x099c, 0x099d, 0x099e, 0x099f, 0x09a0, 0x09a1,
+	0x09a2, 0x09a3, 0x09a4, 0x09a5, 0x09a6, 0x09a7, 0x09a8, 0x09a9,
+	0x09aa, 0x09ab, 0x09ac, 0x09ad, 0x09ae, 0x09af, 0x09b0, 0x09b1,
+	0x09b2, 0x09b3, 0x09b4, 0x09b5, 0x09b6, 0x09b7, 0x09b8, 0x09b9,
+	0x09ba, 0x09bb, 0x09bc, 0x09bd, 0x09be, 0x09bf, 0x09c0, 0x09c1,
+	0x09c2, 0x09c3, 0x09c4, 0x09c5, 0x09c6, 0x09c7, 0x09c8, 0x09c9,
+	0x09ca, 0x09cb, 0x09cc, 0x09cd, 0x09ce, 0x09cf, 0x09d0, 0x09d1,
+	0x09d2, 0x09d3, 0x09d4, 0x09d5, 0x09d6, 0x09d7, 0x09d8, 0x09d9,
+	0x09da, 0x09db, 0x09dc, 0x09dd, 0x09de, 0x09df, 0x09e0, 0x09e1,
+	0x09e2, 0x09e3, 0x09e4, 0x09e5, 0x09e6, 0x09e7, 0x09e8, 0x09e9,
+	0x09ea, 0x09eb, 0x09ec, 0x09ed, 0x09ee, 0x09ef, 0x09f0, 0x09f1,
+	0x09f2, 0x09f3, 0x09f4, 0x09f5, 0x09f6, 0x09f7, 0x09f8, 0x09f9,
+	0x09fa, 0x09fb, 0x09fc, 0x09fd, 0x09fe, 0x09ff, 0x0a00, 0x0a01,
+	0x0a02, 0x0a03, 0x0a04, 0x0a05, 0x0a06, 0x0a07, 0x0a08, 0x0a09,
+	0x0a0a, 0x0a0b, 0x0a0c, 0x0a0d, 0x0a0e, 0x0a0f, 0x0a10, 0x0a11,
+	0x0a12, 0x0a13, 0x0a14, 0x0a15, 0x0a16, 0x0a17, 0x0a18, 0x0a19,
+	0x0a1a, 0x0a1b, 0x0a1c, 0x0a1d, 0x0a1e, 0x0a1f, 0x0a20, 0x0a21,
+	0x0a22, 0x0a23, 0x0a24, 0x0a25, 0x0a26, 0x0a27, 0x0a28, 0x0a29,
+	0x0a2a, 0x0a2b, 0x0a2c, 0x0a2d, 0x0a2e, 0x0a2f, 0x0a30, 0x0a31,
+	0x0a32, 0x0a33, 0x0a34, 0x0a35, 0x0a36, 0x0a37, 0x0a38, 0x0a39,
+	0x0a3a, 0x0a3b, 0x0a3c, 0x0a3d, 0x0a3e, 0x0a3f, 0x0a40, 0x0a41,
+	0x0a42, 0x0a43, 0x0a44, 0x0a45, 0x0a46, 0x0a47, 0x0a48, 0x0a49,
+	0x0a4a, 0x0a4b, 0x0a4c, 0x0a4d, 0x0a4e, 0x0a4f, 0x0a50, 0x0a51,
+	0x0a52, 0x0a53, 0x0a54, 0x0a55, 0x0a56, 0x0a57, 0x0a58, 0x0a59,
+	0x0a5a, 0x0a5b, 0x0a5c, 0x0a5d, 0x0a5e, 0x0a5f, 0x0a60, 0x0a61,
+	0x0a62, 0x0a63, 0x0a64, 0x0a65, 0x0a66, 0x0a67, 0x0a68, 0x0a69,
+	0x0a6a, 0x0a6b, 0x0a6c, 0x0a6d, 0x0a6e, 0x0a6f, 0x0a70, 0x0a71,
+	0x0a72, 0x0a73, 0x0a74, 0x0a75, 0x0a76, 0x0a77, 0x0a78, 0x0a79,
+	0x0a7a, 0x0a7b, 0x0a7c, 0x0a7d, 0x0a7e, 0x0a7f, 0x0a80, 0x0a81,
+	0x0a82, 0x0a83, 0x0a84, 0x0a85, 0x0a86, 0x0a87, 0x0a88, 0x0a89,
+	0x0a8a, 0x0a8b, 0x0a8c, 0x0a8d, 0x0a8e, 0x0a8f, 0x0a90, 0x0a91,
+	0x0a92, 0x0a93, 0x0a94, 0x0a95, 0x0a96, 0x0a97, 0x0a98, 0x0a99,
+	0x0a9a, 0x0a9b, 0x0a9c, 0x0a9d, 0x0a9e, 0x0a9f, 0x0aa0, 0x0aa1,
+	0x0aa2, 0x0aa3, 0x0aa4, 0x0aa5, 0x0aa6, 0x0aa7, 0x0aa8, 0x0aa9,
+	0x0aaa, 0x0aab, 0x0aac, 0x0aad, 0x0aaf, 0x0ab1, 0x0ab3, 0x0ab5,
+	0x0ab8, 0x0abb, 0x0abd, 0x0abf, 0x0ac1, 0x0ac3, 0x0ac5, 0x0ac7,
+	0x0ac9, 0x0acb, 0x0acd, 0x0acf, 0x0ad1, 0x0ad3, 0x0ad5, 0x0ad7,
+	0x0ad9, 0x0adb, 0x0add, 0x0adf, 0x0ae1, 0x0ae3, 0x0ae5, 0x0ae7,
+	0x0ae9, 0x0aeb, 0x0aed, 0x0aef, 0x0af1, 0x0af3, 0x0af5, 0x0af7,
+	0x0af9, 0x0afb, 0x0afd, 0x0aff, 0x0b01, 0x0b03, 0x0b05, 0x0b07,
+	0x0b09, 0x0b0b, 0x0b0d, 0x0b0f, 0x0b11, 0x0b13, 0x0b15, 0x0b17,
+	0x0b19, 0x0b1b, 0x0b1d, 0x0b1f, 0x0b21, 0x0b23, 0x0b25, 0x0b27,
+	0x0b29, 0x0b2c, 0x0b2f, 0x0b32, 0x0b34, 0x0b36, 0x0b39, 0x0b3b,
+	0x0b3d, 0x0b40, 0x0b43, 0x0b46, 0x0b49, 0x0b4c, 0x0b4e, 0x0b50,
+	0x0b53, 0x0b56, 0x0b59, 0x0b5c, 0x0b5d, 0x0b5e, 0x0b5f, 0x0b60,
+	0x0b61, 0x0b62, 0x0b63, 0x0b64, 0x0b65, 0x0b66, 0x0b67, 0x0b68,
+	0x0b69, 0x0b6a, 0x0b6b, 0x0b6c, 0x0b6d, 0x0b6e, 0x0b6f, 0x0b70,
+	0x0b71, 0x0b72, 0x0b73, 0x0b74, 0x0b75, 0x0b76, 0x0b77, 0x0b78,
+	0x0b79, 0x0b7a, 0x0b7b, 0x0b7c, 0x0b7d, 0x0b7e, 0x0b7f, 0x0b80,
+	0x0b81, 0x0b82, 0x0b83, 0x0b84, 0x0b85, 0x0b86, 0x0b87, 0x0b88,
+	0x0b89, 0x0b8a, 0x0b8b, 0x0b8c, 0x0b8d, 0x0b8e, 0x0b8f, 0x0b90,
+	0x0b91, 0x0b92, 0x0b93, 0x0b94, 0x0b95, 0x0b96, 0x0b97, 0x0b98,
+	0x0b99, 0x0b9a, 0x0b9b, 0x0b9c, 0x0b9d, 0x0b9e, 0x0b9f, 0x0ba0,
+	0x0ba1, 0x0ba2, 0x0ba3, 0x0ba4, 0x0ba5, 0x0ba6, 0x0ba7, 0x0ba8,
+	0x0ba9, 0x0baa, 0x0bab, 0x0bac, 0x0bad, 0x0bae, 0x0baf, 0x0bb0,
+	0x0bb1, 0x0bb2, 0x0bb3, 0x0bb4, 0x0bb5, 0x0bb6, 0x0bb7, 0x0bb8,
+	0x0bb9, 0x0bba, 0x0bbb, 0x0bbc, 0x0bbd, 0x0bbe, 0x0bbf, 0x0bc0,
+	0x0bc1, 0x0bc2, 0x0bc3, 0x0bc4, 0x0bc5, 0x0bc6, 0x0bc7, 0x0bc8,
+	0x0bc9, 0x0bca, 0x0bcb, 0x0bcc, 0x0bcd, 0x0bce, 0x0bcf, 0x0bd0,
+	0x0bd1, 0x0bd2, 0x0bd3, 0x0bd4, 0x0bd5, 0x0bd6, 0x0bd7, 0x0bd8,
+	0x0bd9, 0x0bda, 0x0bdb, 0x0bdc, 0x0bdd, 0x0bde, 0x0bdf, 0x0be0,
+	0x0be1, 0x0be2, 0x0be3, 0x0be4, 0x0be5, 0x0be6, 0x0be7, 0x0be8,
+	0x0be9, 0x0bea, 0x0beb, 0x0bec, 0x0bed, 0x0bee, 0x0bef, 0x0bf0,
+	0x0bf1, 0x0bf2, 0x0bf3, 0x0bf4, 0x0bf5, 0x0bf6, 0x0bf7, 0x0bf8,
+	0x0bf9, 0x0bfa, 0x0bfb, 0x0bfc, 0x0bfd, 0x0bfe, 0x0bff, 0x0c00,
+	0x0c01, 0x0c02, 0x0c03, 0x0c04, 0x0c05, 0x0c06, 0x0c07, 0x0c08,
+	0x0c09, 0x0c0a, 0x0c0b, 0x0c0c, 0x0c0d, 0x0c0e, 0x0c0f, 0x0c10,
+	0x0c11, 0x0c12, 0x0c13, 0x0c14, 0x0c15, 0x0c16, 0x0c17, 0x0c18,
+	0x0c19, 0x0c1a, 0x0c1b, 0x0c1c, 0x0c1d, 0x0c1e, 0x0c1f, 0x0c20,
+	0x0c21, 0x0c22, 0x0c23, 0x0c24, 0x0c25, 0x0c26, 0x0c27, 0x0c28,
+	0x0c29, 0x0c2a, 0x0c2b, 0x0c2c, 0x0c2d, 0x0c2e, 0x0c2f, 0x0c30,
+	0x0c31, 0x0c32, 0x0c33, 0x0c34, 0x0c35, 0x0c36, 0x0c37, 0x0c38,
+	0x0c39, 0x0c3a, 0x0c3b, 0x0c3c, 0x0c3d, 0x0c3e, 0x0c3f, 0x0c40,
+	0x0c41, 0x0c42, 0x0c43, 0x0c44, 0x0c45, 0x0c46, 0x0c47, 0x0c48,
+	0x0c49, 0x0c4a, 0x0c4b, 0x0c4c, 0x0c4d, 0x0c4e, 0x0c4f, 0x0c50,
+	0x0c51, 0x0c52, 0x0c53, 0x0c54, 0x0c55, 0x0c56, 0x0c57, 0x0c58,
+	0x0c59, 0x0c5a, 0x0c5b, 0x0c5c, 0x0c5d, 0x0c5e, 0x0c5f, 0x0c60,
+	0x0c61, 0x0c62, 0x0c63, 0x0c64, 0x0c65, 0x0c66, 0x0c67, 0x0c68,
+	0x0c69, 0x0c6a, 0x0c6b, 0x0c6c, 0x0c6d, 0x0c6e, 0x0c6f, 0x0c70,
+	0x0c71, 0x0c72, 0x0c73, 0x0c74, 0x0c75, 0x0c76, 0x0c77, 0x0c78,
+	0x0c79, 0x0c7a, 0x0c7b, 0x0c7c, 0x0c7d, 0x0c7e, 0x0c7f, 0x0c80,
+	0x0c81, 0x0c82, 0x0c83, 0x0c84, 0x0c85, 0x0c86, 0x0c87, 0x0c88,
+	0x0c89, 0x0c8a, 0x0c8b, 0x0c8c, 0x0c8d, 0x0c8e, 0x0c8f, 0x0c90,
+	0x0c91, 0x0c92, 0x0c93, 0x0c94, 0x0c95, 0x0c96, 0x0c97, 0x0c98,
+	0x0c99, 0x0c9a, 0x0c9b, 0x0c9c, 0x0c9d, 0x0c9e, 0x0c9f, 0x0ca0,
+	0x0ca1, 0x0ca2, 0x0ca3, 0x0ca4, 0x0ca5, 0x0ca6, 0x0ca7, 0x0ca8,
+	0x0ca9, 0x0caa, 0x0cab, 0x0cac, 0x0cad, 0x0cae, 0x0caf, 0x0cb0,
+	0x0cb1, 0x0cb2, 0x0cb3, 0x0cb4, 0x0cb5, 0x0cb6, 0x0cb7, 0x0cb8,
+	0x0cb9, 0x0cba, 0x0cbb, 0x0cbc, 0x0cbd, 0x0cbe, 0x0cbf, 0x0cc0,
+	0x0cc1, 0x0cc2, 0x0cc3, 0x0cc4, 0x0cc5, 0x0cc6, 0x0cc7, 0x0cc8,
+	0x0cc9, 0x0cca, 0x0ccb, 0x0ccc, 0x0ccd, 0x0cce, 0x0ccf, 0x0cd0,
+	0x0cd1, 0x0cd2, 0x0cd3, 0x0cd4, 0x0cd5, 0x0cd6, 0x0cd7, 0x0cd8,
+	0x0cd9, 0x0cda, 0x0cdb, 0x0cdc, 0x0cdd, 0x0cde, 0x0cdf, 0x0ce0,
+	0x0ce1, 0x0ce2, 0x0ce3, 0x0ce4, 0x0ce5, 0x0ce6, 0x0ce7, 0x0ce8,
+	0x0ce9, 0x0cea, 0x0ceb, 0x0cec, 0x0ced, 0x0cee, 0x0cef, 0x0cf0,
+	0x0cf1, 0x0cf2, 0x0cf3, 0x0cf4, 0x0cf5, 0x0cf6, 0x0cf7, 0x0cf8,
+	0x0cf9, 0x0cfa, 0x0cfb, 0x0cfc, 0x0cfd, 0x0cfe, 0x0cff, 0x0d00,
+	0x0d01, 0x0d02, 0x0d03, 0x0d04, 0x0d05, 0x0d06, 0x0d07, 0x0d08,
+	0x0d09, 0x0d0a, 0x0d0b, 0x0d0c, 0x0d0d, 0x0d0e, 0x0d0f, 0x0d10,
+	0x0d11, 0x0d12, 0x0d13, 0x0d14, 0x0d15, 0x0d16, 0x0d17, 0x0d18,
+	0x0d19, 0x0d1a, 0x0d1b, 0x0d1c, 0x0d1d, 0x0d1e, 0x0d1f, 0x0d20,
+	0x0d21, 0x0d22, 0x0d23, 0x0d24, 0x0d25, 0x0d26, 0x0d27, 0x0d28,
+	0x0d29, 0x0d2a, 0x0d2b, 0x0d2c, 0x0d2d, 0x0d2e, 0x0d2f, 0x0d30,
+	0x0d31, 0x0d32, 0x0d33, 0x0d34, 0x0d35, 0x0d36, 0x0d37, 0x0d38,
+	0x0d39, 0x0d3a, 0x0d3b, 0x0d3c, 0x0d3d, 0x0d3e, 0x0d3f, 0x0d40,
+	0x0d41, 0x0d42, 0x0d43, 0x0d44, 0x0d45, 0x0d46, 0x0d47, 0x0d48,
+	0x0d49, 0x0d4a, 0x0d4b, 0x0d4c, 0x0d4d, 0x0d4e, 0x0d4f, 0x0d50,
+	0x0d51, 0x0d52, 0x0d53, 0x0d54, 0x0d55, 0x0d56, 0x0d57, 0x0d58,
+	0x0d59, 0x0d5a, 0x0d5b, 0x0d5c, 0x0d5d, 0x0d5e, 0x0d5f, 0x0d60,
+	0x0d61, 0x0d62, 0x0d63, 0x0d64, 0x0d65, 0x0d66, 0x0d67, 0x0d68,
+	0x0d69, 0x0d6a, 0x0d6b, 0x0d6c, 0x0d6d, 0x0d6e, 0x0d6f, 0x0d70,
+	0x0d71, 0x0d72, 0x0d73, 0x0d74, 0x0d75, 0x0d76, 0x0d77, 0x0d78,
+	0x0d79, 0x0d7a,
+}
+
+var canonicalDecompositionRunes = [...]rune{
+	0x00041, 0x00300, 0x00041, 0x00301, 0x00041, 0x00302, 0x00041, 0x00303,
+	0x00041, 0x00308, 0x00041, 0x0030a, 0x00043, 0x00327, 0x00045, 0x00300,
+	0x00045, 0x00301, 0x00045, 0x00302, 0x00045, 0x00308, 0x00049, 0x00300,
+	0x00049, 0x00301, 0x00049, 0x00302, 0x00049, 0x00308, 0x0004e, 0x00303,
+	0x0004f, 0x00300, 0x0004f, 0x00301, 0x0004f, 0x00302, 0x0004f, 0x00303,
+	0x0004f, 0x00308, 0x00055, 0x00300, 0x00055, 0x00301, 0x00055, 0x00302,
+	0x00055, 0x00308, 0x00059, 0x00301, 0x00061, 0x00300, 0x00061, 0x00301,
+	0x00061, 0x00302, 0x00061, 0x00303, 0x00061, 0x00308, 0x00061, 0x0030a,
+	0x00063, 0x00327, 0x00065, 0x00300, 0x00065, 0x00301, 0x00065, 0x00302,
+	0x00065, 0x00308, 0x00069, 0x00300, 0x00069, 0x00301, 0x00069, 0x00302,
+	0x00069, 0x00308, 0x0006e, 0x00303, 0x0006f, 0x00300, 0x0006f, 0x00301,
+	0x0006f, 0x00302, 0x0006f, 0x00303, 0x0006f, 0x00308, 0x00075, 0x00300,
+	0x00075, 0x00301, 0x00075, 0x00302, 0x00075, 0x00308, 0x00079, 0x00301,
+	0x00079, 0x00308, 0x00041, 0x00304, 0x00061, 0x00304, 0x00041, 0x00306,
+	0x00061, 0x00306, 0x00041, 0x00328, 0x00061, 0x00328, 0x00043, 0x00301,
+	0x00063, 0x00301, 0x00043, 0x00302, 0x00063, 0x00302, 0x00043, 0x00307,
+	0x00063, 0x00307, 0x00043, 0x0030c, 0x00063, 0x0030c, 0x00044, 0x0030c,
+	0x00064, 0x0030c, 0x00045, 0x00304, 0x00065, 0x00304, 0x00045, 0x00306,
+	0x00065, 0x00306, 0x00045, 0x00307, 0x00065, 0x00307, 0x00045, 0x00328,
+	0x00065, 0x00328, 0x00045, 0x0030c, 0x00065, 0x0030c, 0x00047, 0x00302,
+	0x00067, 0x00302, 0x00047, 0x00306, 0x00067, 0x00306, 0x00047, 0x00307,
+	0x00067, 0x00307, 0x00047, 0x00327, 0x00067, 0x00327, 0x00048, 0x00302,
+	0x00068, 0x00302, 0x00049, 0x00303, 0x00069, 0x00303, 0x00049, 0x00304,
+	0x00069, 0x00304, 0x00049, 0x00306, 0x00069, 0x00306, 0x00049, 0x00328,
+	0x00069, 0x00328, 0x00049, 0x00307, 0x0004a, 0x00302, 0x0006a, 0x00302,
+	0x0004b, 0x00327, 0x0006b, 0x00327, 0x0004c, 0x00301, 0x0006c, 0x00301,
+	0x0004c, 0x00327, 0x0006c, 0x00327, 0x0004c, 0x0030c, 0x0006c, 0x0030c,
+	0x0004e, 0x00301, 0x0006e, 0x00301, 0x0004e, 0x00327, 0x0006e, 0x00327,
+	0x0004e, 0x0030c, 0x0006e, 0x0030c, 0x0004f, 0x00304, 0x0006f, 0x00304,
+	0x0004f, 0x00306, 0x0006f, 0x00306, 0x0004f, 0x0030b, 0x0006f, 0x0030b,
+	0x00052, 0x00301, 0x00072, 0x00301, 0x00052, 0x00327, 0x00072, 0x00327,
+	0x00052, 0x0030c, 0x00072, 0x0030c, 0x00053, 0x00301, 0x00073, 0x00301,
+	0x00053, 0x00302, 0x00073, 0x00302, 0x00053, 0x00327, 0x00073, 0x00327,
+	0x00053, 0x0030c, 0x00073, 0x0030c, 0x00054, 0x00327, 0x00074, 0x00327,
+	0x00054, 0x0030c, 0x00074, 0x0030c, 0x00055, 0x00303, 0x00075, 0x00303,
+	0x00055, 0x00304, 0x00075, 0x00304, 0x00055, 0x00306, 0x00075, 0x00306,
+	0x00055, 0x0030a, 0x00075, 0x0030a, 0x00055, 0x0030b, 0x00075, 0x0030b,
+	0x00055, 0x00328, 0x00075, 0x00328, 0x00057, 0x00302, 0x00077, 0x00302,
+	0x00059, 0x00302, 0x00079, 0x00302, 0x00059, 0x00308, 0x0005a, 0x00301,
+	0x0007a, 0x00301, 0x0005a, 0x00307, 0x0007a, 0x00307, 0x0005a, 0x0030c,
+	0x0007a, 0x0030c, 0x0004f, 0x0031b, 0x0006f, 0x0031b, 0x00055, 0x0031b,
+	0x00075, 0x0031b, 0x00041, 0x0030c, 0x00061, 0x0030c, 0x00049, 0x0030c,
+	0x00069, 0x0030c, 0x0004f, 0x0030c, 0x0006f, 0x0030c, 0x00055, 0x0030c,
+	0x00075, 0x0030c, 0x00055, 0x00308, 0x00304, 0x00075, 0x00308, 0x00304,
+	0x00055, 0x00308, 0x00301, 0x00075, 0x00308, 0x00301, 0x00055, 0x00308,
+	0x0030c, 0x00075, 0x00308, 0x0030c, 0x00055, 0x00308, 0x00300, 0x00075,
+	0x00308, 0x00300, 0x00041, 0x00308, 0x00304, 0x00061, 0x00308, 0x00304,
+	0x00041, 0x00307, 0x00304, 0x00061, 0x00307, 0x00304, 0x000c6, 0x00304,
+	0x000e6, 0x00304, 0x00047, 0x0030c, 0x00067, 0x0030c, 0x0004b, 0x0030c,
+	0x0006b, 0x0030c, 0x0004f, 0x00328, 0x0006f, 0x00328, 0x0004f, 0x00328,
+	0x00304, 0x0006f, 0x00328, 0x00304, 0x001b7, 0x0030c, 0x00292, 0x0030c,
+	0x0006a, 0x0030c, 0x00047, 0x00301, 0x00067, 0x00301, 0x0004e, 0x00300,
+	0x0006e, 0x00300, 0x00041, 0x0030a, 0x00301, 0x00061, 0x0030a, 0x00301,
+	0x000c6, 0x00301, 0x000e6, 0x00301, 0x000d8, 0x00301, 0x000f8, 0x00301,
+	0x00041, 0x0030f, 0x00061, 0x0030f, 0x00041, 0x00311, 0x00061, 0x00311,
+	0x00045, 0x0030f, 0x00065, 0x0030f, 0x00045, 0x00311, 0x00065, 0x00311,
+	0x00049, 0x0030f, 0x00069, 0x0030f, 0x00049, 0x00311, 0x00069, 0x00311,
+	0x0004f, 0x0030f, 0x0006f, 0x0030f, 0x0004f, 0x00311, 0x0006f, 0x00311,
+	0x00052, 0x0030f, 0x00072, 0x0030f, 0x00052, 0x00311, 0x00072, 0x00311,
+	0x00055, 0x0030f, 0x00075, 0x0030f, 0x00055, 0x00311, 0x00075, 0x00311,
+	0x00053, 0x00326, 0x00073, 0x00326, 0x00054, 0x00326, 0x00074, 0x00326,
+	0x00048, 0x0030c, 0x00068, 0x0030c, 0x00041, 0x00307, 0x00061, 0x00307,
+	0x00045, 0x00327, 0x00065, 0x00327, 0x0004f, 0x00308, 0x00304, 0x0006f,
+	0x00308, 0x00304, 0x0004f, 0x00303, 0x00304, 0x0006f, 0x00303, 0x00304,
+	0x0004f, 0x00307, 0x0006f, 0x00307, 0x0004f, 0x00307, 0x00304, 0x0006f,
+	0x00307, 0x00304, 0x00059, 0x00304, 0x00079, 0x00304, 0x00300, 0x00301,
+	0x00313, 0x00308, 0x00301, 0x002b9, 0x0003b, 0x000a8, 0x00301, 0x00391,
+	0x00301, 0x000b7, 0x00395, 0x00301, 0x00397, 0x00301, 0x00399, 0x00301,
+	0x0039f, 0x00301, 0x003a5, 0x00301, 0x003a9, 0x00301, 0x003b9, 0x00308,
+	0x00301, 0x00399, 0x00308, 0x003a5, 0x00308, 0x003b1, 0x00301, 0x003b5,
+	0x00301, 0x003b7, 0x00301, 0x003b9, 0x00301, 0x003c5, 0x00308, 0x00301,
+	0x003b9, 0x00308, 0x003c5, 0x00308, 0x003bf, 0x00301, 0x003c5, 0x00301,
+	0x003c9, 0x00301, 0x003d2, 0x00301, 0x003d2, 0x00308, 0x00415, 0x00300,
+	0x00415, 0x00308, 0x00413, 0x00301, 0x00406, 0x00308, 0x0041a, 0x00301,
+	0x00418, 0x00300, 0x00423, 0x00306, 0x00418, 0x00306, 0x00438, 0x00306,
+	0x00435, 0x00300, 0x00435, 0x00308, 0x00433, 0x00301, 0x00456, 0x00308,
+	0x0043a, 0x00301, 0x00438, 0x00300, 0x00443, 0x00306, 0x00474, 0x0030f,
+	0x00475, 0x0030f, 0x00416, 0x00306, 0x00436, 0x00306, 0x00410, 0x00306,
+	0x00430, 0x00306, 0x00410, 0x00308, 0x00430, 0x00308, 0x00415, 0x00306,
+	0x00435, 0x00306, 0x004d8, 0x00308, 0x004d9, 0x00308, 0x00416, 0x00308,
+	0x00436, 0x00308, 0x00417, 0x00308, 0x00437, 0x00308, 0x00418, 0x00304,
+	0x00438, 0x00304, 0x00418, 0x00308, 0x00438, 0x00308, 0x0041e, 0x00308,
+	0x0043e, 0x00308, 0x004e8, 0x00308, 0x004e9, 0x00308, 0x0042d, 0x00308,
+	0x0044d, 0x00308, 0x00423, 0x00304, 0x00443, 0x00304, 0x00423, 0x00308,
+	0x00443, 0x00308, 0x00423, 0x0030b, 0x00443, 0x0030b, 0x00427, 0x00308,
+	0x00447, 0x00308, 0x0042b, 0x00308, 0x0044b, 0x00308, 0x00627, 0x00653,
+	0x00627, 0x00654, 0x00648, 0x00654, 0x00627, 0x00655, 0x0064a, 0x00654,
+	0x006d5, 0x00654, 0x006c1, 0x00654, 0x006d2, 0x00654, 0x00928, 0x0093c,
+	0x00930, 0x0093c, 0x00933, 0x0093c, 0x00915, 0x0093c, 0x00916, 0x0093c,
+	0x00917, 0x0093c, 0x0091c, 0x0093c, 0x00921, 0x0093c, 0x00922, 0x0093c,
+	0x0092b, 0x0093c, 0x0092f, 0x0093c, 0x009c7, 0x009be, 0x009c7, 0x009d7,
+	0x009a1, 0x009bc, 0x009a2, 0x009bc, 0x009af, 0x009bc, 0x00a32, 0x00a3c,
+	0x00a38, 0x00a3c, 0x00a16, 0x00a3c, 0x00a17, 0x00a3c, 0x00a1c, 0x00a3c,
+	0x00a2b, 0x00a3c, 0x00b47, 0x00b56, 0x00b47, 0x00b3e, 0x00b47, 0x00b57,
+	0x00b21, 0x00b3c, 0x00b22, 0x00b3c, 0x00b92, 0x00bd7, 0x00bc6, 0x00bbe,
+	0x00bc7, 0x00bbe, 0x00bc6, 0x00bd7, 0x00c46, 0x00c56, 0x00cbf, 0x00cd5,
+	0x00cc6, 0x00cd5, 0x00cc6, 0x00cd6, 0x00cc6, 0x00cc2, 0x00cc6, 0x00cc2,
+	0x00cd5, 0x00d46, 0x00d3e, 0x00d47, 0x00d3e, 0x00d46, 0x00d57, 0x00dd9,
+	0x00dca, 0x00dd9, 0x00dcf, 0x00dd9, 0x00dcf, 0x00dca, 0x00dd9, 0x00ddf,
+	0x00f42, 0x00fb7, 0x00f4c, 0x00fb7, 0x00f51, 0x00fb7, 0x00f56, 0x00fb7,
+	0x00f5b, 0x00fb7, 0x00f40, 0x00fb5, 0x00f71, 0x00f72, 0x00f71, 0x00f74,
+	0x00fb2, 0x00f80, 0x00fb3, 0x00f80, 0x00f71, 0x00f80, 0x00f92, 0x00fb7,
+	0x00f9c, 0x00fb7, 0x00fa1, 0x00fb7, 0x00fa6, 0x00fb7, 0x00fab, 0x00fb7,
+	0x00f90, 0x00fb5, 0x01025, 0x0102e, 0x01b05, 0x01b35, 0x01b07, 0x01b35,
+	0x01b09, 0x01b35, 0x01b0b, 0x01b35, 0x01b0d, 0x01b35, 0x01b11, 0x01b35,
+	0x01b3a, 0x01b35, 0x01b3c, 0x01b35, 0x01b3e, 0x01b35, 0x01b3f, 0x01b35,
+	0x01b42, 0x01b35, 0x00041, 0x00325, 0x00061, 0x00325, 0x00042, 0x00307,
+	0x00062, 0x00307, 0x00042, 0x00323, 0x00062, 0x00323, 0x00042, 0x00331,
+	0x00062, 0x00331, 0x00043, 0x00327, 0x00301, 0x00063, 0x00327, 0x00301,
+	0x00044, 0x00307, 0x00064, 0x00307, 0x00044, 0x00323, 0x00064, 0x00323,
+	0x00044, 0x00331, 0x00064, 0x00331, 0x00044, 0x00327, 0x00064, 0x00327,
+	0x00044, 0x0032d, 0x00064, 0x0032d, 0x00045, 0x00304, 0x00300, 0x00065,
+	0x00304, 0x00300, 0x00045, 0x00304, 0x00301, 0x00065, 0x00304, 0x00301,
+	0x00045, 0x0032d, 0x00065, 0x0032d, 0x00045, 0x00330, 0x00065, 0x00330,
+	0x00045, 0x00327, 0x00306, 0x00065, 0x00327, 0x00306, 0x00046, 0x00307,
+	0x00066, 0x00307, 0x00047, 0x00304, 0x00067, 0x00304, 0x00048, 0x00307,
+	0x00068, 0x00307, 0x00048, 0x00323, 0x00068, 0x00323, 0x00048, 0x00308,
+	0x00068, 0x00308, 0x00048, 0x00327, 0x00068, 0x00327, 0x00048, 0x0032e,
+	0x00068, 0x0032e, 0x00049, 0x00330, 0x00069, 0x00330, 0x00049, 0x00308,
+	0x00301, 0x00069, 0x00308, 0x00301, 0x0004b, 0x00301, 0x0006b, 0x00301,
+	0x0004b, 0x00323, 0x0006b, 0x00323, 0x0004b, 0x00331, 0x0006b, 0x00331,
+	0x0004c, 0x00323, 0x0006c, 0x00323, 0x0004c, 0x00323, 0x00304, 0x0006c,
+	0x00323, 0x00304, 0x0004c, 0x00331, 0x0006c, 0x00331, 0x0004c, 0x0032d,
+	0x0006c, 0x0032d, 0x0004d, 0x00301, 0x0006d, 0x00301, 0x0004d, 0x00307,
+	0x0006d, 0x00307, 0x0004d, 0x00323, 0x0006d, 0x00323, 0x0004e, 0x00307,
+	0x0006e, 0x00307, 0x0004e, 0x00323, 0x0006e, 0x00323, 0x0004e, 0x00331,
+	0x0006e, 0x00331, 0x0004e, 0x0032d, 0x0006e, 0x0032d, 0x0004f, 0x00303,
+	0x00301, 0x0006f, 0x00303, 0x00301, 0x0004f, 0x00303, 0x00308, 0x0006f,
+	0x00303, 0x00308, 0x0004f, 0x00304, 0x00300, 0x0006f, 0x00304, 0x00300,
+	0x0004f, 0x00304, 0x00301, 0x0006f, 0x00304, 0x00301, 0x00050, 0x00301,
+	0x00070, 0x00301, 0x00050, 0x00307, 0x00070, 0x00307, 0x00052, 0x00307,
+	0x00072, 0x00307, 0x00052, 0x00323, 0x00072, 0x00323, 0x00052, 0x00323,
+	0x00304, 0x00072, 0x00323, 0x00304, 0x00052, 0x00331, 0x00072, 0x00331,
+	0x00053, 0x00307, 0x00073, 0x00307, 0x00053, 0x00323, 0x00073, 0x00323,
+	0x00053, 0x00301, 0x00307, 0x00073, 0x00301, 0x00307, 0x00053, 0x0030c,
+	0x00307, 0x00073, 0x0030c, 0x00307, 0x00053, 0x00323, 0x00307, 0x00073,
+	0x00323, 0x00307, 0x00054, 0x00307, 0x00074, 0x00307, 0x00054, 0x00323,
+	0x00074, 0x00323, 0x00054, 0x00331, 0x00074, 0x00331, 0x00054, 0x0032d,
+	0x00074, 0x0032d, 0x00055, 0x00324, 0x00075, 0x00324, 0x00055, 0x00330,
+	0x00075, 0x00330, 0x00055, 0x0032d, 0x00075, 0x0032d, 0x00055, 0x00303,
+	0x00301, 0x00075, 0x00303, 0x00301, 0x00055, 0x00304, 0x00308, 0x00075,
+	0x00304, 0x00308, 0x00056, 0x00303, 0x00076, 0x00303, 0x00056, 0x00323,
+	0x00076, 0x00323, 0x00057, 0x00300, 0x00077, 0x00300, 0x00057, 0x00301,
+	0x00077, 0x00301, 0x00057, 0x00308, 0x00077, 0x00308, 0x00057, 0x00307,
+	0x00077, 0x00307, 0x00057, 0x00323, 0x00077, 0x00323, 0x00058, 0x00307,
+	0x00078, 0x00307, 0x00058, 0x00308, 0x00078, 0x00308, 0x00059, 0x00307,
+	0x00079, 0x00307, 0x0005a, 0x00302, 0x0007a, 0x00302, 0x0005a, 0x00323,
+	0x0007a, 0x00323, 0x0005a, 0x00331, 0x0007a, 0x00331, 0x00068, 0x00331,
+	0x00074, 0x00308, 0x00077, 0x0030a, 0x00079, 0x0030a, 0x0017f, 0x00307,
+	0x00041, 0x00323, 0x00061, 0x00323, 0x00041, 0x00309, 0x00061, 0x00309,
+	0x00041, 0x00302, 0x00301, 0x00061, 0x00302, 0x00301, 0x00041, 0x00302,
+	0x00300, 0x00061, 0x00302, 0x00300, 0x00041, 0x00302, 0x00309, 0x00061,
+	0x00302, 0x00309, 0x00041, 0x00302, 0x00303, 0x00061, 0x00302, 0x00303,
+	0x00041, 0x00323, 0x00302, 0x00061, 0x00323, 0x00302, 0x00041, 0x00306,
+	0x00301, 0x00061, 0x00306, 0x00301, 0x00041, 0x00306, 0x00300, 0x00061,
+	0x00306, 0x00300, 0x00041, 0x00306, 0x00309, 0x00061, 0x00306, 0x00309,
+	0x00041, 0x00306, 0x00303, 0x00061, 0x00306, 0x00303, 0x00041, 0x00323,
+	0x00306, 0x00061, 0x00323, 0x00306, 0x00045, 0x00323, 0x00065, 0x00323,
+	0x00045, 0x00309, 0x00065, 0x00309, 0x00045, 0x00303, 0x00065, 0x00303,
+	0x00045, 0x00302, 0x00301, 0x00065, 0x00302, 0x00301, 0x00045, 0x00302,
+	0x00300, 0x00065, 0x00302, 0x00300, 0x00045, 0x00302, 0x00309, 0x00065,
+	0x00302, 0x00309, 0x00045, 0x00302, 0x00303, 0x00065, 0x00302, 0x00303,
+	0x00045, 0x00323, 0x00302, 0x00065, 0x00323, 0x00302, 0x00049, 0x00309,
+	0x00069, 0x00309, 0x00049, 0x00323, 0x00069, 0x00323, 0x0004f, 0x00323,
+	0x0006f, 0x00323, 0x0004f, 0x00309, 0x0006f, 0x00309, 0x0004f, 0x00302,
+	0x00301, 0x0006f, 0x00302, 0x00301, 0x0004f, 0x00302, 0x00300, 0x0006f,
+	0x00302, 0x00300, 0x0004f, 0x00302, 0x00309, 0x0006f, 0x00302, 0x00309,
+	0x0004f, 0x00302, 0x00303, 0x0006f, 0x00302, 0x00303, 0x0004f, 0x00323,
+	0x00302, 0x0006f, 0x00323, 0x00302, 0x0004f, 0x0031b, 0x00301, 0x0006f,
+	0x0031b, 0x00301, 0x0004f, 0x0031b, 0x00300, 0x0006f, 0x0031b, 0x00300,
+	0x0004f, 0x0031b, 0x00309, 0x0006f, 0x0031b, 0x00309, 0x0004f, 0x0031b,
+	0x00303, 0x0006f, 0x0031b, 0x00303, 0x0004f, 0x0031b, 0x00323, 0x0006f,
+	0x0031b, 0x00323, 0x00055, 0x00323, 0x00075, 0x00323, 0x00055, 0x00309,
+	0x00075, 0x00309, 0x00055, 0x0031b, 0x00301, 0x00075, 0x0031b, 0x00301,
+	0x00055, 0x0031b, 0x00300, 0x00075, 0x0031b, 0x00300, 0x00055, 0x0031b,
+	0x00309, 0x00075, 0x0031b, 0x00309, 0x00055, 0x0031b, 0x00303, 0x00075,
+	0x0031b, 0x00303, 0x00055, 0x0031b, 0x00323, 0x00075, 0x0031b, 0x00323,
+	0x00059, 0x00300, 0x00079, 0x00300, 0x00059, 0x00323, 0x00079, 0x00323,
+	0x00059, 0x00309, 0x00079, 0x00309, 0x00059, 0x00303, 0x00079, 0x00303,
+	0x003b1, 0x00313, 0x003b1, 0x00314, 0x003b1, 0x00313, 0x00300, 0x003b1,
+	0x00314, 0x00300, 0x003b1, 0x00313, 0x00301, 0x003b1, 0x00314, 0x00301,
+	0x003b1, 0x00313, 0x00342, 0x003b1, 0x00314, 0x00342, 0x00391, 0x00313,
+	0x00391, 0x00314, 0x00391, 0x00313, 0x00300, 0x00391, 0x00314, 0x00300,
+	0x00391, 0x00313, 0x00301, 0x00391, 0x00314, 0x00301, 0x00391, 0x00313,
+	0x00342, 0x00391, 0x00314, 0x00342, 0x003b5, 0x00313, 0x003b5, 0x00314,
+	0x003b5, 0x00313, 0x00300, 0x003b5, 0x00314, 0x00300, 0x003b5, 0x00313,
+	0x00301, 0x003b5, 0x00314, 0x00301, 0x00395, 0x00313, 0x00395, 0x00314,
+	0x00395, 0x00313, 0x00300, 0x00395, 0x00314, 0x00300, 0x00395, 0x00313,
+	0x00301, 0x00395, 0x00314, 0x00301, 0x003b7, 0x00313, 0x003b7, 0x00314,
+	0x003b7, 0x00313, 0x00300, 0x003b7, 0x00314, 0x00300, 0x003b7, 0x00313,
+	0x00301, 0x003b7, 0x00314, 0x00301, 0x003b7, 0x00313, 0x00342, 0x003b7,
+	0x00314, 0x00342, 0x00397, 0x00313, 0x00397, 0x00314, 0x00397, 0x00313,
+	0x00300, 0x00397, 0x00314, 0x00300, 0x00397, 0x00313, 0x00301, 0x00397,
+	0x00314, 0x00301, 0x00397, 0x00313, 0x00342, 0x00397, 0x00314, 0x00342,
+	0x003b9, 0x00313, 0x003b9, 0x00314, 0x003b9, 0x00313, 0x00300, 0x003b9,
+	0x00314, 0x00300, 0x003b9, 0x00313, 0x00301, 0x003b9, 0x00314, 0x00301,
+	0x003b9, 0x00313, 0x00342, 0x003b9, 0x00314, 0x00342, 0x00399, 0x00313,
+	0x00399, 0x00314, 0x00399, 0x00313, 0x00300, 0x00399, 0x00314, 0x00300,
+	0x00399, 0x00313, 0x00301, 0x00399, 0x00314, 0x00301, 0x00399, 0x00313,
+	0x00342, 0x00399, 0x00314, 0x00342, 0x003bf, 0x00313, 0x003bf, 0x00314,
+	0x003bf, 0x00313, 0x00300, 0x003bf, 0x00314, 0x00300, 0x003bf, 0x00313,
+	0x00301, 0x003bf, 0x00314, 0x00301, 0x0039f, 0x00313, 0x0039f, 0x00314,
+	0x0039f, 0x00313, 0x00300, 0x0039f, 0x00314, 0x00300, 0x0039f, 0x00313,
+	0x00301, 0x0039f, 0x00314, 0x00301, 0x003c5, 0x00313, 0x003c5, 0x00314,
+	0x003c5, 0x00313, 0x00300, 0x003c5, 0x00314, 0x00300, 0x003c5, 0x00313,
+	0x00301, 0x003c5, 0x00314, 0x00301, 0x003c5, 0x00313, 0x00342, 0x003c5,
+	0x00314, 0x00342, 0x003a5, 0x00314, 0x003a5, 0x00314, 0x00300, 0x003a5,
+	0x00314, 0x00301, 0x003a5, 0x00314, 0x00342, 0x003c9, 0x00313, 0x003c9,
+	0x00314, 0x003c9, 0x00313, 0x00300, 0x003c9, 0x00314, 0x00300, 0x003c9,
+	0x00313, 0x00301, 0x003c9, 0x00314, 0x00301, 0x003c9, 0x00313, 0x00342,
+	0x003c9, 0x00314, 0x00342, 0x003a9, 0x00313, 0x003a9, 0x00314, 0x003a9,
+	0x00313, 0x00300, 0x003a9, 0x00314, 0x00300, 0x003a9, 0x00313, 0x00301,
+	0x003a9, 0x00314, 0x00301, 0x003a9, 0x00313, 0x00342, 0x003a9, 0x00314,
+	0x00342, 0x003b1, 0x00300, 0x003b1, 0x00301, 0x003b5, 0x00300, 0x003b5,
+	0x00301, 0x003b7, 0x00300, 0x003b7, 0x00301, 0x003b9, 0x00300, 0x003b9,
+	0x00301, 0x003bf, 0x00300, 0x003bf, 0x00301, 0x003c5, 0x00300, 0x003c5,
+	0x00301, 0x003c9, 0x00300, 0x003c9, 0x00301, 0x003b1, 0x00313, 0x00345,
+	0x003b1, 0x00314, 0x00345, 0x003b1, 0x00313, 0x00300, 0x00345, 0x003b1,
+	0x00314, 0x00300, 0x00345, 0x003b1, 0x00313, 0x00301, 0x00345, 0x003b1,
+	0x00314, 0x00301, 0x00345, 0x003b1, 0x00313, 0x00342, 0x00345, 0x003b1,
+	0x00314, 0x00342, 0x00345, 0x00391, 0x00313, 0x00345, 0x00391, 0x00314,
+	0x00345, 0x00391, 0x00313, 0x00300, 0x00345, 0x00391, 0x00314, 0x00300,
+	0x00345, 0x00391, 0x00313, 0x00301, 0x00345, 0x00391, 0x00314, 0x00301,
+	0x00345, 0x00391, 0x00313, 0x00342, 0x00345, 0x00391, 0x00314, 0x00342,
+	0x00345, 0x003b7, 0x00313, 0x00345, 0x003b7, 0x00314, 0x00345, 0x003b7,
+	0x00313, 0x00300, 0x00345, 0x003b7, 0x00314, 0x00300, 0x00345, 0x003b7,
+	0x00313, 0x00301, 0x00345, 0x003b7, 0x00314, 0x00301, 0x00345, 0x003b7,
+	0x00313, 0x00342, 0x00345, 0x003b7, 0x00314, 0x00342, 0x00345, 0x00397,
+	0x00313, 0x00345, 0x00397, 0x00314, 0x00345, 0x00397, 0x00313, 0x00300,
+	0x00345, 0x00397, 0x00314, 0x00300, 0x00345, 0x00397, 0x00313, 0x00301,
+	0x00345, 0x00397, 0x00314, 0x00301, 0x00345, 0x00397, 0x00313, 0x00342,
+	0x00345, 0x00397, 0x00314, 0x00342, 0x00345, 0x003c9, 0x00313, 0x00345,
+	0x003c9, 0x00314, 0x00345, 0x003c9, 0x00313, 0x00300, 0x00345, 0x003c9,
+	0x00314, 0x00300, 0x00345, 0x003c9, 0x00313, 0x00301, 0x00345, 0x003c9,
+	0x00314, 0x00301, 0x00345, 0x003c9, 0x00313, 0x00342, 0x00345, 0x003c9,
+	0x00314, 0x00342, 0x00345, 0x003a9, 0x00313, 0x00345, 0x003a9, 0x00314,
+	0x00345, 0x003a9, 0x00313, 0x00300, 0x00345, 0x003a9, 0x00314, 0x00300,
+	0x00345, 0x003a9, 0x00313, 0x00301, 0x00345, 0x003a9, 0x00314, 0x00301,
+	0x00345, 0x003a9, 0x00313, 0x00342, 0x00345, 0x003a9, 0x00314, 0x00342,
+	0x00345, 0x003b1, 0x00306, 0x003b1, 0x00304, 0x003b1, 0x00300, 0x00345,
+	0x003b1, 0x00345, 0x003b1, 0x00301, 0x00345, 0x003b1, 0x00342, 0x003b1,
+	0x00342, 0x00345, 0x00391, 0x00306, 0x00391, 0x00304, 0x00391, 0x00300,
+	0x00391, 0x00301, 0x00391, 0x00345, 0x003b9, 0x000a8, 0x00342, 0x003b7,
+	0x00300, 0x00345, 0x003b7, 0x00345, 0x003b7, 0x00301, 0x00345, 0x003b7,
+	0x00342, 0x003b7, 0x00342, 0x00345, 0x00395, 0x00300, 0x00395, 0x00301,
+	0x00397, 0x00300, 0x00397, 0x00301, 0x00397, 0x00345, 0x01fbf, 0x00300,
+	0x01fbf, 0x00301, 0x01fbf, 0x00342, 0x003b9, 0x00306, 0x003b9, 0x00304,
+	0x003b9, 0x00308, 0x00300, 0x003b9, 0x00308, 0x00301, 0x003b9, 0x00342,
+	0x003b9, 0x00308, 0x00342, 0x00399, 0x00306, 0x00399, 0x00304, 0x00399,
+	0x00300, 0x00399, 0x00301, 0x01ffe, 0x00300, 0x01ffe, 0x00301, 0x01ffe,
+	0x00342, 0x003c5, 0x00306, 0x003c5, 0x00304, 0x003c5, 0x00308, 0x00300,
+	0x003c5, 0x00308, 0x00301, 0x003c1, 0x00313, 0x003c1, 0x00314, 0x003c5,
+	0x00342, 0x003c5, 0x00308, 0x00342, 0x003a5, 0x00306, 0x003a5, 0x00304,
+	0x003a5, 0x00300, 0x003a5, 0x00301, 0x003a1, 0x00314, 0x000a8, 0x00300,
+	0x000a8, 0x00301, 0x00060, 0x003c9, 0x00300, 0x00345, 0x003c9, 0x00345,
+	0x003c9, 0x00301, 0x00345, 0x003c9, 0x00342, 0x003c9, 0x00342, 0x00345,
+	0x0039f, 0x00300, 0x0039f, 0x00301, 0x003a9, 0x00300, 0x003a9, 0x00301,
+	0x003a9, 0x00345, 0x000b4, 0x02002, 0x02003, 0x003a9, 0x0004b, 0x00041,
+	0x0030a, 0x02190, 0x00338, 0x02192, 0x00338, 0x02194, 0x00338, 0x021d0,
+	0x00338, 0x021d4, 0x00338, 0x021d2, 0x00338, 0x02203, 0x00338, 0x02208,
+	0x00338, 0x0220b, 0x00338, 0x02223, 0x00338, 0x02225, 0x00338, 0x0223c,
+	0x00338, 0x02243, 0x00338, 0x02245, 0x00338, 0x02248, 0x00338, 0x0003d,
+	0x00338, 0x02261, 0x00338, 0x0224d, 0x00338, 0x0003c, 0x00338, 0x0003e,
+	0x00338, 0x02264, 0x00338, 0x02265, 0x00338, 0x02272, 0x00338, 0x02273,
+	0x00338, 0x02276, 0x00338, 0x02277, 0x00338, 0x0227a, 0x00338, 0x0227b,
+	0x00338, 0x02282, 0x00338, 0x02283, 0x00338, 0x02286, 0x00338, 0x02287,
+	0x00338, 0x022a2, 0x00338, 0x022a8, 0x00338, 0x022a9, 0x00338, 0x022ab,
+	0x00338, 0x0227c, 0x00338, 0x0227d, 0x00338, 0x02291, 0x00338, 0x02292,
+	0x00338, 0x022b2, 0x00338, 0x022b3, 0x00338, 0x022b4, 0x00338, 0x022b5,
+	0x00338, 0x03008, 0x03009, 0x02add, 0x00338, 0x0304b, 0x03099, 0x0304d,
+	0x03099, 0x0304f, 0x03099, 0x03051, 0x03099, 0x03053, 0x03099, 0x03055,
+	0x03099, 0x03057, 0x03099, 0x03059, 0x03099, 0x0305b, 0x03099, 0x0305d,
+	0x03099, 0x0305f, 0x03099, 0x03061, 0x03099, 0x03064, 0x03099, 0x03066,
+	0x03099, 0x03068, 0x03099, 0x0306f, 0x03099, 0x0306f, 0x0309a, 0x03072,
+	0x03099, 0x03072, 0x0309a, 0x03075, 0x03099, 0x03075, 0x0309a, 0x03078,
+	0x03099, 0x03078, 0x0309a, 0x0307b, 0x03099, 0x0307b, 0x0309a, 0x03046,
+	0x03099, 0x0309d, 0x03099, 0x030ab, 0x03099, 0x030ad, 0x03099, 0x030af,
+	0x03099, 0x030b1, 0x03099, 0x030b3, 0x03099, 0x030b5, 0x03099, 0x030b7,
+	0x03099, 0x030b9, 0x03099, 0x030bb, 0x03099, 0x030bd, 0x03099, 0x030bf,
+	0x03099, 0x030c1, 0x03099, 0x030c4, 0x03099, 0x030c6, 0x03099, 0x030c8,
+	0x03099, 0x030cf, 0x03099, 0x030cf, 0x0309a, 0x030d2, 0x03099, 0x030d2,
+	0x0309a, 0x030d5, 0x03099, 0x030d5, 0x0309a, 0x030d8, 0x03099, 0x030d8,
+	0x0309a, 0x030db, 0x03099, 0x030db, 0x0309a, 0x030a6, 0x03099, 0x030ef,
+	0x03099, 0x030f0, 0x03099, 0x030f1, 0x03099, 0x030f2, 0x03099, 0x030fd,
+	0x03099, 0x08c48, 0x066f4, 0x08eca, 0x08cc8, 0x06ed1, 0x04e32, 0x053e5,
+	0x09f9c, 0x09f9c, 0x05951, 0x091d1, 0x05587, 0x05948, 0x061f6, 0x07669,
+	0x07f85, 0x0863f, 0x087ba, 0x088f8, 0x0908f, 0x06a02, 0x06d1b, 0x070d9,
+	0x073de, 0x0843d, 0x0916a, 0x099f1, 0x04e82, 0x05375, 0x06b04, 0x0721b,
+	0x0862d, 0x09e1e, 0x05d50, 0x06feb, 0x085cd, 0x08964, 0x062c9, 0x081d8,
+	0x0881f, 0x05eca, 0x06717, 0x06d6a, 0x072fc, 0x090ce, 0x04f86, 0x051b7,
+	0x052de, 0x064c4, 0x06ad3, 0x07210, 0x076e7, 0x08001, 0x08606, 0x0865c,
+	0x08def, 0x09732, 0x09b6f, 0x09dfa, 0x0788c, 0x0797f, 0x07da0, 0x083c9,
+	0x09304, 0x09e7f, 0x08ad6, 0x058df, 0x05f04, 0x07c60, 0x0807e, 0x07262,
+	0x078ca, 0x08cc2, 0x096f7, 0x058d8, 0x05c62, 0x06a13, 0x06dda, 0x06f0f,
+	0x07d2f, 0x07e37, 0x0964b, 0x052d2, 0x0808b, 0x051dc, 0x051cc, 0x07a1c,
+	0x07dbe, 0x083f1, 0x09675, 0x08b80, 0x062cf, 0x06a02, 0x08afe, 0x04e39,
+	0x05be7, 0x06012, 0x07387, 0x07570, 0x05317, 0x078fb, 0x04fbf, 0x05fa9,
+	0x04e0d, 0x06ccc, 0x06578, 0x07d22, 0x053c3, 0x0585e, 0x07701, 0x08449,
+	0x08aaa, 0x06bba, 0x08fb0, 0x06c88, 0x062fe, 0x082e5, 0x063a0, 0x07565,
+	0x04eae, 0x05169, 0x051c9, 0x06881, 0x07ce7, 0x0826f, 0x08ad2, 0x091cf,
+	0x052f5, 0x05442, 0x05973, 0x05eec, 0x065c5, 0x06ffe, 0x0792a, 0x095ad,
+	0x09a6a, 0x09e97, 0x09ece, 0x0529b, 0x066c6, 0x06b77, 0x08f62, 0x05e74,
+	0x06190, 0x06200, 0x0649a, 0x06f23, 0x07149, 0x07489, 0x079ca, 0x07df4,
+	0x0806f, 0x08f26, 0x084ee, 0x09023, 0x0934a, 0x05217, 0x052a3, 0x054bd,
+	0x070c8, 0x088c2, 0x08aaa, 0x05ec9, 0x05ff5, 0x0637b, 0x06bae, 0x07c3e,
+	0x07375, 0x04ee4, 0x056f9, 0x05be7, 0x05dba, 0x0601c, 0x073b2, 0x07469,
+	0x07f9a, 0x08046, 0x09234, 0x096f6, 0x09748, 0x09818, 0x04f8b, 0x079ae,
+	0x091b4, 0x096b8, 0x060e1, 0x04e86, 0x050da, 0x05bee, 0x05c3f, 0x06599,
+	0x06a02, 0x071ce, 0x07642, 0x084fc, 0x0907c, 0x09f8d, 0x06688, 0x0962e,
+	0x05289, 0x0677b, 0x067f3, 0x06d41, 0x06e9c, 0x07409, 0x07559, 0x0786b,
+	0x07d10, 0x0985e, 0x0516d, 0x0622e, 0x09678, 0x0502b, 0x05d19, 0x06dea,
+	0x08f2a, 0x05f8b, 0x06144, 0x06817, 0x07387, 0x09686, 0x05229, 0x0540f,
+	0x05c65, 0x06613, 0x0674e, 0x068a8, 0x06ce5, 0x07406, 0x075e2, 0x07f79,
+	0x088cf, 0x088e1, 0x091cc, 0x096e2, 0x0533f, 0x06eba, 0x0541d, 0x071d0,
+	0x07498, 0x085fa, 0x096a3, 0x09c57, 0x09e9f, 0x06797, 0x06dcb, 0x081e8,
+	0x07acb, 0x07b20, 0x07c92, 0x072c0, 0x07099, 0x08b58, 0x04ec0, 0x08336,
+	0x0523a, 0x05207, 0x05ea6, 0x062d3, 0x07cd6, 0x05b85, 0x06d1e, 0x066b4,
+	0x08f3b, 0x0884c, 0x0964d, 0x0898b, 0x05ed3, 0x05140, 0x055c0, 0x0585a,
+	0x06674, 0x051de, 0x0732a, 0x076ca, 0x0793c, 0x0795e, 0x07965, 0x0798f,
+	0x09756, 0x07cbe, 0x07fbd, 0x08612, 0x08af8, 0x09038, 0x090fd, 0x098ef,
+	0x098fc, 0x09928, 0x09db4, 0x090de, 0x096b7, 0x04fae, 0x050e7, 0x0514d,
+	0x052c9, 0x052e4, 0x05351, 0x0559d, 0x05606, 0x05668, 0x05840, 0x058a8,
+	0x05c64, 0x05c6e, 0x06094, 0x06168, 0x0618e, 0x061f2, 0x0654f, 0x065e2,
+	0x06691, 0x06885, 0x06d77, 0x06e1a, 0x06f22, 0x0716e, 0x0722b, 0x07422,
+	0x07891, 0x0793e, 0x07949, 0x07948, 0x07950, 0x07956, 0x0795d, 0x0798d,
+	0x0798e, 0x07a40, 0x07a81, 0x07bc0, 0x07df4, 0x07e09, 0x07e41, 0x07f72,
+	0x08005, 0x081ed, 0x08279, 0x08279, 0x08457, 0x08910, 0x08996, 0x08b01,
+	0x08b39, 0x08cd3, 0x08d08, 0x08fb6, 0x09038, 0x096e3, 0x097ff, 0x0983b,
+	0x06075, 0x242ee, 0x08218, 0x04e26, 0x051b5, 0x05168, 0x04f80, 0x05145,
+	0x05180, 0x052c7, 0x052fa, 0x0559d, 0x05555, 0x05599, 0x055e2, 0x0585a,
+	0x058b3, 0x05944, 0x05954, 0x05a62, 0x05b28, 0x05ed2, 0x05ed9, 0x05f69,
+	0x05fad, 0x060d8, 0x0614e, 0x06108, 0x0618e, 0x06160, 0x061f2, 0x06234,
+	0x063c4, 0x0641c, 0x06452, 0x06556, 0x06674, 0x06717, 0x0671b, 0x06756,
+	0x06b79, 0x06bba, 0x06d41, 0x06edb, 0x06ecb, 0x06f22, 0x0701e, 0x0716e,
+	0x077a7, 0x07235, 0x072af, 0x0732a, 0x07471, 0x07506, 0x0753b, 0x0761d,
+	0x0761f, 0x076ca, 0x076db, 0x076f4, 0x0774a, 0x07740, 0x078cc, 0x07ab1,
+	0x07bc0, 0x07c7b, 0x07d5b, 0x07df4, 0x07f3e, 0x08005, 0x08352, 0x083ef,
+	0x08779, 0x08941, 0x08986, 0x08996, 0x08abf, 0x08af8, 0x08acb, 0x08b01,
+	0x08afe, 0x08aed, 0x08b39, 0x08b8a, 0x08d08, 0x08f38, 0x09072, 0x09199,
+	0x09276, 0x0967c, 0x096e3, 0x09756, 0x097db, 0x097ff, 0x0980b, 0x0983b,
+	0x09b12, 0x09f9c, 0x2284a, 0x22844, 0x233d5, 0x03b9d, 0x04018, 0x04039,
+	0x25249, 0x25cd0, 0x27ed3, 0x09f43, 0x09f8e, 0x005d9, 0x005b4, 0x005f2,
+	0x005b7, 0x005e9, 0x005c1, 0x005e9, 0x005c2, 0x005e9, 0x005bc, 0x005c1,
+	0x005e9, 0x005bc, 0x005c2, 0x005d0, 0x005b7, 0x005d0, 0x005b8, 0x005d0,
+	0x005bc, 0x005d1, 0x005bc, 0x005d2, 0x005bc, 0x005d3, 0x005bc, 0x005d4,
+	0x005bc, 0x005d5, 0x005bc, 0x005d6, 0x005bc, 0x005d8, 0x005bc, 0x005d9,
+	0x005bc, 0x005da, 0x005bc, 0x005db, 0x005bc, 0x005dc, 0x005bc, 0x005de,
+	0x005bc, 0x005e0, 0x005bc, 0x005e1, 0x005bc, 0x005e3, 0x005bc, 0x005e4,
+	0x005bc, 0x005e6, 0x005bc, 0x005e7, 0x005bc, 0x005e8, 0x005bc, 0x005e9,
+	0x005bc, 0x005ea, 0x005bc, 0x005d5, 0x005b9, 0x005d1, 0x005bf, 0x005db,
+	0x005bf, 0x005e4, 0x005bf, 0x105d2, 0x00307, 0x105da, 0x00307, 0x11099,
+	0x110ba, 0x1109b, 0x110ba, 0x110a5, 0x110ba, 0x11131, 0x11127, 0x11132,
+	0x11127, 0x11347, 0x1133e, 0x11347, 0x11357, 0x11382, 0x113c9, 0x11384,
+	0x113bb, 0x1138b, 0x113c2, 0x11390, 0x113c9, 0x113c2, 0x113c2, 0x113c2,
+	0x113b8, 0x113c2, 0x113c9, 0x114b9, 0x114ba, 0x114b9, 0x114b0, 0x114b9,
+	0x114bd, 0x115b8, 0x115af, 0x115b9, 0x115af, 0x11935, 0x11930, 0x1611e,
+	0x1611e, 0x1611e, 0x16129, 0x1611e, 0x1611f, 0x16129, 0x1611f, 0x1611e,
+	0x16120, 0x1611e, 0x1611e, 0x1611f, 0x1611e, 0x16129, 0x1611f, 0x1611e,
+	0x1611e, 0x16120, 0x16d67, 0x16d67, 0x16d63, 0x16d67, 0x16d63, 0x16d67,
+	0x16d67, 0x1d157, 0x1d165, 0x1d158, 0x1d165, 0x1d158, 0x1d165, 0x1d16e,
+	0x1d158, 0x1d165, 0x1d16f, 0x1d158, 0x1d165, 0x1d170, 0x1d158, 0x1d165,
+	0x1d171, 0x1d158, 0x1d165, 0x1d172, 0x1d1b9, 0x1d165, 0x1d1ba, 0x1d165,
+	0x1d1b9, 0x1d165, 0x1d16e, 0x1d1ba, 0x1d165, 0x1d16e, 0x1d1b9, 0x1d165,
+	0x1d16f, 0x1d1ba, 0x1d165, 0x1d16f, 0x04e3d, 0x04e38, 0x04e41, 0x20122,
+	0x04f60, 0x04fae, 0x04fbb, 0x05002, 0x0507a, 0x05099, 0x050e7, 0x050cf,
+	0x0349e, 0x2063a, 0x0514d, 0x05154, 0x05164, 0x05177, 0x2051c, 0x034b9,
+	0x05167, 0x0518d, 0x2054b, 0x05197, 0x051a4, 0x04ecc, 0x051ac, 0x051b5,
+	0x291df, 0x051f5, 0x05203, 0x034df, 0x0523b, 0x05246, 0x05272, 0x05277,
+	0x03515, 0x052c7, 0x052c9, 0x052e4, 0x052fa, 0x05305, 0x05306, 0x05317,
+	0x05349, 0x05351, 0x0535a, 0x05373, 0x0537d, 0x0537f, 0x0537f, 0x0537f,
+	0x20a2c, 0x07070, 0x053ca, 0x053df, 0x20b63, 0x053eb, 0x053f1, 0x05406,
+	0x0549e, 0x05438, 0x05448, 0x05468, 0x054a2, 0x054f6, 0x05510, 0x05553,
+	0x05563, 0x05584, 0x05584, 0x05599, 0x055ab, 0x055b3, 0x055c2, 0x05716,
+	0x05606, 0x05717, 0x05651, 0x05674, 0x05207, 0x058ee, 0x057ce, 0x057f4,
+	0x0580d, 0x0578b, 0x05832, 0x05831, 0x058ac, 0x214e4, 0x058f2, 0x058f7,
+	0x05906, 0x0591a, 0x05922, 0x05962, 0x216a8, 0x216ea, 0x059ec, 0x05a1b,
+	0x05a27, 0x059d8, 0x05a66, 0x036ee, 0x036fc, 0x05b08, 0x05b3e, 0x05b3e,
+	0x219c8, 0x05bc3, 0x05bd8, 0x05be7, 0x05bf3, 0x21b18, 0x05bff, 0x05c06,
+	0x05f53, 0x05c22, 0x03781, 0x05c60, 0x05c6e, 0x05cc0, 0x05c8d, 0x21de4,
+	0x05d43, 0x21de6, 0x05d6e, 0x05d6b, 0x05d7c, 0x05de1, 0x05de2, 0x0382f,
+	0x05dfd, 0x05e28, 0x05e3d, 0x05e69, 0x03862, 0x22183, 0x0387c, 0x05eb0,
+	0x05eb3, 0x05eb6, 0x05eca, 0x2a392, 0x05efe, 0x22331, 0x22331, 0x08201,
+	0x05f22, 0x05f22, 0x038c7, 0x232b8, 0x261da, 0x05f62, 0x05f6b, 0x038e3,
+	0x05f9a, 0x05fcd, 0x05fd7, 0x05ff9, 0x06081, 0x0393a, 0x0391c, 0x06094,
+	0x226d4, 0x060c7, 0x06148, 0x0614c, 0x0614e, 0x0614c, 0x0617a, 0x0618e,
+	0x061b2, 0x061a4, 0x061af, 0x061de, 0x061f2, 0x061f6, 0x06210, 0x0621b,
+	0x0625d, 0x062b1, 0x062d4, 0x06350, 0x22b0c, 0x0633d, 0x062fc, 0x06368,
+	0x06383, 0x063e4, 0x22bf1, 0x06422, 0x063c5, 0x063a9, 0x03a2e, 0x06469,
+	0x0647e, 0x0649d, 0x06477, 0x03a6c, 0x0654f, 0x0656c, 0x2300a, 0x065e3,
+	0x066f8, 0x06649, 0x03b19, 0x06691, 0x03b08, 0x03ae4, 0x05192, 0x05195,
+	0x06700, 0x0669c, 0x080ad, 0x043d9, 0x06717, 0x0671b, 0x06721, 0x0675e,
+	0x06753, 0x233c3, 0x03b49, 0x067fa, 0x06785, 0x06852, 0x06885, 0x2346d,
+	0x0688e, 0x0681f, 0x06914, 0x03b9d, 0x06942, 0x069a3, 0x069ea, 0x06aa8,
+	0x236a3, 0x06adb, 0x03c18, 0x06b21, 0x238a7, 0x06b54, 0x03c4e, 0x06b72,
+	0x06b9f, 0x06bba, 0x06bbb, 0x23a8d, 0x21d0b, 0x23afa, 0x06c4e, 0x23cbc,
+	0x06cbf, 0x06ccd, 0x06c67, 0x06d16, 0x06d3e, 0x06d77, 0x06d41, 0x06d69,
+	0x06d78, 0x06d85, 0x23d1e, 0x06d34, 0x06e2f, 0x06e6e, 0x03d33, 0x06ecb,
+	0x06ec7, 0x23ed1, 0x06df9, 0x06f6e, 0x23f5e, 0x23f8e, 0x06fc6, 0x07039,
+	0x0701e, 0x0701b, 0x03d96, 0x0704a, 0x0707d, 0x07077, 0x070ad, 0x20525,
+	0x07145, 0x24263, 0x0719c, 0x243ab, 0x07228, 0x07235, 0x07250, 0x24608,
+	0x07280, 0x07295, 0x24735, 0x24814, 0x0737a, 0x0738b, 0x03eac, 0x073a5,
+	0x03eb8, 0x03eb8, 0x07447, 0x0745c, 0x07471, 0x07485, 0x074ca, 0x03f1b,
+	0x07524, 0x24c36, 0x0753e, 0x24c92, 0x07570, 0x2219f, 0x07610, 0x24fa1,
+	0x24fb8, 0x25044, 0x03ffc, 0x04008, 0x076f4, 0x250f3, 0x250f2, 0x25119,
+	0x25133, 0x0771e, 0x0771f, 0x0771f, 0x0774a, 0x04039, 0x0778b, 0x04046,
+	0x04096, 0x2541d, 0x0784e, 0x0788c, 0x078cc, 0x040e3, 0x25626, 0x07956,
+	0x2569a, 0x256c5, 0x0798f, 0x079eb, 0x0412f, 0x07a40, 0x07a4a, 0x07a4f,
+	0x2597c, 0x25aa7, 0x25aa7, 0x07aee, 0x04202, 0x25bab, 0x07bc6, 0x07bc9,
+	0x04227, 0x25c80, 0x07cd2, 0x042a0, 0x07ce8, 0x07ce3, 0x07d00, 0x25f86,
+	0x07d63, 0x04301, 0x07dc7, 0x07e02, 0x07e45, 0x04334, 0x26228, 0x26247,
+	0x04359, 0x262d9, 0x07f7a, 0x2633e, 0x07f95, 0x07ffa, 0x08005, 0x264da,
+	0x26523, 0x08060, 0x265a8, 0x08070, 0x2335f, 0x043d5, 0x080b2, 0x08103,
+	0x0440b, 0x0813e, 0x05ab5, 0x267a7, 0x267b5, 0x23393, 0x2339c, 0x08201,
+	0x08204, 0x08f9e, 0x0446b, 0x08291, 0x0828b, 0x0829d, 0x052b3, 0x082b1,
+	0x082b3, 0x082bd, 0x082e6, 0x26b3c, 0x082e5, 0x0831d, 0x08363, 0x083ad,
+	0x08323, 0x083bd, 0x083e7, 0x08457, 0x08353, 0x083ca, 0x083cc, 0x083dc,
+	0x26c36, 0x26d6b, 0x26cd5, 0x0452b, 0x084f1, 0x084f3, 0x08516, 0x273ca,
+	0x08564, 0x26f2c, 0x0455d, 0x04561, 0x26fb1, 0x270d2, 0x0456b, 0x08650,
+	0x0865c, 0x08667, 0x08669, 0x086a9, 0x08688, 0x0870e, 0x086e2, 0x08779,
+	0x08728, 0x0876b, 0x08786, 0x045d7, 0x087e1, 0x08801, 0x045f9, 0x08860,
+	0x08863, 0x27667, 0x088d7, 0x088de, 0x04635, 0x088fa, 0x034bb, 0x278ae,
+	0x27966, 0x046be, 0x046c7, 0x08aa0, 0x08aed, 0x08b8a, 0x08c55, 0x27ca8,
+	0x08cab, 0x08cc1, 0x08d1b, 0x08d77, 0x27f2f, 0x20804, 0x08dcb, 0x08dbc,
+	0x08df0, 0x208de, 0x08ed4, 0x08f38, 0x285d2, 0x285ed, 0x09094, 0x090f1,
+	0x09111, 0x2872e, 0x0911b, 0x09238, 0x092d7, 0x092d8, 0x0927c, 0x093f9,
+	0x09415, 0x28bfa, 0x0958b, 0x04995, 0x095b7, 0x28d77, 0x049e6, 0x096c3,
+	0x05db2, 0x09723, 0x29145, 0x2921a, 0x04a6e, 0x04a76, 0x097e0, 0x2940a,
+	0x04ab2, 0x29496, 0x0980b, 0x0980b, 0x09829, 0x295b6, 0x098e2, 0x04b33,
+	0x09929, 0x099a7, 0x099c2, 0x099fe, 0x04bce, 0x29b30, 0x09b12, 0x09c40,
+	0x09cfd, 0x04cce, 0x04ced, 0x09d67, 0x2a0ce, 0x04cf8, 0x2a105, 0x2a20e,
+	0x2a291, 0x09ebb, 0x04d56, 0x09ef9, 0x09efe, 0x09f05, 0x09f0f, 0x09f16,
+	0x09f3b, 0x2a600,
+}
+
+var compatDecompositionKeys = [...]rune{
+	0x000a0, 0x000a8, 0x000aa, 0x000af, 0x000b2, 0x000b3, 0x000b4, 0x000b5,
+	0x000b8, 0x000b9, 0x000ba, 0x000bc, 0x000bd, 0x000be, 0x00132, 0x00133,
+	0x0013f, 0x00140, 0x00149, 0x0017f, 0x001c4, 0x001c5, 0x001c6, 0x001c7,
+	0x001c8, 0x001c9, 0x001ca, 0x001cb, 0x001cc, 0x001f1, 0x001f2, 0x001f3,
+	0x002b0, 0x002b1, 0x002b2, 0x002b3, 0x002b4, 0x002b5, 0x002b6, 0x002b7,
+	0x002b8, 0x002d8, 0x002d9, 0x002da, 0x002db, 0x002dc, 0x002dd, 0x002e0,
+	0x002e1, 0x002e2, 0x002e3, 0x002e4, 0x0037a, 0x00384, 0x00385, 0x003d0,
+	0x003d1, 0x003d2, 0x003d3, 0x003d4, 0x003d5, 0x003d6, 0x003f0, 0x003f1,
+	0x003f2, 0x003f4, 0x003f5, 0x003f9, 0x00587, 0x00675, 0x00676, 0x00677,
+	0x00678, 0x00e33, 0x00eb3, 0x00edc, 0x00edd, 0x00f0c, 0x00f77, 0x00f79,
+	0x010fc, 0x01d2c, 0x01d2d, 0x01d2e, 0x01d30, 0x01d31, 0x01d32, 0x01d33,
+	0x01d34, 0x01d35, 0x01d36, 0x01d37, 0x01d38, 0x01d39, 0x01d3a, 0x01d3c,
+	0x01d3d, 0x01d3e, 0x01d3f, 0x01d40, 0x01d41, 0x01d42, 0x01d43, 0x01d44,
+	0x01d45, 0x01d46, 0x01d47, 0x01d48, 0x01d49, 0x01d4a, 0x01d4b, 0x01d4c,
+	0x01d4d, 0x01d4f, 0x01d50, 0x01d51, 0x01d52, 0x01d53, 0x01d54, 0x01d55,
+	0x01d56, 0x01d57, 0x01d58, 0x01d59, 0x01d5a, 0x01d5b, 0x01d5c, 0x01d5d,
+	0x01d5e, 0x01d5f, 0x01d60, 0x01d61, 0x01d62, 0x01d63, 0x01d64, 0x01d65,
+	0x01d66, 0x01d67, 0x01d68, 0x01d69, 0x01d6a, 0x01d78, 0x01d9b, 0x01d9c,
+	0x01d9d, 0x01d9e, 0x01d9f, 0x01da0, 0x01da1, 0x01da2, 0x01da3, 0x01da4,
+	0x01da5, 0x01da6, 0x01da7, 0x01da8, 0x01da9, 0x01daa, 0x01dab, 0x01dac,
+	0x01dad, 0x01dae, 0x01daf, 0x01db0, 0x01db1, 0x01db2, 0x01db3, 0x01db4,
+	0x01db5, 0x01db6, 0x01db7, 0x01db8, 0x01db9, 0x01dba, 0x01dbb, 0x01dbc,
+	0x01dbd, 0x01dbe, 0x01dbf, 0x01e9a, 0x01e9b, 0x01fbd, 0x01fbf, 0x01fc0,
+	0x01fc1, 0x01fcd, 0x01fce, 0x01fcf, 0x01fdd, 0x01fde, 0x01fdf, 0x01fed,
+	0x01fee, 0x01ffd, 0x01ffe, 0x02000, 0x02001, 0x02002, 0x02003, 0x02004,
+	0x02005, 0x02006, 0x02007, 0x02008, 0x02009, 0x0200a, 0x02011, 0x02017,
+	0x02024, 0x02025, 0x02026, 0x0202f, 0x02033, 0x02034, 0x02036, 0x02037,
+	0x0203c, 0x0203e, 0x02047, 0x02048, 0x02049, 0x02057, 0x0205f, 0x02070,
+	0x02071, 0x02074, 0x02075, 0x02076, 0x02077, 0x02078, 0x02079, 0x0207a,
+	0x0207b, 0x0207c, 0x0207d, 0x0207e, 0x0207f, 0x02080, 0x02081, 0x02082,
+	0x02083, 0x02084, 0x02085, 0x02086, 0x02087, 0x02088, 0x02089, 0x0208a,
+	0x0208b, 0x0208c, 0x0208d, 0x0208e, 0x02090, 0x02091, 0x02092, 0x02093,
+	0x02094, 0x02095, 0x02096, 0x02097, 0x02098, 0x02099, 0x0209a, 0x0209b,
+	0x0209c, 0x020a8, 0x02100, 0x02101, 0x02102, 0x02103, 0x02105, 0x02106,
+	0x02107, 0x02109, 0x0210a, 0x0210b, 0x0210c, 0x0210d, 0x0210e, 0x0210f,
+	0x02110, 0x02111, 0x02112, 0x02113, 0x02115, 0x02116, 0x02119, 0x0211a,
+	0x0211b, 0x0211c, 0x0211d, 0x02120, 0x02121, 0x02122, 0x02124, 0x02128,
+	0x0212c, 0x0212d, 0x0212f, 0x02130, 0x02131, 0x02133, 0x02134, 0x02135,
+	0x02136, 0x02137, 0x02138, 0x02139, 0x0213b, 0x0213c, 0x0213d, 0x0213e,
+	0x0213f, 0x02140, 0x02145, 0x02146, 0x02147, 0x02148, 0x02149, 0x02150,
+	0x02151, 0x02152, 0x02153, 0x02154, 0x02155, 0x02156, 0x02157, 0x02158,
+	0x02159, 0x0215a, 0x0215b, 0x0215c, 0x0215d, 0x0215e, 0x0215f, 0x02160,
+	0x02161, 0x02162, 0x02163, 0x02164, 0x02165, 0x02166, 0x02167, 0x02168,
+	0x02169, 0x0216a, 0x0216b, 0x0216c, 0x0216d, 0x0216e, 0x0216f, 0x02170,
+	0x02171, 0x02172, 0x02173, 0x02174, 0x02175, 0x02176, 0x02177, 0x02178,
+	0x02179, 0x0217a, 0x0217b, 0x0217c, 0x0217d, 0x0217e, 0x0217f, 0x02189,
+	0x0222c, 0x0222d, 0x0222f, 0x02230, 0x02460, 0x02461, 0x02462, 0x02463,
+	0x02464, 0x02465, 0x02466, 0x02467, 0x02468, 0x02469, 0x0246a, 0x0246b,
+	0x0246c, 0x0246d, 0x0246e, 0x0246f, 0x02470, 0x02471, 0x02472, 0x02473,
+	0x02474, 0x02475, 0x02476, 0x02477, 0x02478, 0x02479, 0x0247a, 0x0247b,
+	0x0247c, 0x0247d, 0x0247e, 0x0247f, 0x02480, 0x02481, 0x02482, 0x02483,
+	0x02484, 0x02485, 0x02486, 0x02487, 0x02488, 0x02489, 0x0248a, 0x0248b,
+	0x0248c, 0x0248d, 0x0248e, 0x0248f, 0x02490, 0x02491, 0x02492, 0x02493,
+	0x02494, 0x02495, 0x02496, 0x02497, 0x02498, 0x02499, 0x0249a, 0x0249b,
+	0x0249c, 0x0249d, 0x0249e, 0x0249f, 0x024a0, 0x024a1, 0x024a2, 0x024a3,
+	0x024a4, 0x024a5, 0x024a6, 0x024a7, 0x024a8, 0x024a9, 0x024aa, 0x024ab,
+	0x024ac, 0x024ad, 0x024ae, 0x024af, 0x024b0, 0x024b1, 0x024b2, 0x024b3,
+	0x024b4, 0x024b5, 0x024b6, 0x024b7, 0x024b8, 0x024b9, 0x024ba, 0x024bb,
+	0x024bc, 0x024bd, 0x024be, 0x024bf, 0x024c0, 0x024c1, 0x024c2, 0x024c3,
+	0x024c4, 0x024c5, 0x024c6, 0x024c7, 0x024c8, 0x024c9, 0x024ca, 0x024cb,
+	0x024cc, 0x024cd, 0x024ce, 0x024cf, 0x024d0, 0x024d1, 0x024d2, 0x024d3,
+	0x024d4, 0x024d5, 0x024d6, 0x024d7, 0x024d8, 0x024d9, 0x024da, 0x024db,
+	0x024dc, 0x024dd, 0x024de, 0x024df, 0x024e0, 0x024e1, 0x024e2, 0x024e3,
+	0x024e4, 0x024e5, 0x024e6, 0x024e7, 0x024e8, 0x024e9, 0x024ea, 0x02a0c,
+	0x02a74, 0x02a75, 0x02a76, 0x02c7c, 0x02c7d, 0x02d6f, 0x02e9f, 0x02ef3,
+	0x02f00, 0x02f01, 0x02f02, 0x02f03, 0x02f04, 0x02f05, 0x02f06, 0x02f07,
+	0x02f08, 0x02f09, 0x02f0a, 0x02f0b, 0x02f0c, 0x02f0d, 0x02f0e, 0x02f0f,
+	0x02f10, 0x02f11, 0x02f12, 0x02f13, 0x02f14, 0x02f15, 0x02f16, 0x02f17,
+	0x02f18, 0x02f19, 0x02f1a, 0x02f1b, 0x02f1c, 0x02f1d, 0x02f1e, 0x02f1f,
+	0x02f20, 0x02f21, 0x02f22, 0x02f23, 0x02f24, 0x02f25, 0x02f26, 0x02f27,
+	0x02f28, 0x02f29, 0x02f2a, 0x02f2b, 0x02f2c, 0x02f2d, 0x02f2e, 0x02f2f,
+	0x02f30, 0x02f31, 0x02f32, 0x02f33, 0x02f34, 0x02f35, 0x02f36, 0x02f37,
+	0x02f38, 0x02f39, 0x02f3a, 0x02f3b, 0x02f3c, 0x02f3d, 0x02f3e, 0x02f3f,
+	0x02f40, 0x02f41, 0x02f42, 0x02f43, 0x02f44, 0x02f45, 0x02f46, 0x02f47,
+	0x02f48, 0x02f49, 0x02f4a, 0x02f4b, 0x02f4c, 0x02f4d, 0x02f4e, 0x02f4f,
+	0x02f50, 0x02f51, 0x02f52, 0x02f53, 0x02f54, 0x02f55, 0x02f56, 0x02f57,
+	0x02f58, 0x02f59, 0x02f5a, 0x02f5b, 0x02f5c, 0x02f5d, 0x02f5e, 0x02f5f,
+	0x02f60, 0x02f61, 0x02f62, 0x02f63, 0x02f64, 0x02f65, 0x02f66, 0x02f67,
+	0x02f68, 0x02f69, 0x02f6a, 0x02f6b, 0x02f6c, 0x02f6d, 0x02f6e, 0x02f6f,
+	0x02f70, 0x02f71, 0x02f72, 0x02f73, 0x02f74, 0x02f75, 0x02f76, 0x02f77,
+	0x02f78, 0x02f79, 0x02f7a, 0x02f7b, 0x02f7c, 0x02f7d, 0x02f7e, 0x02f7f,
+	0x02f80, 0x02f81, 0x02f82, 0x02f83, 0x02f84, 0x02f85, 0x02f86, 0x02f87,
+	0x02f88, 0x02f89, 0x02f8a, 0x02f8b, 0x02f8c, 0x02f8d, 0x02f8e, 0x02f8f,
+	0x02f90, 0x02f91, 0x02f92, 0x02f93, 0x02f94, 0x02f95, 0x02f96, 0x02f97,
+	0x02f98, 0x02f99, 0x02f9a, 0x02f9b, 0x02f9c, 0x02f9d, 0x02f9e, 0x02f9f,
+	0x02fa0, 0x02fa1, 0x02fa2, 0x02fa3, 0x02fa4, 0x02fa5, 0x02fa6, 0x02fa7,
+	0x02fa8, 0x02fa9, 0x02faa, 0x02fab, 0x02fac, 0x02fad, 0x02fae, 0x02faf,
+	0x02fb0, 0x02fb1, 0x02fb2, 0x02fb3, 0x02fb4, 0x02fb5, 0x02fb6, 0x02fb7,
+	0x02fb8, 0x02fb9, 0x02fba, 0x02fbb, 0x02fbc, 0x02fbd, 0x02fbe, 0x02fbf,
+	0x02fc0, 0x02fc1, 0x02fc2, 0x02fc3, 0x02fc4, 0x02fc5, 0x02fc6, 0x02fc7,
+	0x02fc8, 0x02fc9, 0x02fca, 0x02fcb, 0x02fcc, 0x02fcd, 0x02fce, 0x02fcf,
+	0x02fd0, 0x02fd1, 0x02fd2, 0x02fd3, 0x02fd4, 0x02fd5, 0x03000, 0x03036,
+	0x03038, 0x03039, 0x0303a, 0x0309b, 0x0309c, 0x0309f, 0x030ff, 0x03131,
+	0x03132, 0x03133, 0x03134, 0x03135, 0x03136, 0x03137, 0x03138, 0x03139,
+	0x0313a, 0x0313b, 0x0313c, 0x0313d, 0x0313e, 0x0313f, 0x03140, 0x03141,
+	0x03142, 0x03143, 0x03144, 0x03145, 0x03146, 0x03147, 0x03148, 0x03149,
+	0x0314a, 0x0314b, 0x0314c, 0x0314d, 0x0314e, 0x0314f, 0x03150, 0x03151,
+	0x03152, 0x03153, 0x03154, 0x03155, 0x03156, 0x03157, 0x03158, 0x03159,
+	0x0315a, 0x0315b, 0x0315c, 0x0315d, 0x0315e, 0x0315f, 0x03160, 0x03161,
+	0x03162, 0x03163, 0x03164, 0x03165, 0x03166, 0x03167, 0x03168, 0x03169,
+	0x0316a, 0x0316b, 0x0316c, 0x0316d, 0x0316e, 0x0316f, 0x03170, 0x03171,
+	0x03172, 0x03173, 0x03174, 0x03175, 0x03176, 0x03177, 0x03178, 0x03179,
+	0x0317a, 0x0317b, 0x0317c, 0x0317d, 0x0317e, 0x0317f, 0x03180, 0x03181,
+	0x03182, 0x03183, 0x03184, 0x03185, 0x03186, 0x03187, 0x03188, 0x03189,
+	0x0318a, 0x0318b, 0x0318c, 0x0318d, 0x0318e, 0x03192, 0x03193, 0x03194,
+	0x03195, 0x03196, 0x03197, 0x03198, 0x03199, 0x0319a, 0x0319b, 0x0319c,
+	0x0319d, 0x0319e, 0x0319f, 0x03200, 0x03201, 0x03202, 0x03203, 0x03204,
+	0x03205, 0x03206, 0x03207, 0x03208, 0x03209, 0x0320a, 0x0320b, 0x0320c,
+	0x0320d, 0x0320e, 0x0320f, 0x03210, 0x03211, 0x03212, 0x03213, 0x03214,
+	0x03215, 0x03216, 0x03217, 0x03218, 0x03219, 0x0321a, 0x0321b, 0x0321c,
+	0x0321d, 0x0321e, 0x03220, 0x03221, 0x03222, 0x03223, 0x03224, 0x03225,
+	0x03226, 0x03227, 0x03228, 0x03229, 0x0322a, 0x0322b, 0x0322c, 0x0322d,
+	0x0322e, 0x0322f, 0x03230, 0x03231, 0x03232, 0x03233, 0x03234, 0x03235,
+	0x03236, 0x03237, 0x03238, 0x03239, 0x0323a, 0x0323b, 0x0323c, 0x0323d,
+	0x0323e, 0x0323f, 0x03240, 0x03241, 0x03242, 0x03243, 0x03244, 0x03245,
+	0x03246, 0x03247, 0x03250, 0x03251, 0x03252, 0x03253, 0x03254, 0x03255,
+	0x03256, 0x03257, 0x03258, 0x03259, 0x0325a, 0x0325b, 0x0325c, 0x0325d,
+	0x0325e, 0x0325f, 0x03260, 0x03261, 0x03262, 0x03263, 0x03264, 0x03265,
+	0x03266, 0x03267, 0x03268, 0x03269, 0x0326a, 0x0326b, 0x0326c, 0x0326d,
+	0x0326e, 0x0326f, 0x03270, 0x03271, 0x03272, 0x03273, 0x03274, 0x03275,
+	0x03276, 0x03277, 0x03278, 0x03279, 0x0327a, 0x0327b, 0x0327c, 0x0327d,
+	0x0327e, 0x03280, 0x03281, 0x03282, 0x03283, 0x03284, 0x03285, 0x03286,
+	0x03287, 0x03288, 0x03289, 0x0328a, 0x0328b, 0x0328c, 0x0328d, 0x0328e,
+	0x0328f, 0x03290, 0x03291, 0x03292, 0x03293, 0x03294, 0x03295, 0x03296,
+	0x03297, 0x03298, 0x03299, 0x0329a, 0x0329b, 0x0329c, 0x0329d, 0x0329e,
+	0x0329f, 0x032a0, 0x032a1, 0x032a2, 0x032a3, 0x032a4, 0x032a5, 0x032a6,
+	0x032a7, 0x032a8, 0x032a9, 0x032aa, 0x032ab, 0x032ac, 0x032ad, 0x032ae,
+	0x032af, 0x032b0, 0x032b1, 0x032b2, 0x032b3, 0x032b4, 0x032b5, 0x032b6,
+	0x032b7, 0x032b8, 0x032b9, 0x032ba, 0x032bb, 0x032bc, 0x032bd, 0x032be,
+	0x032bf, 0x032c0, 0x032c1, 0x032c2, 0x032c3, 0x032c4, 0x032c5, 0x032c6,
+	0x032c7, 0x032c8, 0x032c9, 0x032ca, 0x032cb, 0x032cc, 0x032cd, 0x032ce,
+	0x032cf, 0x032d0, 0x032d1, 0x032d2, 0x032d3, 0x032d4, 0x032d5, 0x032d6,
+	0x032d7, 0x032d8, 0x032d9, 0x032da, 0x032db, 0x032dc, 0x032dd, 0x032de,
+	0x032df, 0x032e0, 0x032e1, 0x032e2, 0x032e3, 0x032e4, 0x032e5, 0x032e6,
+	0x032e7, 0x032e8, 0x032e9, 0x032ea, 0x032eb, 0x032ec, 0x032ed, 0x032ee,
+	0x032ef, 0x032f0, 0x032f1, 0x032f2, 0x032f3, 0x032f4, 0x032f5, 0x032f6,
+	0x032f7, 0x032f8, 0x032f9, 0x032fa, 0x032fb, 0x032fc, 0x032fd, 0x032fe,
+	0x032ff, 0x03300, 0x03301, 0x03302, 0x03303, 0x03304, 0x03305, 0x03306,
+	0x03307, 0x03308, 0x03309, 0x0330a, 0x0330b, 0x0330c, 0x0330d, 0x0330e,
+	0x0330f, 0x03310, 0x03311, 0x03312, 0x03313, 0x03314, 0x03315, 0x03316,
+	0x03317, 0x03318, 0x03319, 0x0331a, 0x0331b, 0x0331c, 0x0331d, 0x0331e,
+	0x0331f, 0x03320, 0x03321, 0x03322, 0x03323, 0x03324, 0x03325, 0x03326,
+	0x03327, 0x03328, 0x03329, 0x0332a, 0x0332b, 0x0332c, 0x0332d, 0x0332e,
+	0x0332f, 0x03330, 0x03331, 0x03332, 0x03333, 0x03334, 0x03335, 0x03336,
+	0x03337, 0x03338, 0x03339, 0x0333a, 0x0333b, 0x0333c, 0x0333d, 0x0333e,
+	0x0333f, 0x03340, 0x03341, 0x03342, 0x03343, 0x03344, 0x03345, 0x03346,
+	0x03347, 0x03348, 0x03349, 0x0334a, 0x0334b, 0x0334c, 0x0334d, 0x0334e,
+	0x0334f, 0x03350, 0x03351, 0x03352, 0x03353, 0x03354, 0x03355, 0x03356,
+	0x03357, 0x03358, 0x03359, 0x0335a, 0x0335b, 0x0335c, 0x0335d, 0x0335e,
+	0x0335f, 0x03360, 0x03361, 0x03362, 0x03363, 0x03364, 0x03365, 0x03366,
+	0x03367, 0x03368, 0x03369, 0x0336a, 0x0336b, 0x0336c, 0x0336d, 0x0336e,
+	0x0336f, 0x03370, 0x03371, 0x03372, 0x03373, 0x03374, 0x03375, 0x03376,
+	0x03377, 0x03378, 0x03379, 0x0337a, 0x0337b, 0x0337c, 0x0337d, 0x0337e,
+	0x0337f, 0x03380, 0x03381, 0x03382, 0x03383, 0x03384, 0x03385, 0x03386,
+	0x03387, 0x03388, 0x03389, 0x0338a, 0x0338b, 0x0338c, 0x0338d, 0x0338e,
+	0x0338f, 0x03390, 0x03391, 0x03392, 0x03393, 0x03394, 0x03395, 0x03396,
+	0x03397, 0x03398, 0x03399, 0x0339a, 0x0339b, 0x0339c, 0x0339d, 0x0339e,
+	0x0339f, 0x033a0, 0x033a1, 0x033a2, 0x033a3, 0x033a4, 0x033a5, 0x033a6,
+	0x033a7, 0x033a8, 0x033a9, 0x033aa, 0x033ab, 0x033ac, 0x033ad, 0x033ae,
+	0x033af, 0x033b0, 0x033b1, 0x033b2, 0x033b3, 0x033b4, 0x033b5, 0x033b6,
+	0x033b7, 0x033b8, 0x033b9, 0x033ba, 0x033bb, 0x033bc, 0x033bd, 0x033be,
+	0x033bf, 0x033c0, 0x033c1, 0x033c2, 0x033c3, 0x033c4, 0x033c5, 0x033c6,
+	0x033c7, 0x033c8, 0x033c9, 0x033ca, 0x033cb, 0x033cc, 0x033cd, 0x033ce,
+	0x033cf, 0x033d0, 0x033d1, 0x033d2, 0x033d3, 0x033d4, 0x033d5, 0x033d6,
+	0x033d7, 0x033d8, 0x033d9, 0x033da, 0x033db, 0x033dc, 0x033dd, 0x033de,
+	0x033df, 0x033e0, 0x033e1, 0x033e2, 0x033e3, 0x033e4, 0x033e5, 0x033e6,
+	0x033e7, 0x033e8, 0x033e9, 0x033ea, 0x033eb, 0x033ec, 0x033ed, 0x033ee,
+	0x033ef, 0x033f0, 0x033f1, 0x033f2, 0x033f3, 0x033f4, 0x033f5, 0x033f6,
+	0x033f7, 0x033f8, 0x033f9, 0x033fa, 0x033fb, 0x033fc, 0x033fd, 0x033fe,
+	0x033ff, 0x0a69c, 0x0a69d, 0x0a770, 0x0a7f1, 0x0a7f2, 0x0a7f3, 0x0a7f4,
+	0x0a7f8, 0x0a7f9, 0x0ab5c, 0x0ab5d, 0x0ab5e, 0x0ab5f, 0x0ab69, 0x0fb00,
+	0x0fb01, 0x0fb02, 0x0fb03, 0x0fb04, 0x0fb05, 0x0fb06, 0x0fb13, 0x0fb14,
+	0x0fb15, 0x0fb16, 0x0fb17, 0x0fb20, 0x0fb21, 0x0fb22, 0x0fb23, 0x0fb24,
+	0x0fb25, 0x0fb26, 0x0fb27, 0x0fb28, 0x0fb29, 0x0fb4f, 0x0fb50, 0x0fb51,
+	0x0fb52, 0x0fb53, 0x0fb54, 0x0fb55, 0x0fb56, 0x0fb57, 0x0fb58, 0x0fb59,
+	0x0fb5a, 0x0fb5b, 0x0fb5c, 0x0fb5d, 0x0fb5e, 0x0fb5f, 0x0fb60, 0x0fb61,
+	0x0fb62, 0x0fb63, 0x0fb64, 0x0fb65, 0x0fb66, 0x0fb67, 0x0fb68, 0x0fb69,
+	0x0fb6a, 0x0fb6b, 0x0fb6c, 0x0fb6d, 0x0fb6e, 0x0fb6f, 0x0fb70, 0x0fb71,
+	0x0fb72, 0x0fb73, 0x0fb74, 0x0fb75, 0x0fb76, 0x0fb77, 0x0fb78, 0x0fb79,
+	0x0fb7a, 0x0fb7b, 0x0fb7c, 0x0fb7d, 0x0fb7e, 0x0fb7f, 0x0fb80, 0x0fb81,
+	0x0fb82, 0x0fb83, 0x0fb84, 0x0fb85, 0x0fb86, 0x0fb87, 0x0fb88, 0x0fb89,
+	0x0fb8a, 0x0fb8b, 0x0fb8c, 0x0fb8d, 0x0fb8e, 0x0fb8f, 0x0fb90, 0x0fb91,
+	0x0fb92, 0x0fb93, 0x0fb94, 0x0fb95, 0x0fb96, 0x0fb97, 0x0fb98, 0x0fb99,
+	0x0fb9a, 0x0fb9b, 0x0fb9c, 0x0fb9d, 0x0fb9e, 0x0fb9f, 0x0fba0, 0x0fba1,
+	0x0fba2, 0x0fba3, 0x0fba4, 0x0fba5, 0x0fba6, 0x0fba7, 0x0fba8, 0x0fba9,
+	0x0fbaa, 0x0fbab, 0x0fbac, 0x0fbad, 0x0fbae, 0x0fbaf, 0x0fbb0, 0x0fbb1,
+	0x0fbd3, 0x0fbd4, 0x0fbd5, 0x0fbd6, 0x0fbd7, 0x0fbd8, 0x0fbd9, 0x0fbda,
+	0x0fbdb, 0x0fbdc, 0x0fbdd, 0x0fbde, 0x0fbdf, 0x0fbe0, 0x0fbe1, 0x0fbe2,
+	0x0fbe3, 0x0fbe4, 0x0fbe5, 0x0fbe6, 0x0fbe7, 0x0fbe8, 0x0fbe9, 0x0fbea,
+	0x0fbeb, 0x0fbec, 0x0fbed, 0x0fbee, 0x0fbef, 0x0fbf0, 0x0fbf1, 0x0fbf2,
+	0x0fbf3, 0x0fbf4, 0x0fbf5, 0x0fbf6, 0x0fbf7, 0x0fbf8, 0x0fbf9, 0x0fbfa,
+	0x0fbfb, 0x0fbfc, 0x0fbfd, 0x0fbfe, 0x0fbff, 0x0fc00, 0x0fc01, 0x0fc02,
+	0x0fc03, 0x0fc04, 0x0fc05, 0x0fc06, 0x0fc07, 0x0fc08, 0x0fc09, 0x0fc0a,
+	0x0fc0b, 0x0fc0c, 0x0fc0d, 0x0fc0e, 0x0fc0f, 0x0fc10, 0x0fc11, 0x0fc12,
+	0x0fc13, 0x0fc14, 0x0fc15, 0x0fc16, 0x0fc17, 0x0fc18, 0x0fc19, 0x0fc1a,
+	0x0fc1b, 0x0fc1c, 0x0fc1d, 0x0fc1e, 0x0fc1f, 0x0fc20, 0x0fc21, 0x0fc22,
+	0x0fc23, 0x0fc24, 0x0fc25, 0x0fc26, 0x0fc27, 0x0fc28, 0x0fc29, 0x0fc2a,
+	0x0fc2b, 0x0fc2c, 0x0fc2d, 0x0fc2e, 0x0fc2f, 0x0fc30, 0x0fc31, 0x0fc32,
+	0x0fc33, 0x0fc34, 0x0fc35, 0x0fc36, 0x0fc37, 0x0fc38, 0x0fc39, 0x0fc3a,
+	0x0fc3b, 0x0fc3c, 0x0fc3d, 0x0fc3e, 0x0fc3f, 0x0fc40, 0x0fc41, 0x0fc42,
+	0x0fc43, 0x0fc44, 0x0fc45, 0x0fc46, 0x0fc47, 0x0fc48, 0x0fc49, 0x0fc4a,
+	0x0fc4b, 0x0fc4c, 0x0fc4d, 0x0fc4e, 0x0fc4f, 0x0fc50, 0x0fc51, 0x0fc52,
+	0x0fc53, 0x0fc54, 0x0fc55, 0x0fc56, 0x0fc57, 0x0fc58, 0x0fc59, 0x0fc5a,
+	0x0fc5b, 0x0fc5c, 0x0fc5d, 0x0fc5e, 0x0fc5f, 0x0fc60, 0x0fc61, 0x0fc62,
+	0x0fc63, 0x0fc64, 0x0fc65, 0x0fc66, 0x0fc67, 0x0fc68, 0x0fc69, 0x0fc6a,
+	0x0fc6b, 0x0fc6c, 0x0fc6d, 0x0fc6e, 0x0fc6f, 0x0fc70, 0x0fc71, 0x0fc72,
+	0x0fc73, 0x0fc74, 0x0fc75, 0x0fc76, 0x0fc77, 0x0fc78, 0x0fc79, 0x0fc7a,
+	0x0fc7b, 0x0fc7c, 0x0fc7d, 0x0fc7e, 0x0fc7f, 0x0fc80, 0x0fc81, 0x0fc82,
+	0x0fc83, 0x0fc84, 0x0fc85, 0x0fc86, 0x0fc87, 0x0fc88, 0x0fc89, 0x0fc8a,
+	0x0fc8b, 0x0fc8c, 0x0fc8d, 0x0fc8e, 0x0fc8f, 0x0fc90, 0x0fc91, 0x0fc92,
+	0x0fc93, 0x0fc94, 0x0fc95, 0x0fc96, 0x0fc97, 0x0fc98, 0x0fc99, 0x0fc9a,
+	0x0fc9b, 0x0fc9c, 0x0fc9d, 0x0fc9e, 0x0fc9f, 0x0fca0, 0x0fca1, 0x0fca2,
+	0x0fca3, 0x0fca4, 0x0fca5, 0x0fca6, 0x0fca7, 0x0fca8, 0x0fca9, 0x0fcaa,
+	0x0fcab, 0x0fcac, 0x0fcad, 0x0fcae, 0x0fcaf, 0x0fcb0, 0x0fcb1, 0x0fcb2,
+	0x0fcb3, 0x0fcb4, 0x0fcb5, 0x0fcb6, 0x0fcb7, 0x0fcb8, 0x0fcb9, 0x0fcba,
+	0x0fcbb, 0x0fcbc, 0x0fcbd, 0x0fcbe, 0x0fcbf, 0x0fcc0, 0x0fcc1, 0x0fcc2,
+	0x0fcc3, 0x0fcc4, 0x0fcc5, 0x0fcc6, 0x0fcc7, 0x0fcc8, 0x0fcc9, 0x0fcca,
+	0x0fccb, 0x0fccc, 0x0fccd, 0x0fcce, 0x0fccf, 0x0fcd0, 0x0fcd1, 0x0fcd2,
+	0x0fcd3, 0x0fcd4, 0x0fcd5, 0x0fcd6, 0x0fcd7, 0x0fcd8, 0x0fcd9, 0x0fcda,
+	0x0fcdb, 0x0fcdc, 0x0fcdd, 0x0fcde, 0x0fcdf, 0x0fce0, 0x0fce1, 0x0fce2,
+	0x0fce3, 0x0fce4, 0x0fce5, 0x0fce6, 0x0fce7, 0x0fce8, 0x0fce9, 0x0fcea,
+	0x0fceb, 0x0fcec, 0x0fced, 0x0fcee, 0x0fcef, 0x0fcf0, 0x0fcf1, 0x0fcf2,
+	0x0fcf3, 0x0fcf4, 0x0fcf5, 0x0fcf6, 0x0fcf7, 0x0fcf8, 0x0fcf9, 0x0fcfa,
+	0x0fcfb, 0x0fcfc, 0x0fcfd, 0x0fcfe, 0x0fcff, 0x0fd00, 0x0fd01, 0x0fd02,
+	0x0fd03, 0x0fd04, 0x0fd05, 0x0fd06, 0x0fd07, 0x0fd08, 0x0fd09, 0x0fd0a,
+	0x0fd0b, 0x0fd0c, 0x0fd0d, 0x0fd0e, 0x0fd0f, 0x0fd10, 0x0fd11, 0x0fd12,
+	0x0fd13, 0x0fd14, 0x0fd15, 0x0fd16, 0x0fd17, 0x0fd18, 0x0fd19, 0x0fd1a,
+	0x0fd1b, 0x0fd1c, 0x0fd1d, 0x0fd1e, 0x0fd1f, 0x0fd20, 0x0fd21, 0x0fd22,
+	0x0fd23, 0x0fd24, 0x0fd25, 0x0fd26, 0x0fd27, 0x0fd28, 0x0fd29, 0x0fd2a,
+	0x0fd2b, 0x0fd2c, 0x0fd2d, 0x0fd2e, 0x0fd2f, 0x0fd30, 0x0fd31, 0x0fd32,
+	0x0fd33, 0x0fd34, 0x0fd35, 0x0fd36, 0x0fd37, 0x0fd38, 0x0fd39, 0x0fd3a,
+	0x0fd3b, 0x0fd3c, 0x0fd3d, 0x0fd50, 0x0fd51, 0x0fd52, 0x0fd53, 0x0fd54,
+	0x0fd55, 0x0fd56, 0x0fd57, 0x0fd58, 0x0fd59, 0x0fd5a, 0x0fd5b, 0x0fd5c,
+	0x0fd5d, 0x0fd5e, 0x0fd5f, 0x0fd60, 0x0fd61, 0x0fd62, 0x0fd63, 0x0fd64,
+	0x0fd65, 0x0fd66, 0x0fd67, 0x0fd68, 0x0fd69, 0x0fd6a, 0x0fd6b, 0x0fd6c,
+	0x0fd6d, 0x0fd6e, 0x0fd6f, 0x0fd70, 0x0fd71, 0x0fd72, 0x0fd73, 0x0fd74,
+	0x0fd75, 0x0fd76, 0x0fd77, 0x0fd78, 0x0fd79, 0x0fd7a, 0x0fd7b, 0x0fd7c,
+	0x0fd7d, 0x0fd7e, 0x0fd7f, 0x0fd80, 0x0fd81, 0x0fd82, 0x0fd83, 0x0fd84,
+	0x0fd85, 0x0fd86, 0x0fd87, 0x0fd88, 0x0fd89, 0x0fd8a, 0x0fd8b, 0x0fd8c,
+	0x0fd8d, 0x0fd8e, 0x0fd8f, 0x0fd92, 0x0fd93, 0x0fd94, 0x0fd95, 0x0fd96,
+	0x0fd97, 0x0fd98, 0x0fd99, 0x0fd9a, 0x0fd9b, 0x0fd9c, 0x0fd9d, 0x0fd9e,
+	0x0fd9f, 0x0fda0, 0x0fda1, 0x0fda2, 0x0fda3, 0x0fda4, 0x0fda5, 0x0fda6,
+	0x0fda7, 0x0fda8, 0x0fda9, 0x0fdaa, 0x0fdab, 0x0fdac, 0x0fdad, 0x0fdae,
+	0x0fdaf, 0x0fdb0, 0x0fdb1, 0x0fdb2, 0x0fdb3, 0x0fdb4, 0x0fdb5, 0x0fdb6,
+	0x0fdb7, 0x0fdb8, 0x0fdb9, 0x0fdba, 0x0fdbb, 0x0fdbc, 0x0fdbd, 0x0fdbe,
+	0x0fdbf, 0x0fdc0, 0x0fdc1, 0x0fdc2, 0x0fdc3, 0x0fdc4, 0x0fdc5, 0x0fdc6,
+	0x0fdc7, 0x0fdf0, 0x0fdf1, 0x0fdf2, 0x0fdf3, 0x0fdf4, 0x0fdf5, 0x0fdf6,
+	0x0fdf7, 0x0fdf8, 0x0fdf9, 0x0fdfa, 0x0fdfb, 0x0fdfc, 0x0fe10, 0x0fe11,
+	0x0fe12, 0x0fe13, 0x0fe14, 0x0fe15, 0x0fe16, 0x0fe17, 0x0fe18, 0x0fe19,
+	0x0fe30, 0x0fe31, 0x0fe32, 0x0fe33, 0x0fe34, 0x0fe35, 0x0fe36, 0x0fe37,
+	0x0fe38, 0x0fe39, 0x0fe3a, 0x0fe3b, 0x0fe3c, 0x0fe3d, 0x0fe3e, 0x0fe3f,
+	0x0fe40, 0x0fe41, 0x0fe42, 0x0fe43, 0x0fe44, 0x0fe47, 0x0fe48, 0x0fe49,
+	0x0fe4a, 0x0fe4b, 0x0fe4c, 0x0fe4d, 0x0fe4e, 0x0fe4f, 0x0fe50, 0x0fe51,
+	0x0fe52, 0x0fe54, 0x0fe55, 0x0fe56, 0x0fe57, 0x0fe58, 0x0fe59, 0x0fe5a,
+	0x0fe5b, 0x0fe5c, 0x0fe5d, 0x0fe5e, 0x0fe5f, 0x0fe60, 0x0fe61, 0x0fe62,
+	0x0fe63, 0x0fe64, 0x0fe65, 0x0fe66, 0x0fe68, 0x0fe69, 0x0fe6a, 0x0fe6b,
+	0x0fe70, 0x0fe71, 0x0fe72, 0x0fe74, 0x0fe76, 0x0fe77, 0x0fe78, 0x0fe79,
+	0x0fe7a, 0x0fe7b, 0x0fe7c, 0x0fe7d, 0x0fe7e, 0x0fe7f, 0x0fe80, 0x0fe81,
+	0x0fe82, 0x0fe83, 0x0fe84, 0x0fe85, 0x0fe86, 0x0fe87, 0x0fe88, 0x0fe89,
+	0x0fe8a, 0x0fe8b, 0x0fe8c, 0x0fe8d, 0x0fe8e, 0x0fe8f, 0x0fe90, 0x0fe91,
+	0x0fe92, 0x0fe93, 0x0fe94, 0x0fe95, 0x0fe96, 0x0fe97, 0x0fe98, 0x0fe99,
+	0x0fe9a, 0x0fe9b, 0x0fe9c, 0x0fe9d, 0x0fe9e, 0x0fe9f, 0x0fea0, 0x0fea1,
+	0x0fea2, 0x0fea3, 0x0fea4, 0x0fea5, 0x0fea6, 0x0fea7, 0x0fea8, 0x0fea9,
+	0x0feaa, 0x0feab, 0x0feac, 0x0fead, 0x0feae, 0x0feaf, 0x0feb0, 0x0feb1,
+	0x0feb2, 0x0feb3, 0x0feb4, 0x0feb5, 0x0feb6, 0x0feb7, 0x0feb8, 0x0feb9,
+	0x0feba, 0x0febb, 0x0febc, 0x0febd, 0x0febe, 0x0febf, 0x0fec0, 0x0fec1,
+	0x0fec2, 0x0fec3, 0x0fec4, 0x0fec5, 0x0fec6, 0x0fec7, 0x0fec8, 0x0fec9,
+	0x0feca, 0x0fecb, 0x0fecc, 0x0fecd, 0x0fece, 0x0fecf, 0x0fed0, 0x0fed1,
+	0x0fed2, 0x0fed3, 0x0fed4, 0x0fed5, 0x0fed6, 0x0fed7, 0x0fed8, 0x0fed9,
+	0x0feda, 0x0fedb, 0x0fedc, 0x0fedd, 0x0fede, 0x0fedf, 0x0fee0, 0x0fee1,
+	0x0fee2, 0x0fee3, 0x0fee4, 0x0fee5, 0x0fee6, 0x0fee7, 0x0fee8, 0x0fee9,
+	0x0feea, 0x0feeb, 0x0feec, 0x0feed, 0x0feee, 0x0feef, 0x0fef0, 0x0fef1,
+	0x0fef2, 0x0fef3, 0x0fef4, 0x0fef5, 0x0fef6, 0x0fef7, 0x0fef8, 0x0fef9,
+	0x0fefa, 0x0fefb, 0x0fefc, 0x0ff01, 0x0ff02, 0x0ff03, 0x0ff04, 0x0ff05,
+	0x0ff06, 0x0ff07, 0x0ff08, 0x0ff09, 0x0ff0a, 0x0ff0b, 0x0ff0c, 0x0ff0d,
+	0x0ff0e, 0x0ff0f, 0x0ff10, 0x0ff11, 0x0ff12, 0x0ff13, 0x0ff14, 0x0ff15,
+	0x0ff16, 0x0ff17, 0x0ff18, 0x0ff19, 0x0ff1a, 0x0ff1b, 0x0ff1c, 0x0ff1d,
+	0x0ff1e, 0x0ff1f, 0x0ff20, 0x0ff21, 0x0ff22, 0x0ff23, 0x0ff24, 0x0ff25,
+	0x0ff26, 0x0ff27, 0x0ff28, 0x0ff29, 0x0ff2a, 0x0ff2b, 0x0ff2c, 0x0ff2d,
+	0x0ff2e, 0x0ff2f, 0x0ff30, 0x0ff31, 0x0ff32, 0x0ff33, 0x0ff34, 0x0ff35,
+	0x0ff36, 0x0ff37, 0x0ff38, 0x0ff39, 0x0ff3a, 0x0ff3b, 0x0ff3c, 0x0ff3d,
+	0x0ff3e, 0x0ff3f, 0x0ff40, 0x0ff41, 0x0ff42, 0x0ff43, 0x0ff44, 0x0ff45,
+	0x0ff46, 0x0ff47, 0x0ff48, 0x0ff49, 0x0ff4a, 0x0ff4b, 0x0ff4c, 0x0ff4d,
+	0x0ff4e, 0x0ff4f, 0x0ff50, 0x0ff51, 0x0ff52, 0x0ff53, 0x0ff54, 0x0ff55,
+	0x0ff56, 0x0ff57, 0x0ff58, 0x0ff59, 0x0ff5a, 0x0ff5b, 0x0ff5c, 0x0ff5d,
+	0x0ff5e, 0x0ff5f, 0x0ff60, 0x0ff61, 0x0ff62, 0x0ff63, 0x0ff64, 0x0ff65,
+	0x0ff66, 0x0ff67, 0x0ff68, 0x0ff69, 0x0ff6a, 0x0ff6b, 0x0ff6c, 0x0ff6d,
+	0x0ff6e, 0x0ff6f, 0x0ff70, 0x0ff71, 0x0ff72, 0x0ff73, 0x0ff74, 0x0ff75,
+	0x0ff76, 0x0ff77, 0x0ff78, 0x0ff79, 0x0ff7a, 0x0ff7b, 0x0ff7c, 0x0ff7d,
+	0x0ff7e, 0x0ff7f, 0x0ff80, 0x0ff81, 0x0ff82, 0x0ff83, 0x0ff84, 0x0ff85,
+	0x0ff86, 0x0ff87, 0x0ff88, 0x0ff89, 0x0ff8a, 0x0ff8b, 0x0ff8c, 0x0ff8d,
+	0x0ff8e, 0x0ff8f, 0x0ff90, 0x0ff91, 0x0ff92, 0x0ff93, 0x0ff94, 0x0ff95,
+	0x0ff96, 0x0ff97, 0x0ff98, 0x0ff99, 0x0ff9a, 0x0ff9b, 0x0ff9c, 0x0ff9d,
+	0x0ff9e, 0x0ff9f, 0x0ffa0, 0x0ffa1, 0x0ffa2, 0x0ffa3, 0x0ffa4, 0x0ffa5,
+	0x0ffa6, 0x0ffa7, 0x0ffa8, 0x0ffa9, 0x0ffaa, 0x0ffab, 0x0ffac, 0x0ffad,
+	0x0ffae, 0x0ffaf, 0x0ffb0, 0x0ffb1, 0x0ffb2, 0x0ffb3, 0x0ffb4, 0x0ffb5,
+	0x0ffb6, 0x0ffb7, 0x0ffb8, 0x0ffb9, 0x0ffba, 0x0ffbb, 0x0ffbc, 0x0ffbd,
+	0x0ffbe, 0x0ffc2, 0x0ffc3, 0x0ffc4, 0x0ffc5, 0x0ffc6, 0x0ffc7, 0x0ffca,
+	0x0ffcb, 0x0ffcc, 0x0ffcd, 0x0ffce, 0x0ffcf, 0x0ffd2, 0x0ffd3, 0x0ffd4,
+	0x0ffd5, 0x0ffd6, 0x0ffd7, 0x0ffda, 0x0ffdb, 0x0ffdc, 0x0ffe0, 0x0ffe1,
+	0x0ffe2, 0x0ffe3, 0x0ffe4, 0x0ffe5, 0x0ffe6, 0x0ffe8, 0x0ffe9, 0x0ffea,
+	0x0ffeb, 0x0ffec, 0x0ffed, 0x0ffee, 0x10781, 0x10782, 0x10783, 0x10784,
+	0x10785, 0x10787, 0x10788, 0x10789, 0x1078a, 0x1078b, 0x1078c, 0x1078d,
+	0x1078e, 0x1078f, 0x10790, 0x10791, 0x10792, 0x10793, 0x10794, 0x10795,
+	0x10796, 0x10797, 0x10798, 0x10799, 0x1079a, 0x1079b, 0x1079c, 0x1079d,
+	0x1079e, 0x1079f, 0x107a0, 0x107a1, 0x107a2, 0x107a3, 0x107a4, 0x107a5,
+	0x107a6, 0x107a7, 0x107a8, 0x107a9, 0x107aa, 0x107ab, 0x107ac, 0x107ad,
+	0x107ae, 0x107af, 0x107b0, 0x107b2, 0x107b3, 0x107b4, 0x107b5, 0x107b6,
+	0x107b7, 0x107b8, 0x107b9, 0x107ba, 0x1ccd6, 0x1ccd7, 0x1ccd8, 0x1ccd9,
+	0x1ccda, 0x1ccdb, 0x1ccdc, 0x1ccdd, 0x1ccde, 0x1ccdf, 0x1cce0, 0x1cce1,
+	0x1cce2, 0x1cce3, 0x1cce4, 0x1cce5, 0x1cce6, 0x1cce7, 0x1cce8, 0x1cce9,
+	0x1ccea, 0x1cceb, 0x1ccec, 0x1cced, 0x1ccee, 0x1ccef, 0x1ccf0, 0x1ccf1,
+	0x1ccf2, 0x1ccf3, 0x1ccf4, 0x1ccf5, 0x1ccf6, 0x1ccf7, 0x1ccf8, 0x1ccf9,
+	0x1d400, 0x1d401, 0x1d402, 0x1d403, 0x1d404, 0x1d405, 0x1d406, 0x1d407,
+	0x1d408, 0x1d409, 0x1d40a, 0x1d40b, 0x1d40c, 0x1d40d, 0x1d40e, 0x1d40f,
+	0x1d410, 0x1d411, 0x1d412, 0x1d413, 0x1d414, 0x1d415, 0x1d416, 0x1d417,
+	0x1d418, 0x1d419, 0x1d41a, 0x1d41b, 0x1d41c, 0x1d41d, 0x1d41e, 0x1d41f,
+	0x1d420, 0x1d421, 0x1d422, 0x1d423, 0x1d424, 0x1d425, 0x1d426, 0x1d427,
+	0x1d428, 0x1d429, 0x1d42a, 0x1d42b, 0x1d42c, 0x1d42d, 0x1d42e, 0x1d42f,
+	0x1d430, 0x1d431, 0x1d432, 0x1d433, 0x1d434, 0x1d435, 0x1d436, 0x1d437,
+	0x1d438, 0x1d439, 0x1d43a, 0x1d43b, 0x1d43c, 0x1d43d, 0x1d43e, 0x1d43f,
+	0x1d440, 0x1d441, 0x1d442, 0x1d443, 0x1d444, 0x1d445, 0x1d446, 0x1d447,
+	0x1d448, 0x1d449, 0x1d44a, 0x1d44b, 0x1d44c, 0x1d44d, 0x1d44e, 0x1d44f,
+	0x1d450, 0x1d451, 0x1d452, 0x1d453, 0x1d454, 0x1d456, 0x1d457, 0x1d458,
+	0x1d459, 0x1d45a, 0x1d45b, 0x1d45c, 0x1d45d, 0x1d45e, 0x1d45f, 0x1d460,
+	0x1d461, 0x1d462, 0x1d463, 0x1d464, 0x1d465, 0x1d466, 0x1d467, 0x1d468,
+	0x1d469, 0x1d46a, 0x1d46b, 0x1d46c, 0x1d46d, 0x1d46e, 0x1d46f, 0x1d470,
+	0x1d471, 0x1d472, 0x1d473, 0x1d474, 0x1d475, 0x1d476, 0x1d477, 0x1d478,
+	0x1d479, 0x1d47a, 0x1d47b, 0x1d47c, 0x1d47d, 0x1d47e, 0x1d47f, 0x1d480,
+	0x1d481, 0x1d482, 0x1d483, 0x1d484, 0x1d485, 0x1d486, 0x1d487, 0x1d488,
+	0x1d489, 0x1d48a, 0x1d48b, 0x1d48c, 0x1d48d, 0x1d48e, 0x1d48f, 0x1d490,
+	0x1d491, 0x1d492, 0x1d493, 0x1d494, 0x1d495, 0x1d496, 0x1d497, 0x1d498,
+	0x1d499, 0x1d49a, 0x1d49b, 0x1d49c, 0x1d49e, 0x1d49f, 0x1d4a2, 0x1d4a5,
+	0x1d4a6, 0x1d4a9, 0x1d4aa, 0x1d4ab, 0x1d4ac, 0x1d4ae, 0x1d4af, 0x1d4b0,
+	0x1d4b1, 0x1d4b2, 0x1d4b3, 0x1d4b4, 0x1d4b5, 0x1d4b6, 0x1d4b7, 0x1d4b8,
+	0x1d4b9, 0x1d4bb, 0x1d4bd, 0x1d4be, 0x1d4bf, 0x1d4c0, 0x1d4c1, 0x1d4c2,
+	0x1d4c3, 0x1d4c5, 0x1d4c6, 0x1d4c7, 0x1d4c8, 0x1d4c9, 0x1d4ca, 0x1d4cb,
+	0x1d4cc, 0x1d4cd, 0x1d4ce, 0x1d4cf, 0x1d4d0, 0x1d4d1, 0x1d4d2, 0x1d4d3,
+	0x1d4d4, 0x1d4d5, 0x1d4d6, 0x1d4d7, 0x1d4d8, 0x1d4d9, 0x1d4da, 0x1d4db,
+	0x1d4dc, 0x1d4dd, 0x1d4de, 0x1d4df, 0x1d4e0, 0x1d4e1, 0x1d4e2, 0x1d4e3,
+	0x1d4e4, 0x1d4e5, 0x1d4e6, 0x1d4e7, 0x1d4e8, 0x1d4e9, 0x1d4ea, 0x1d4eb,
+	0x1d4ec, 0x1d4ed, 0x1d4ee, 0x1d4ef, 0x1d4f0, 0x1d4f1, 0x1d4f2, 0x1d4f3,
+	0x1d4f4, 0x1d4f5, 0x1d4f6, 0x1d4f7, 0x1d4f8, 0x1d4f9, 0x1d4fa, 0x1d4fb,
+	0x1d4fc, 0x1d4fd, 0x1d4fe, 0x1d4ff, 0x1d500, 0x1d501, 0x1d502, 0x1d503,
+	0x1d504, 0x1d505, 0x1d507, 0x1d508, 0x1d509, 0x1d50a, 0x1d50d, 0x1d50e,
+	0x1d50f, 0x1d510, 0x1d511, 0x1d512, 0x1d513, 0x1d514, 0x1d516, 0x1d517,
+	0x1d518, 0x1d519, 0x1d51a, 0x1d51b, 0x1d51c, 0x1d51e, 0x1d51f, 0x1d520,
+	0x1d521, 0x1d522, 0x1d523, 0x1d524, 0x1d525, 0x1d526, 0x1d527, 0x1d528,
+	0x1d529, 0x1d52a, 0x1d52b, 0x1d52c, 0x1d52d, 0x1d52e, 0x1d52f, 0x1d530,
+	0x1d531, 0x1d532, 0x1d533, 0x1d534, 0x1d535, 0x1d536, 0x1d537, 0x1d538,
+	0x1d539, 0x1d53b, 0x1d53c, 0x1d53d, 0x1d53e, 0x1d540, 0x1d541, 0x1d542,
+	0x1d543, 0x1d544, 0x1d546, 0x1d54a, 0x1d54b, 0x1d54c, 0x1d54d, 0x1d54e,
+	0x1d54f, 0x1d550, 0x1d552, 0x1d553, 0x1d554, 0x1d555, 0x1d556, 0x1d557,
+	0x1d558, 0x1d559, 0x1d55a, 0x1d55b, 0x1d55c, 0x1d55d, 0x1d55e, 0x1d55f,
+	0x1d560, 0x1d561, 0x1d562, 0x1d563, 0x1d564, 0x1d565, 0x1d566, 0x1d567,
+	0x1d568, 0x1d569, 0x1d56a, 0x1d56b, 0x1d56c, 0x1d56d, 0x1d56e, 0x1d56f,
+	0x1d570, 0x1d571, 0x1d572, 0x1d573, 0x1d574, 0x1d575, 0x1d576, 0x1d577,
+	0x1d578, 0x1d579, 0x1d57a, 0x1d57b, 0x1d57c, 0x1d57d, 0x1d57e, 0x1d57f,
+	0x1d580, 0x1d581, 0x1d582, 0x1d583, 0x1d584, 0x1d585, 0x1d586, 0x1d587,
+	0x1d588, 0x1d589, 0x1d58a, 0x1d58b, 0x1d58c, 0x1d58d, 0x1d58e, 0x1d58f,
+	0x1d590, 0x1d591, 0x1d592, 0x1d593, 0x1d594, 0x1d595, 0x1d596, 0x1d597,
+	0x1d598, 0x1d599, 0x1d59a, 0x1d59b, 0x1d59c, 0x1d59d, 0x1d59e, 0x1d59f,
+	0x1d5a0, 0x1d5a1, 0x1d5a2, 0x1d5a3, 0x1d5a4, 0x1d5a5, 0x1d5a6, 0x1d5a7,
+	0x1d5a8, 0x1d5a9, 0x1d5aa, 0x1d5ab, 0x1d5ac, 0x1d5ad, 0x1d5ae, 0x1d5af,
+	0x1d5b0, 0x1d5b1, 0x1d5b2, 0x1d5b3, 0x1d5b4, 0x1d5b5, 0x1d5b6, 0x1d5b7,
+	0x1d5b8, 0x1d5b9, 0x1d5ba, 0x1d5bb, 0x1d5bc, 0x1d5bd, 0x1d5be, 0x1d5bf,
+	0x1d5c0, 0x1d5c1, 0x1d5c2, 0x1d5c3, 0x1d5c4, 0x1d5c5, 0x1d5c6, 0x1d5c7,
+	0x1d5c8, 0x1d5c9, 0x1d5ca, 0x1d5cb, 0x1d5cc, 0x1d5cd, 0x1d5ce, 0x1d5cf,
+	0x1d5d0, 0x1d5d1, 0x1d5d2, 0x1d5d3, 0x1d5d4, 0x1d5d5, 0x1d5d6, 0x1d5d7,
+	0x1d5d8, 0x1d5d9, 0x1d5da, 0x1d5db, 0x1d5dc, 0x1d5dd, 0x1d5de, 0x1d5df,
+	0x1d5e0, 0x1d5e1, 0x1d5e2, 0x1d5e3, 0x1d5e4, 0x1d5e5, 0x1d5e6, 0x1d5e7,
+	0x1d5e8, 0x1d5e9, 0x1d5ea, 0x1d5eb, 0x1d5ec, 0x1d5ed, 0x1d5ee, 0x1d5ef,
+	0x1d5f0, 0x1d5f1, 0x1d5f2, 0x1d5f3, 0x1d5f4, 0x1d5f5, 0x1d5f6, 0x1d5f7,
+	0x1d5f8, 0x1d5f9, 0x1d5fa, 0x1d5fb, 0x1d5fc, 0x1d5fd, 0x1d5fe, 0x1d5ff,
+	0x1d600, 0x1d601, 0x1d602, 0x1d603, 0x1d604, 0x1d605, 0x1d606, 0x1d607,
+	0x1d608, 0x1d609, 0x1d60a, 0x1d60b, 0x1d60c, 0x1d60d, 0x1d60e, 0x1d60f,
+	0x1d610, 0x1d611, 0x1d612, 0x1d613, 0x1d614, 0x1d615, 0x1d616, 0x1d617,
+	0x1d618, 0x1d619, 0x1d61a, 0x1d61b, 0x1d61c, 0x1d61d, 0x1d61e, 0x1d61f,
+	0x1d620, 0x1d621, 0x1d622, 0x1d623, 0x1d624, 0x1d625, 0x1d626, 0x1d627,
+	0x1d628, 0x1d629, 0x1d62a, 0x1d62b, 0x1d62c, 0x1d62d, 0x1d62e, 0x1d62f,
+	0x1d630, 0x1d631, 0x1d632, 0x1d633, 0x1d634, 0x1d635, 0x1d636, 0x1d637,
+	0x1d638, 0x1d639, 0x1d63a, 0x1d63b, 0x1d63c, 0x1d63d, 0x1d63e, 0x1d63f,
+	0x1d640, 0x1d641, 0x1d642, 0x1d643, 0x1d644, 0x1d645, 0x1d646, 0x1d647,
+	0x1d648, 0x1d649, 0x1d64a, 0x1d64b, 0x1d64c, 0x1d64d, 0x1d64e, 0x1d64f,
+	0x1d650, 0x1d651, 0x1d652, 0x1d653, 0x1d654, 0x1d655, 0x1d656, 0x1d657,
+	0x1d658, 0x1d659, 0x1d65a, 0x1d65b, 0x1d65c, 0x1d65d, 0x1d65e, 0x1d65f,
+	0x1d660, 0x1d661, 0x1d662, 0x1d663, 0x1d664, 0x1d665, 0x1d666, 0x1d667,
+	0x1d668, 0x1d669, 0x1d66a, 0x1d66b, 0x1d66c, 0x1d66d, 0x1d66e, 0x1d66f,
+	0x1d670, 0x1d671, 0x1d672, 0x1d673, 0x1d674, 0x1d675, 0x1d676, 0x1d677,
+	0x1d678, 0x1d679, 0x1d67a, 0x1d67b, 0x1d67c, 0x1d67d, 0x1d67e, 0x1d67f,
+	0x1d680, 0x1d681, 0x1d682, 0x1d683, 0x1d684, 0x1d685, 0x1d686, 0x1d687,
+	0x1d688, 0x1d689, 0x1d68a, 0x1d68b, 0x1d68c, 0x1d68d, 0x1d68e, 0x1d68f,
+	0x1d690, 0x1d691, 0x1d692, 0x1d693, 0x1d694, 0x1d695, 0x1d696, 0x1d697,
+	0x1d698, 0x1d699, 0x1d69a, 0x1d69b, 0x1d69c, 0x1d69d, 0x1d69e, 0x1d69f,
+	0x1d6a0, 0x1d6a1, 0x1d6a2, 0x1d6a3, 0x1d6a4, 0x1d6a5, 0x1d6a8, 0x1d6a9,
+	0x1d6aa, 0x1d6ab, 0x1d6ac, 0x1d6ad, 0x1d6ae, 0x1d6af, 0x1d6b0, 0x1d6b1,
+	0x1d6b2, 0x1d6b3, 0x1d6b4, 0x1d6b5, 0x1d6b6, 0x1d6b7, 0x1d6b8, 0x1d6b9,
+	0x1d6ba, 0x1d6bb, 0x1d6bc, 0x1d6bd, 0x1d6be, 0x1d6bf, 0x1d6c0, 0x1d6c1,
+	0x1d6c2, 0x1d6c3, 0x1d6c4, 0x1d6c5, 0x1d6c6, 0x1d6c7, 0x1d6c8, 0x1d6c9,
+	0x1d6ca, 0x1d6cb, 0x1d6cc, 0x1d6cd, 0x1d6ce, 0x1d6cf, 0x1d6d0, 0x1d6d1,
+	0x1d6d2, 0x1d6d3, 0x1d6d4, 0x1d6d5, 0x1d6d6, 0x1d6d7, 0x1d6d8, 0x1d6d9,
+	0x1d6da, 0x1d6db, 0x1d6dc, 0x1d6dd, 0x1d6de, 0x1d6df, 0x1d6e0, 0x1d6e1,
+	0x1d6e2, 0x1d6e3, 0x1d6e4, 0x1d6e5, 0x1d6e6, 0x1d6e7, 0x1d6e8, 0x1d6e9,
+	0x1d6ea, 0x1d6eb, 0x1d6ec, 0x1d6ed, 0x1d6ee, 0x1d6ef, 0x1d6f0, 0x1d6f1,
+	0x1d6f2, 0x1d6f3, 0x1d6f4, 0x1d6f5, 0x1d6f6, 0x1d6f7, 0x1d6f8, 0x1d6f9,
+	0x1d6fa, 0x1d6fb, 0x1d6fc, 0x1d6fd, 0x1d6fe, 0x1d6ff, 0x1d700, 0x1d701,
+	0x1d702, 0x1d703, 0x1d704, 0x1d705, 0x1d706, 0x1d707, 0x1d708, 0x1d709,
+	0x1d70a, 0x1d70b, 0x1d70c, 0x1d70d, 0x1d70e, 0x1d70f, 0x1d710, 0x1d711,
+	0x1d712, 0x1d713, 0x1d714, 0x1d715, 0x1d716, 0x1d717, 0x1d718, 0x1d719,
+	0x1d71a, 0x1d71b, 0x1d71c, 0x1d71d, 0x1d71e, 0x1d71f, 0x1d720, 0x1d721,
+	0x1d722, 0x1d723, 0x1d724, 0x1d725, 0x1d726, 0x1d727, 0x1d728, 0x1d729,
+	0x1d72a, 0x1d72b, 0x1d72c, 0x1d72d, 0x1d72e, 0x1d72f, 0x1d730, 0x1d731,
+	0x1d732, 0x1d733, 0x1d734, 0x1d735, 0x1d736, 0x1d737, 0x1d738, 0x1d739,
+	0x1d73a, 0x1d73b, 0x1d73c, 0x1d73d, 0x1d73e, 0x1d73f, 0x1d740, 0x1d741,
+	0x1d742, 0x1d743, 0x1d744, 0x1d745, 0x1d746, 0x1d747, 0x1d748, 0x1d749,
+	0x1d74a, 0x1d74b, 0x1d74c, 0x1d74d, 0x1d74e, 0x1d74f, 0x1d750, 0x1d751,
+	0x1d752, 0x1d753, 0x1d754, 0x1d755, 0x1d756, 0x1d757, 0x1d758, 0x1d759,
+	0x1d75a, 0x1d75b, 0x1d75c, 0x1d75d, 0x1d75e, 0x1d75f, 0x1d760, 0x1d761,
+	0x1d762, 0x1d763, 0x1d764, 0x1d765, 0x1d766, 0x1d767, 0x1d768, 0x1d769,
+	0x1d76a, 0x1d76b, 0x1d76c, 0x1d76d, 0x1d76e, 0x1d76f, 0x1d770, 0x1d771,
+	0x1d772, 0x1d773, 0x1d774, 0x1d775, 0x1d776, 0x1d777, 0x1d778, 0x1d779,
+	0x1d77a, 0x1d77b, 0x1d77c, 0x1d77d, 0x1d77e, 0x1d77f, 0x1d780, 0x1d781,
+	0x1d782, 0x1d783, 0x1d784, 0x1d785, 0x1d786, 0x1d787, 0x1d788, 0x1d789,
+	0x1d78a, 0x1d78b, 0x1d78c, 0x1d78d, 0x1d78e, 0x1d78f, 0x1d790, 0x1d791,
+	0x1d792, 0x1d793, 0x1d794, 0x1d795, 0x1d796, 0x1d797, 0x1d798, 0x1d799,
+	0x1d79a, 0x1d79b, 0x1d79c, 0x1d79d, 0x1d79e, 0x1d79f, 0x1d7a0, 0x1d7a1,
+	0x1d7a2, 0x1d7a3, 0x1d7a4, 0x1d7a5, 0x1d7a6, 0x1d7a7, 0x1d7a8, 0x1d7a9,
+	0x1d7aa, 0x1d7ab, 0x1d7ac, 0x1d7ad, 0x1d7ae, 0x1d7af, 0x1d7b0, 0x1d7b1,
+	0x1d7b2, 0x1d7b3, 0x1d7b4, 0x1d7b5, 0x1d7b6, 0x1d7b7, 0x1d7b8, 0x1d7b9,
+	0x1d7ba, 0x1d7bb, 0x1d7bc, 0x1d7bd, 0x1d7be, 0x1d7bf, 0x1d7c0, 0x1d7c1,
+	0x1d7c2, 0x1d7c3, 0x1d7c4, 0x1d7c5, 0x1d7c6, 0x1d7c7, 0x1d7c8, 0x1d7c9,
+	0x1d7ca, 0x1d7cb, 0x1d7ce, 0x1d7cf, 0x1d7d0, 0x1d7d1, 0x1d7d2, 0x1d7d3,
+	0x1d7d4, 0x1d7d5, 0x1d7d6, 0x1d7d7, 0x1d7d8, 0x1d7d9, 0x1d7da, 0x1d7db,
+	0x1d7dc, 0x1d7dd, 0x1d7de, 0x1d7df, 0x1d7e0, 0x1d7e1, 0x1d7e2, 0x1d7e3,
+	0x1d7e4, 0x1d7e5, 0x1d7e6, 0x1d7e7, 0x1d7e8, 0x1d7e9, 0x1d7ea, 0x1d7eb,
+	0x1d7ec, 0x1d7ed, 0x1d7ee, 0x1d7ef, 0x1d7f0, 0x1d7f1, 0x1d7f2, 0x1d7f3,
+	0x1d7f4, 0x1d7f5, 0x1d7f6, 0x1d7f7, 0x1d7f8, 0x1d7f9, 0x1d7fa, 0x1d7fb,
+	0x1d7fc, 0x1d7fd, 0x1d7fe, 0x1d7ff, 0x1e030, 0x1e031, 0x1e032, 0x1e033,
+	0x1e034, 0x1e035, 0x1e036, 0x1e037, 0x1e038, 0x1e039, 0x1e03a, 0x1e03b,
+	0x1e03c, 0x1e03d, 0x1e03e, 0x1e03f, 0x1e040, 0x1e041, 0x1e042, 0x1e043,
+	0x1e044, 0x1e045, 0x1e046, 0x1e047, 0x1e048, 0x1e049, 0x1e04a, 0x1e04b,
+	0x1e04c, 0x1e04d, 0x1e04e, 0x1e04f, 0x1e050, 0x1e051, 0x1e052, 0x1e053,
+	0x1e054, 0x1e055, 0x1e056, 0x1e057, 0x1e058, 0x1e059, 0x1e05a, 0x1e05b,
+	0x1e05c, 0x1e05d, 0x1e05e, 0x1e05f, 0x1e060, 0x1e061, 0x1e062, 0x1e063,
+	0x1e064, 0x1e065, 0x1e066, 0x1e067, 0x1e068, 0x1e069, 0x1e06a, 0x1e06b,
+	0x1e06c, 0x1e06d, 0x1ee00, 0x1ee01, 0x1ee02, 0x1ee03, 0x1ee05, 0x1ee06,
+	0x1ee07, 0x1ee08, 0x1ee09, 0x1ee0a, 0x1ee0b, 0x1ee0c, 0x1ee0d, 0x1ee0e,
+	0x1ee0f, 0x1ee10, 0x1ee11, 0x1ee12, 0x1ee13, 0x1ee14, 0x1ee15, 0x1ee16,
+	0x1ee17, 0x1ee18, 0x1ee19, 0x1ee1a, 0x1ee1b, 0x1ee1c, 0x1ee1d, 0x1ee1e,
+	0x1ee1f, 0x1ee21, 0x1ee22, 0x1ee24, 0x1ee27, 0x1ee29, 0x1ee2a, 0x1ee2b,
+	0x1ee2c, 0x1ee2d, 0x1ee2e, 0x1ee2f, 0x1ee30, 0x1ee31, 0x1ee32, 0x1ee34,
+	0x1ee35, 0x1ee36, 0x1ee37, 0x1ee39, 0x1ee3b, 0x1ee42, 0x1ee47, 0x1ee49,
+	0x1ee4b, 0x1ee4d, 0x1ee4e, 0x1ee4f, 0x1ee51, 0x1ee52, 0x1ee54, 0x1ee57,
+	0x1ee59, 0x1ee5b, 0x1ee5d, 0x1ee5f, 0x1ee61, 0x1ee62, 0x1ee64, 0x1ee67,
+	0x1ee68, 0x1ee69, 0x1ee6a, 0x1ee6c, 0x1ee6d, 0x1ee6e, 0x1ee6f, 0x1ee70,
+	0x1ee71, 0x1ee72, 0x1ee74, 0x1ee75, 0x1ee76, 0x1ee77, 0x1ee79, 0x1ee7a,
+	0x1ee7b, 0x1ee7c, 0x1ee7e, 0x1ee80, 0x1ee81, 0x1ee82, 0x1ee83, 0x1ee84,
+	0x1ee85, 0x1ee86, 0x1ee87, 0x1ee88, 0x1ee89, 0x1ee8b, 0x1ee8c, 0x1ee8d,
+	0x1ee8e, 0x1ee8f, 0x1ee90, 0x1ee91, 0x1ee92, 0x1ee93, 0x1ee94, 0x1ee95,
+	0x1ee96, 0x1ee97, 0x1ee98, 0x1ee99, 0x1ee9a, 0x1ee9b, 0x1eea1, 0x1eea2,
+	0x1eea3, 0x1eea5, 0x1eea6, 0x1eea7, 0x1eea8, 0x1eea9, 0x1eeab, 0x1eeac,
+	0x1eead, 0x1eeae, 0x1eeaf, 0x1eeb0, 0x1eeb1, 0x1eeb2, 0x1eeb3, 0x1eeb4,
+	0x1eeb5, 0x1eeb6, 0x1eeb7, 0x1eeb8, 0x1eeb9, 0x1eeba, 0x1eebb, 0x1f100,
+	0x1f101, 0x1f102, 0x1f103, 0x1f104, 0x1f105, 0x1f106, 0x1f107, 0x1f108,
+	0x1f109, 0x1f10a, 0x1f110, 0x1f111, 0x1f112, 0x1f113, 0x1f114, 0x1f115,
+	0x1f116, 0x1f117, 0x1f118, 0x1f119, 0x1f11a, 0x1f11b, 0x1f11c, 0x1f11d,
+	0x1f11e, 0x1f11f, 0x1f120, 0x1f121, 0x1f122, 0x1f123, 0x1f124, 0x1f125,
+	0x1f126, 0x1f127, 0x1f128, 0x1f129, 0x1f12a, 0x1f12b, 0x1f12c, 0x1f12d,
+	0x1f12e, 0x1f130, 0x1f131, 0x1f132, 0x1f133, 0x1f134, 0x1f135, 0x1f136,
+	0x1f137, 0x1f138, 0x1f139, 0x1f13a, 0x1f13b, 0x1f13c, 0x1f13d, 0x1f13e,
+	0x1f13f, 0x1f140, 0x1f141, 0x1f142, 0x1f143, 0x1f144, 0x1f145, 0x1f146,
+	0x1f147, 0x1f148, 0x1f149, 0x1f14a, 0x1f14b, 0x1f14c, 0x1f14d, 0x1f14e,
+	0x1f14f, 0x1f16a, 0x1f16b, 0x1f16c, 0x1f190, 0x1f200, 0x1f201, 0x1f202,
+	0x1f210, 0x1f211, 0x1f212, 0x1f213, 0x1f214, 0x1f215, 0x1f216, 0x1f217,
+	0x1f218, 0x1f219, 0x1f21a, 0x1f21b, 0x1f21c, 0x1f21d, 0x1f21e, 0x1f21f,
+	0x1f220, 0x1f221, 0x1f222, 0x1f223, 0x1f224, 0x1f225, 0x1f226, 0x1f227,
+	0x1f228, 0x1f229, 0x1f22a, 0x1f22b, 0x1f22c, 0x1f22d, 0x1f22e, 0x1f22f,
+	0x1f230, 0x1f231, 0x1f232, 0x1f233, 0x1f234, 0x1f235, 0x1f236, 0x1f237,
+	0x1f238, 0x1f239, 0x1f23a, 0x1f23b, 0x1f240, 0x1f241, 0x1f242, 0x1f243,
+	0x1f244, 0x1f245, 0x1f246, 0x1f247, 0x1f248, 0x1f250, 0x1f251, 0x1fbf0,
+	0x1fbf1, 0x1fbf2, 0x1fbf3, 0x1fbf4, 0x1fbf5, 0x1fbf6, 0x1fbf7, 0x1fbf8,
+	0x1fbf9,
+}
+
+var compatDecompositionOffsets = [...]uint16{
+	0x0000, 0x0001, 0x0003, 0x0004, 0x0006, 0x0007, 0x0008, 0x000a,
+	0x000b, 0x000d, 0x000e, 0x000f, 0x0012, 0x0015, 0x0018, 0x001a,
+	0x001c, 0x001e, 0x0020, 0x0022, 0x0023, 0x0026, 0x0029, 0x002c,
+	0x002e, 0x0030, 0x0032, 0x0034, 0x0036, 0x0038, 0x003a, 0x003c,
+	0x003e, 0x003f, 0x0040, 0x0041, 0x0042, 0x0043, 0x0044, 0x0045,
+	0x0046, 0x0047, 0x0049, 0x004b, 0x004d, 0x004f, 0x0051, 0x0053,
+	0x0054, 0x0055, 0x0056, 0x0057, 0x0058, 0x005a, 0x005c, 0x005f,
+	0x0060, 0x0061, 0x0062, 0x0064, 0x0066, 0x0067, 0x0068, 0x0069,
+	0x006a, 0x006b, 0x006c, 0x006d, 0x006e, 0x0070, 0x0072, 0x0074,
+	0x0076, 0x0078, 0x007a, 0x007c, 0x007e, 0x0080, 0x0081, 0x0084,
+	0x0087, 0x0088, 0x0089, 0x008a, 0x008b, 0x008c, 0x008d, 0x008e,
+	0x008f, 0x0090, 0x0091, 0x0092, 0x0093, 0x0094, 0x0095, 0x0096,
+	0x0097, 0x0098, 0x0099, 0x009a, 0x009b, 0x009c, 0x009d, 0x009e,
+	0x009f, 0x00a0, 0x00a1, 0x00a2, 0x00a3, 0x00a4, 0x00a5, 0x00a6,
+	0x00a7, 0x00a8, 0x00a9, 0x00aa, 0x00ab, 0x00ac, 0x00ad, 0x00ae,
+	0x00af, 0x00b0, 0x00b1, 0x00b2, 0x00b3, 0x00b4, 0x00b5, 0x00b6,
+	0x00b7, 0x00b8, 0x00b9, 0x00ba, 0x00bb, 0x00bc, 0x00bd, 0x00be,
+	0x00bf, 0x00c0, 0x00c1, 0x00c2, 0x00c3, 0x00c4, 0x00c5, 0x00c6,
+	0x00c7, 0x00c8, 0x00c9, 0x00ca, 0x00cb, 0x00cc, 0x00cd, 0x00ce,
+	0x00cf, 0x00d0, 0x00d1, 0x00d2, 0x00d3, 0x00d4, 0x00d5, 0x00d6,
+	0x00d7, 0x00d8, 0x00d9, 0x00da, 0x00db, 0x00dc, 0x00dd, 0x00de,
+	0x00df, 0x00e0, 0x00e1, 0x00e2, 0x00e3, 0x00e4, 0x00e5, 0x00e6,
+	0x00e7, 0x00e8, 0x00e9, 0x00ea, 0x00ec, 0x00ee, 0x00f0, 0x00f2,
+	0x00f4, 0x00f7, 0x00fa, 0x00fd, 0x0100, 0x0103, 0x0106, 0x0109,
+	0x010c, 0x010f, 0x0111, 0x0113, 0x0114, 0x0115, 0x0116, 0x0117,
+	0x0118, 0x0119, 0x011a, 0x011b, 0x011c, 0x011d, 0x011e, 0x011f,
+	0x0121, 0x0122, 0x0124, 0x0127, 0x0128, 0x012a, 0x012d, 0x012f,
+	0x0132, 0x0134, 0x0136, 0x0138, 0x013a, 0x013c, 0x0140, 0x0141,
+	0x0142, 0x0143, 0x0144, 0x0145, 0x0146, 0x0147, 0x0148, 0x0149,
+	0x014a, 0x014b, 0x014c, 0x014d, 0x014e, 0x014f, 0x0150, 0x0151,
+	0x0152, 0x0153, 0x0154, 0x0155, 0x0156, 0x0157, 0x0158, 0x0159,
+	0x015a, 0x015b, 0x015c, 0x015d, 0x015e, 0x015f, 0x0160, 0x0161,
+	0x0162, 0x0163, 0x0164, 0x0165, 0x0166, 0x0167, 0x0168, 0x0169,
+	0x016a, 0x016b, 0x016d, 0x0170, 0x0173, 0x0174, 0x0176, 0x0179,
+	0x017c, 0x017d, 0x017f, 0x0180, 0x0181, 0x0182, 0x0183, 0x0184,
+	0x0185, 0x0186, 0x0187, 0x0188, 0x0189, 0x018a, 0x018c, 0x018d,
+	0x018e, 0x018f, 0x0190, 0x0191, 0x0193, 0x0196, 0x0198, 0x0199,
+	0x019a, 0x019b, 0x019c, 0x019d, 0x019e, 0x019f, 0x01a0, 0x01a1,
+	0x01a2, 0x01a3, 0x01a4, 0x01a5, 0x01a6, 0x01a9, 0x01aa, 0x01ab,
+	0x01ac, 0x01ad, 0x01ae, 0x01af, 0x01b0, 0x01b1, 0x01b2, 0x01b3,
+	0x01b6, 0x01b9, 0x01bd, 0x01c0, 0x01c3, 0x01c6, 0x01c9, 0x01cc,
+	0x01cf, 0x01d2, 0x01d5, 0x01d8, 0x01db, 0x01de, 0x01e1, 0x01e3,
+	0x01e4, 0x01e6, 0x01e9, 0x01eb, 0x01ec, 0x01ee, 0x01f1, 0x01f5,
+	0x01f7, 0x01f8, 0x01fa, 0x01fd, 0x01fe, 0x01ff, 0x0200, 0x0201,
+	0x0202, 0x0204, 0x0207, 0x0209, 0x020a, 0x020c, 0x020f, 0x0213,
+	0x0215, 0x0216, 0x0218, 0x021b, 0x021c, 0x021d, 0x021e, 0x021f,
+	0x0222, 0x0224, 0x0227, 0x0229, 0x022c, 0x022d, 0x022e, 0x022f,
+	0x0230, 0x0231, 0x0232, 0x0233, 0x0234, 0x0235, 0x0237, 0x0239,
+	0x023b, 0x023d, 0x023f, 0x0241, 0x0243, 0x0245, 0x0247, 0x0249,
+	0x024b, 0x024e, 0x0251, 0x0254, 0x0257, 0x025a, 0x025d, 0x0260,
+	0x0263, 0x0266, 0x026a, 0x026e, 0x0272, 0x0276, 0x027a, 0x027e,
+	0x0282, 0x0286, 0x028a, 0x028e, 0x0292, 0x0294, 0x0296, 0x0298,
+	0x029a, 0x029c, 0x029e, 0x02a0, 0x02a2, 0x02a4, 0x02a7, 0x02aa,
+	0x02ad, 0x02b0, 0x02b3, 0x02b6, 0x02b9, 0x02bc, 0x02bf, 0x02c2,
+	0x02c5, 0x02c8, 0x02cb, 0x02ce, 0x02d1, 0x02d4, 0x02d7, 0x02da,
+	0x02dd, 0x02e0, 0x02e3, 0x02e6, 0x02e9, 0x02ec, 0x02ef, 0x02f2,
+	0x02f5, 0x02f8, 0x02fb, 0x02fe, 0x0301, 0x0304, 0x0307, 0x030a,
+	0x030d, 0x0310, 0x0313, 0x0314, 0x0315, 0x0316, 0x0317, 0x0318,
+	0x0319, 0x031a, 0x031b, 0x031c, 0x031d, 0x031e, 0x031f, 0x0320,
+	0x0321, 0x0322, 0x0323, 0x0324, 0x0325, 0x0326, 0x0327, 0x0328,
+	0x0329, 0x032a, 0x032b, 0x032c, 0x032d, 0x032e, 0x032f, 0x0330,
+	0x0331, 0x0332, 0x0333, 0x0334, 0x0335, 0x0336, 0x0337, 0x0338,
+	0x0339, 0x033a, 0x033b, 0x033c, 0x033d, 0x033e, 0x033f, 0x0340,
+	0x0341, 0x0342, 0x0343, 0x0344, 0x0345, 0x0346, 0x0347, 0x0348,
+	0x034c, 0x034f, 0x0351, 0x0354, 0x0355, 0x0356, 0x0357, 0x0358,
+	0x0359, 0x035a, 0x035b, 0x035c, 0x035d, 0x035e, 0x035f, 0x0360,
+	0x0361, 0x0362, 0x0363, 0x0364, 0x0365, 0x0366, 0x0367, 0x0368,
+	0x0369, 0x036a, 0x036b, 0x036c, 0x036d, 0x036e, 0x036f, 0x0370,
+	0x0371, 0x0372, 0x0373, 0x0374, 0x0375, 0x0376, 0x0377, 0x0378,
+	0x0379, 0x037a, 0x037b, 0x037c, 0x037d, 0x037e, 0x037f, 0x0380,
+	0x0381, 0x0382, 0x0383, 0x0384, 0x0385, 0x0386, 0x0387, 0x0388,
+	0x0389, 0x038a, 0x038b, 0x038c, 0x038d, 0x038e, 0x038f, 0x0390,
+	0x0391, 0x0392, 0x0393, 0x0394, 0x0395, 0x0396, 0x0397, 0x0398,
+	0x0399, 0x039a, 0x039b, 0x039c, 0x039d, 0x039e, 0x039f, 0x03a0,
+	0x03a1, 0x03a2, 0x03a3, 0x03a4, 0x03a5, 0x03a6, 0x03a7, 0x03a8,
+	0x03a9, 0x03aa, 0x03ab, 0x03ac, 0x03ad, 0x03ae, 0x03af, 0x03b0,
+	0x03b1, 0x03b2, 0x03b3, 0x03b4, 0x03b5, 0x03b6, 0x03b7, 0x03b8,
+	0x03b9, 0x03ba, 0x03bb, 0x03bc, 0x03bd, 0x03be, 0x03bf, 0x03c0,
+	0x03c1, 0x03c2, 0x03c3, 0x03c4, 0x03c5, 0x03c6, 0x03c7, 0x03c8,
+	0x03c9, 0x03ca, 0x03cb, 0x03cc, 0x03cd, 0x03ce, 0x03cf, 0x03d0,
+	0x03d1, 0x03d2, 0x03d3, 0x03d4, 0x03d5, 0x03d6, 0x03d7, 0x03d8,
+	0x03d9, 0x03da, 0x03db, 0x03dc, 0x03dd, 0x03de, 0x03df, 0x03e0,
+	0x03e1, 0x03e2, 0x03e3, 0x03e4, 0x03e5, 0x03e6, 0x03e7, 0x03e8,
+	0x03e9, 0x03ea, 0x03eb, 0x03ec, 0x03ed, 0x03ee, 0x03ef, 0x03f0,
+	0x03f1, 0x03f2, 0x03f3, 0x03f4, 0x03f5, 0x03f6, 0x03f7, 0x03f8,
+	0x03f9, 0x03fa, 0x03fb, 0x03fc, 0x03fd, 0x03fe, 0x03ff, 0x0400,
+	0x0401, 0x0402, 0x0403, 0x0404, 0x0405, 0x0406, 0x0407, 0x0408,
+	0x0409, 0x040a, 0x040b, 0x040c, 0x040d, 0x040e, 0x040f, 0x0410,
+	0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417, 0x0418,
+	0x0419, 0x041a, 0x041b, 0x041c, 0x041d, 0x041e, 0x041f, 0x0420,
+	0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427, 0x0428,
+	0x0429, 0x042a, 0x042b, 0x042c, 0x042d, 0x042e, 0x042f, 0x0430,
+	0x0431, 0x0432, 0x0433, 0x0434, 0x0436, 0x0438, 0x043a, 0x043c,
+	0x043d, 0x043e, 0x043f, 0x0440, 0x0441, 0x0442, 0x0443, 0x0444,
+	0x0445, 0x0446, 0x0447, 0x0448, 0x0449, 0x044a, 0x044b, 0x044c,
+	0x044d, 0x044e, 0x044f, 0x0450, 0x0451, 0x0452, 0x0453, 0x0454,
+	0x0455, 0x0456, 0x0457, 0x0458, 0x0459, 0x045a, 0x045b, 0x045c,
+	0x045d, 0x045e, 0x045f, 0x0460, 0x0461, 0x0462, 0x0463, 0x0464,
+	0x0465, 0x0466, 0x0467, 0x0468, 0x0469, 0x046a, 0x046b, 0x046c,
+	0x046d, 0x046e, 0x046f, 0x0470, 0x0471, 0x0472, 0x0473, 0x0474,
+	0x0475, 0x0476, 0x0477, 0x0478, 0x0479, 0x047a, 0x047b, 0x047c,
+	0x047d, 0x047e, 0x047f, 0x0480, 0x0481, 0x0482, 0x0483, 0x0484,
+	0x0485, 0x0486, 0x0487, 0x0488, 0x0489, 0x048a, 0x048b, 0x048c,
+	0x048d, 0x048e, 0x048f, 0x0490, 0x0491, 0x0492, 0x0493, 0x0494,
+	0x0495, 0x0496, 0x0497, 0x0498, 0x0499, 0x049a, 0x049b, 0x049c,
+	0x049d, 0x049e, 0x049f, 0x04a0, 0x04a1, 0x04a2, 0x04a3, 0x04a4,
+	0x04a5, 0x04a6, 0x04a7, 0x04a8, 0x04ab, 0x04ae, 0x04b1, 0x04b4,
+	0x04b7, 0x04ba, 0x04bd, 0x04c0, 0x04c3, 0x04c6, 0x04c9, 0x04cc,
+	0x04cf, 0x04d2, 0x04d6, 0x04da, 0x04de, 0x04e2, 0x04e6, 0x04ea,
+	0x04ee, 0x04f2, 0x04f6, 0x04fa, 0x04fe, 0x0502, 0x0506, 0x050a,
+	0x050e, 0x0515, 0x051b, 0x051e, 0x0521, 0x0524, 0x0527, 0x052a,
+	0x052d, 0x0530, 0x0533, 0x0536, 0x0539, 0x053c, 0x053f, 0x0542,
+	0x0545, 0x0548, 0x054b, 0x054e, 0x0551, 0x0554, 0x0557, 0x055a,
+	0x055d, 0x0560, 0x0563, 0x0566, 0x0569, 0x056c, 0x056f, 0x0572,
+	0x0575, 0x0578, 0x057b, 0x057e, 0x0581, 0x0584, 0x0587, 0x0588,
+	0x0589, 0x058a, 0x058b, 0x058e, 0x0590, 0x0592, 0x0594, 0x0596,
+	0x0598, 0x059a, 0x059c, 0x059e, 0x05a0, 0x05a2, 0x05a4, 0x05a6,
+	0x05a8, 0x05aa, 0x05ac, 0x05ad, 0x05ae, 0x05af, 0x05b0, 0x05b1,
+	0x05b2, 0x05b3, 0x05b4, 0x05b5, 0x05b6, 0x05b7, 0x05b8, 0x05b9,
+	0x05ba, 0x05bc, 0x05be, 0x05c0, 0x05c2, 0x05c4, 0x05c6, 0x05c8,
+	0x05ca, 0x05cc, 0x05ce, 0x05d0, 0x05d2, 0x05d4, 0x05d6, 0x05db,
+	0x05df, 0x05e1, 0x05e2, 0x05e3, 0x05e4, 0x05e5, 0x05e6, 0x05e7,
+	0x05e8, 0x05e9, 0x05ea, 0x05eb, 0x05ec, 0x05ed, 0x05ee, 0x05ef,
+	0x05f0, 0x05f1, 0x05f2, 0x05f3, 0x05f4, 0x05f5, 0x05f6, 0x05f7,
+	0x05f8, 0x05f9, 0x05fa, 0x05fb, 0x05fc, 0x05fd, 0x05fe, 0x05ff,
+	0x0600, 0x0601, 0x0602, 0x0603, 0x0604, 0x0605, 0x0606, 0x0607,
+	0x0608, 0x0609, 0x060a, 0x060b, 0x060c, 0x060d, 0x060e, 0x060f,
+	0x0610, 0x0611, 0x0612, 0x0614, 0x0616, 0x0618, 0x061a, 0x061c,
+	0x061e, 0x0620, 0x0622, 0x0624, 0x0626, 0x0628, 0x062a, 0x062c,
+	0x062e, 0x0630, 0x0632, 0x0634, 0x0636, 0x0638, 0x063a, 0x063c,
+	0x063e, 0x0640, 0x0642, 0x0645, 0x0648, 0x064b, 0x064d, 0x0650,
+	0x0652, 0x0655, 0x0656, 0x0657, 0x0658, 0x0659, 0x065a, 0x065b,
+	0x065c, 0x065d, 0x065e, 0x065f, 0x0660, 0x0661, 0x0662, 0x0663,
+	0x0664, 0x0665, 0x0666, 0x0667, 0x0668, 0x0669, 0x066a, 0x066b,
+	0x066c, 0x066d, 0x066e, 0x066f, 0x0670, 0x0671, 0x0672, 0x0673,
+	0x0674, 0x0675, 0x0676, 0x0677, 0x0678, 0x0679, 0x067a, 0x067b,
+	0x067c, 0x067d, 0x067e, 0x067f, 0x0680, 0x0681, 0x0682, 0x0683,
+	0x0684, 0x0686, 0x068b, 0x068f, 0x0694, 0x0697, 0x069c, 0x069f,
+	0x06a2, 0x06a8, 0x06ac, 0x06af, 0x06b2, 0x06b5, 0x06b9, 0x06bd,
+	0x06c1, 0x06c5, 0x06c9, 0x06cd, 0x06d1, 0x06d7, 0x06d9, 0x06df,
+	0x06e5, 0x06ea, 0x06ee, 0x06f4, 0x06fa, 0x06fe, 0x0701, 0x0704,
+	0x0708, 0x070c, 0x0711, 0x0716, 0x0719, 0x071c, 0x0720, 0x0723,
+	0x0726, 0x0728, 0x072a, 0x072d, 0x0730, 0x0736, 0x073a, 0x073f,
+	0x0745, 0x0749, 0x074c, 0x074f, 0x0755, 0x0759, 0x075f, 0x0762,
+	0x0767, 0x076a, 0x076e, 0x0771, 0x0775, 0x077a, 0x077e, 0x0783,
+	0x0787, 0x0789, 0x078e, 0x0791, 0x0794, 0x0798, 0x079b, 0x079e,
+	0x07a1, 0x07a6, 0x07aa, 0x07ac, 0x07b2, 0x07b5, 0x07ba, 0x07be,
+	0x07c2, 0x07c5, 0x07c8, 0x07cc, 0x07ce, 0x07d2, 0x07d7, 0x07d9,
+	0x07df, 0x07e2, 0x07e4, 0x07e6, 0x07e8, 0x07ea, 0x07ec, 0x07ee,
+	0x07f0, 0x07f2, 0x07f4, 0x07f6, 0x07f9, 0x07fc, 0x07ff, 0x0802,
+	0x0805, 0x0808, 0x080b, 0x080e, 0x0811, 0x0814, 0x0817, 0x081a,
+	0x081d, 0x0820, 0x0823, 0x0826, 0x0828, 0x082a, 0x082d, 0x082f,
+	0x0831, 0x0833, 0x0836, 0x0839, 0x083b, 0x083d, 0x083f, 0x0841,
+	0x0843, 0x0847, 0x0849, 0x084b, 0x084d, 0x084f, 0x0851, 0x0853,
+	0x0855, 0x0857, 0x085a, 0x085e, 0x0860, 0x0862, 0x0864, 0x0866,
+	0x0868, 0x086a, 0x086c, 0x086f, 0x0872, 0x0875, 0x0878, 0x087a,
+	0x087c, 0x087e, 0x0880, 0x0882, 0x0884, 0x0886, 0x0888, 0x088a,
+	0x088c, 0x088f, 0x0892, 0x0894, 0x0897, 0x089a, 0x089d, 0x089f,
+	0x08a2, 0x08a5, 0x08a9, 0x08ab, 0x08ae, 0x08b1, 0x08b4, 0x08b7,
+	0x08bc, 0x08c2, 0x08c4, 0x08c6, 0x08c8, 0x08ca, 0x08cc, 0x08ce,
+	0x08d0, 0x08d2, 0x08d4, 0x08d6, 0x08d8, 0x08da, 0x08dc, 0x08de,
+	0x08e0, 0x08e2, 0x08e4, 0x08e6, 0x08ea, 0x08ec, 0x08ee, 0x08f0,
+	0x08f4, 0x08f7, 0x08f9, 0x08fb, 0x08fd, 0x08ff, 0x0901, 0x0903,
+	0x0905, 0x0907, 0x0909, 0x090b, 0x090e, 0x0910, 0x0912, 0x0915,
+	0x0918, 0x091a, 0x091e, 0x0921, 0x0923, 0x0925, 0x0927, 0x0929,
+	0x092c, 0x092f, 0x0931, 0x0933, 0x0935, 0x0937, 0x0939, 0x093b,
+	0x093d, 0x093f, 0x0941, 0x0944, 0x0947, 0x094a, 0x094d, 0x0950,
+	0x0953, 0x0956, 0x0959, 0x095c, 0x095f, 0x0962, 0x0965, 0x0968,
+	0x096b, 0x096e, 0x0971, 0x0974, 0x0977, 0x097a, 0x097d, 0x0980,
+	0x0983, 0x0986, 0x0987, 0x0988, 0x0989, 0x098a, 0x098b, 0x098c,
+	0x098d, 0x098e, 0x098f, 0x0990, 0x0991, 0x0992, 0x0993, 0x0994,
+	0x0996, 0x0998, 0x099a, 0x099d, 0x09a0, 0x09a2, 0x09a4, 0x09a6,
+	0x09a8, 0x09aa, 0x09ac, 0x09ae, 0x09af, 0x09b0, 0x09b1, 0x09b2,
+	0x09b3, 0x09b4, 0x09b5, 0x09b6, 0x09b7, 0x09b8, 0x09ba, 0x09bb,
+	0x09bc, 0x09bd, 0x09be, 0x09bf, 0x09c0, 0x09c1, 0x09c2, 0x09c3,
+	0x09c4, 0x09c5, 0x09c6, 0x09c7, 0x09c8, 0x09c9, 0x09ca, 0x09cb,
+	0x09cc, 0x09cd, 0x09ce, 0x09cf, 0x09d0, 0x09d1, 0x09d2, 0x09d3,
+	0x09d4, 0x09d5, 0x09d6, 0x09d7, 0x09d8, 0x09d9, 0x09da, 0x09db,
+	0x09dc, 0x09dd, 0x09de, 0x09df, 0x09e0, 0x09e1, 0x09e2, 0x09e3,
+	0x09e4, 0x09e5, 0x09e6, 0x09e7, 0x09e8, 0x09e9, 0x09ea, 0x09eb,
+	0x09ec, 0x09ed, 0x09ee, 0x09ef, 0x09f0, 0x09f1, 0x09f2, 0x09f3,
+	0x09f4, 0x09f5, 0x09f6, 0x09f7, 0x09f8, 0x09f9, 0x09fa, 0x09fb,
+	0x09fc, 0x09fd, 0x09fe, 0x09ff, 0x0a00, 0x0a01, 0x0a02, 0x0a03,
+	0x0a04, 0x0a05, 0x0a06, 0x0a07, 0x0a08, 0x0a09, 0x0a0a, 0x0a0b,
+	0x0a0c, 0x0a0d, 0x0a0e, 0x0a10, 0x0a12, 0x0a13, 0x0a14, 0x0a15,
+	0x0a16, 0x0a17, 0x0a18, 0x0a19, 0x0a1a, 0x0a1b, 0x0a1c, 0x0a1e,
+	0x0a20, 0x0a21, 0x0a22, 0x0a23, 0x0a24, 0x0a25, 0x0a26, 0x0a27,
+	0x0a28, 0x0a29, 0x0a2a, 0x0a2c, 0x0a2d, 0x0a2e, 0x0a2f, 0x0a30,
+	0x0a31, 0x0a32, 0x0a33, 0x0a34, 0x0a35, 0x0a36, 0x0a37, 0x0a38,
+	0x0a3b, 0x0a3e, 0x0a41, 0x0a44, 0x0a47, 0x0a4a, 0x0a4d, 0x0a50,
+	0x0a53, 0x0a56, 0x0a59, 0x0a5c, 0x0a5f, 0x0a62, 0x0a65, 0x0a68,
+	0x0a6b, 0x0a6e, 0x0a6f, 0x0a70, 0x0a71, 0x0a72, 0x0a75, 0x0a78,
+	0x0a7b, 0x0a7e, 0x0a81, 0x0a83, 0x0a85, 0x0a87, 0x0a89, 0x0a8b,
+	0x0a8d, 0x0a8f, 0x0a91, 0x0a93, 0x0a95, 0x0a97, 0x0a99, 0x0a9b,
+	0x0a9d, 0x0a9f, 0x0aa1, 0x0aa3, 0x0aa5, 0x0aa7, 0x0aa9, 0x0aab,
+	0x0aad, 0x0aaf, 0x0ab1, 0x0ab3, 0x0ab5, 0x0ab7, 0x0ab9, 0x0abb,
+	0x0abd, 0x0abf, 0x0ac1, 0x0ac3, 0x0ac5, 0x0ac7, 0x0ac9, 0x0acb,
+	0x0acd, 0x0acf, 0x0ad1, 0x0ad3, 0x0ad5, 0x0ad7, 0x0ad9, 0x0adb,
+	0x0add, 0x0adf, 0x0ae1, 0x0ae3, 0x0ae5, 0x0ae7, 0x0ae9, 0x0aeb,
+	0x0aed, 0x0aef, 0x0af1, 0x0af3, 0x0af5, 0x0af7, 0x0af9, 0x0afb,
+	0x0afd, 0x0aff, 0x0b01, 0x0b03, 0x0b05, 0x0b07, 0x0b09, 0x0b0b,
+	0x0b0d, 0x0b0f, 0x0b11, 0x0b13, 0x0b15, 0x0b17, 0x0b19, 0x0b1b,
+	0x0b1d, 0x0b1f, 0x0b21, 0x0b23, 0x0b25, 0x0b27, 0x0b29, 0x0b2b,
+	0x0b2d, 0x0b2f, 0x0b31, 0x0b33, 0x0b36, 0x0b39, 0x0b3c, 0x0b3f,
+	0x0b42, 0x0b45, 0x0b48, 0x0b4b, 0x0b4e, 0x0b51, 0x0b54, 0x0b57,
+	0x0b59, 0x0b5b, 0x0b5d, 0x0b5f, 0x0b61, 0x0b63, 0x0b65, 0x0b67,
+	0x0b69, 0x0b6b, 0x0b6d, 0x0b6f, 0x0b71, 0x0b73, 0x0b75, 0x0b77,
+	0x0b79, 0x0b7b, 0x0b7d, 0x0b7f, 0x0b81, 0x0b83, 0x0b85, 0x0b87,
+	0x0b89, 0x0b8b, 0x0b8d, 0x0b8f, 0x0b91, 0x0b93, 0x0b95, 0x0b97,
+	0x0b99, 0x0b9b, 0x0b9d, 0x0b9f, 0x0ba1, 0x0ba3, 0x0ba5, 0x0ba7,
+	0x0ba9, 0x0bab, 0x0bad, 0x0baf, 0x0bb1, 0x0bb4, 0x0bb7, 0x0bba,
+	0x0bbd, 0x0bc0, 0x0bc2, 0x0bc4, 0x0bc6, 0x0bc8, 0x0bca, 0x0bcc,
+	0x0bce, 0x0bd0, 0x0bd2, 0x0bd4, 0x0bd6, 0x0bd8, 0x0bda, 0x0bdc,
+	0x0bde, 0x0be0, 0x0be2, 0x0be4, 0x0be6, 0x0be8, 0x0bea, 0x0bec,
+	0x0bee, 0x0bf0, 0x0bf2, 0x0bf4, 0x0bf6, 0x0bf8, 0x0bfa, 0x0bfc,
+	0x0bfe, 0x0c00, 0x0c02, 0x0c04, 0x0c06, 0x0c08, 0x0c0a, 0x0c0c,
+	0x0c0e, 0x0c10, 0x0c12, 0x0c14, 0x0c16, 0x0c18, 0x0c1a, 0x0c1c,
+	0x0c1e, 0x0c20, 0x0c22, 0x0c24, 0x0c26, 0x0c28, 0x0c2a, 0x0c2c,
+	0x0c2e, 0x0c30, 0x0c32, 0x0c34, 0x0c36, 0x0c38, 0x0c3a, 0x0c3c,
+	0x0c3e, 0x0c40, 0x0c42, 0x0c44, 0x0c46, 0x0c49, 0x0c4c, 0x0c4e,
+	0x0c50, 0x0c52, 0x0c54, 0x0c56, 0x0c58, 0x0c5a, 0x0c5c, 0x0c5e,
+	0x0c60, 0x0c62, 0x0c64, 0x0c66, 0x0c68, 0x0c6a, 0x0c6c, 0x0c6e,
+	0x0c71, 0x0c74, 0x0c77, 0x0c79, 0x0c7b, 0x0c7d, 0x0c7f, 0x0c81,
+	0x0c83, 0x0c85, 0x0c87, 0x0c89, 0x0c8b, 0x0c8d, 0x0c8f, 0x0c91,
+	0x0c93, 0x0c95, 0x0c97, 0x0c99, 0x0c9b, 0x0c9d, 0x0c9f, 0x0ca1,
+	0x0ca3, 0x0ca5, 0x0ca7, 0x0ca9, 0x0cab, 0x0cad, 0x0caf, 0x0cb1,
+	0x0cb3, 0x0cb5, 0x0cb7, 0x0cb9, 0x0cbb, 0x0cbd, 0x0cbf, 0x0cc1,
+	0x0cc3, 0x0cc5, 0x0cc7, 0x0cc9, 0x0ccb, 0x0ccd, 0x0ccf, 0x0cd1,
+	0x0cd3, 0x0cd5, 0x0cd7, 0x0cd9, 0x0cdb, 0x0cdd, 0x0cdf, 0x0ce1,
+	0x0ce3, 0x0ce5, 0x0ce7, 0x0ce9, 0x0ceb, 0x0ced, 0x0cef, 0x0cf1,
+	0x0cf3, 0x0cf5, 0x0cf7, 0x0cf9, 0x0cfb, 0x0cfd, 0x0cff, 0x0d01,
+	0x0d03, 0x0d05, 0x0d07, 0x0d09, 0x0d0c, 0x0d0f, 0x0d12, 0x0d15,
+	0x0d18, 0x0d1b, 0x0d1e, 0x0d21, 0x0d24, 0x0d27, 0x0d2a, 0x0d2d,
+	0x0d30, 0x0d33, 0x0d36, 0x0d39, 0x0d3c, 0x0d3f, 0x0d42, 0x0d45,
+	0x0d48, 0x0d4b, 0x0d4e, 0x0d51, 0x0d54, 0x0d57, 0x0d5a, 0x0d5d,
+	0x0d60, 0x0d63, 0x0d66, 0x0d69, 0x0d6c, 0x0d6f, 0x0d72, 0x0d75,
+	0x0d78, 0x0d7b, 0x0d7e, 0x0d81, 0x0d84, 0x0d87, 0x0d8a, 0x0d8d,
+	0x0d90, 0x0d93, 0x0d96, 0x0d99, 0x0d9c, 0x0d9f, 0x0da2, 0x0da5,
+	0x0da8, 0x0dab, 0x0dae, 0x0db1, 0x0db4, 0x0db7, 0x0dba, 0x0dbd,
+	0x0dc0, 0x0dc3, 0x0dc6, 0x0dc9, 0x0dcc, 0x0dcf, 0x0dd2, 0x0dd5,
+	0x0dd8, 0x0ddb, 0x0dde, 0x0de1, 0x0de4, 0x0de7, 0x0dea, 0x0ded,
+	0x0df0, 0x0df3, 0x0df6, 0x0df9, 0x0dfc, 0x0dff, 0x0e02, 0x0e05,
+	0x0e08, 0x0e0b, 0x0e0e, 0x0e11, 0x0e14, 0x0e17, 0x0e1a, 0x0e1d,
+	0x0e20, 0x0e23, 0x0e26, 0x0e29, 0x0e2c, 0x0e2f, 0x0e32, 0x0e35,
+	0x0e38, 0x0e3b, 0x0e3e, 0x0e41, 0x0e44, 0x0e47, 0x0e4a, 0x0e4d,
+	0x0e50, 0x0e53, 0x0e56, 0x0e59, 0x0e5c, 0x0e5f, 0x0e62, 0x0e65,
+	0x0e68, 0x0e6b, 0x0e6e, 0x0e71, 0x0e75, 0x0e79, 0x0e7d, 0x0e81,
+	0x0e85, 0x0e89, 0x0e8d, 0x0e90, 0x0ea2, 0x0eaa, 0x0eae, 0x0eaf,
+	0x0eb0, 0x0eb1, 0x0eb2, 0x0eb3, 0x0eb4, 0x0eb5, 0x0eb6, 0x0eb7,
+	0x0eba, 0x0ebc, 0x0ebd, 0x0ebe, 0x0ebf, 0x0ec0, 0x0ec1, 0x0ec2,
+	0x0ec3, 0x0ec4, 0x0ec5, 0x0ec6, 0x0ec7, 0x0ec8, 0x0ec9, 0x0eca,
+	0x0ecb, 0x0ecc, 0x0ecd, 0x0ece, 0x0ecf, 0x0ed0, 0x0ed1, 0x0ed2,
+	0x0ed4, 0x0ed6, 0x0ed8, 0x0eda, 0x0edb, 0x0edc, 0x0edd, 0x0ede,
+	0x0edf, 0x0ee0, 0x0ee1, 0x0ee2, 0x0ee3, 0x0ee4, 0x0ee5, 0x0ee6,
+	0x0ee7, 0x0ee8, 0x0ee9, 0x0eea, 0x0eeb, 0x0eec, 0x0eed, 0x0eee,
+	0x0eef, 0x0ef0, 0x0ef1, 0x0ef2, 0x0ef3, 0x0ef4, 0x0ef5, 0x0ef6,
+	0x0ef7, 0x0ef9, 0x0efb, 0x0efd, 0x0eff, 0x0f01, 0x0f03, 0x0f05,
+	0x0f07, 0x0f09, 0x0f0b, 0x0f0d, 0x0f0f, 0x0f11, 0x0f13, 0x0f14,
+	0x0f16, 0x0f18, 0x0f1a, 0x0f1c, 0x0f1e, 0x0f20, 0x0f22, 0x0f24,
+	0x0f26, 0x0f28, 0x0f2a, 0x0f2c, 0x0f2d, 0x0f2e, 0x0f2f, 0x0f30,
+	0x0f31, 0x0f32, 0x0f33, 0x0f34, 0x0f35, 0x0f36, 0x0f37, 0x0f38,
+	0x0f39, 0x0f3a, 0x0f3b, 0x0f3c, 0x0f3d, 0x0f3e, 0x0f3f, 0x0f40,
+	0x0f41, 0x0f42, 0x0f43, 0x0f44, 0x0f45, 0x0f46, 0x0f47, 0x0f48,
+	0x0f49, 0x0f4a, 0x0f4b, 0x0f4c, 0x0f4d, 0x0f4e, 0x0f4f, 0x0f50,
+	0x0f51, 0x0f52, 0x0f53, 0x0f54, 0x0f55, 0x0f56, 0x0f57, 0x0f58,
+	0x0f59, 0x0f5a, 0x0f5b, 0x0f5c, 0x0f5d, 0x0f5e, 0x0f5f, 0x0f60,
+	0x0f61, 0x0f62, 0x0f63, 0x0f64, 0x0f65, 0x0f66, 0x0f67, 0x0f68,
+	0x0f69, 0x0f6a, 0x0f6b, 0x0f6c, 0x0f6d, 0x0f6e, 0x0f6f, 0x0f70,
+	0x0f71, 0x0f72, 0x0f73, 0x0f74, 0x0f75, 0x0f76, 0x0f77, 0x0f78,
+	0x0f79, 0x0f7a, 0x0f7b, 0x0f7c, 0x0f7d, 0x0f7e, 0x0f7f, 0x0f80,
+	0x0f81, 0x0f82, 0x0f83, 0x0f84, 0x0f85, 0x0f86, 0x0f87, 0x0f88,
+	0x0f89, 0x0f8a, 0x0f8b, 0x0f8c, 0x0f8d, 0x0f8e, 0x0f8f, 0x0f90,
+	0x0f91, 0x0f92, 0x0f93, 0x0f94, 0x0f97, 0x0f9a, 0x0f9d, 0x0fa0,
+	0x0fa3, 0x0fa6, 0x0fa8, 0x0faa, 0x0fab, 0x0fac, 0x0fad, 0x0fae,
+	0x0faf, 0x0fb0, 0x0fb1, 0x0fb2, 0x0fb3, 0x0fb4, 0x0fb5, 0x0fb6,
+	0x0fb7, 0x0fb8, 0x0fb9, 0x0fba, 0x0fbb, 0x0fbc, 0x0fbd, 0x0fbe,
+	0x0fbf, 0x0fc0, 0x0fc1, 0x0fc2, 0x0fc3, 0x0fc4, 0x0fc5, 0x0fc6,
+	0x0fc7, 0x0fc8, 0x0fc9, 0x0fca, 0x0fcb, 0x0fcc, 0x0fcd, 0x0fce,
+	0x0fcf, 0x0fd0, 0x0fd1, 0x0fd2, 0x0fd3, 0x0fd4, 0x0fd5, 0x0fd6,
+	0x0fd7, 0x0fd8, 0x0fd9, 0x0fda, 0x0fdb, 0x0fdc, 0x0fdd, 0x0fde,
+	0x0fdf, 0x0fe0, 0x0fe1, 0x0fe2, 0x0fe3, 0x0fe4, 0x0fe5, 0x0fe6,
+	0x0fe7, 0x0fe8, 0x0fe9, 0x0fea, 0x0feb, 0x0fec, 0x0fed, 0x0fee,
+	0x0fef, 0x0ff0, 0x0ff1, 0x0ff2, 0x0ff3, 0x0ff4, 0x0ff5, 0x0ff6,
+	0x0ff7, 0x0ff8, 0x0ff9, 0x0ffa, 0x0ffb, 0x0ffc, 0x0ffd, 0x0ffe,
+	0x0fff, 0x1000, 0x1001, 0x1002, 0x1003, 0x1004, 0x1005, 0x1006,
+	0x1007, 0x1008, 0x1009, 0x100a, 0x100b, 0x100c, 0x100d, 0x100e,
+	0x100f, 0x1010, 0x1011, 0x1012, 0x1013, 0x1014, 0x1015, 0x1016,
+	0x1017, 0x1018, 0x1019, 0x101a, 0x101b, 0x101c, 0x101d, 0x101e,
+	0x101f, 0x1020, 0x1021, 0x1022, 0x1023, 0x1024, 0x1025, 0x1026,
+	0x1027, 0x1028, 0x1029, 0x102a, 0x102b, 0x102c, 0x102d, 0x102e,
+	0x102f, 0x1030, 0x1031, 0x1032, 0x1033, 0x1034, 0x1035, 0x1036,
+	0x1037, 0x1038, 0x1039, 0x103a, 0x103b, 0x103c, 0x103d, 0x103e,
+	0x103f, 0x1040, 0x1041, 0x1042, 0x1043, 0x1044, 0x1045, 0x1046,
+	0x1047, 0x1048, 0x1049, 0x104a, 0x104b, 0x104c, 0x104d, 0x104e,
+	0x104f, 0x1050, 0x1051, 0x1052, 0x1053, 0x1054, 0x1055, 0x1056,
+	0x1057, 0x1058, 0x1059, 0x105a, 0x105b, 0x105c, 0x105d, 0x105e,
+	0x105f, 0x1060, 0x1061, 0x1062, 0x1063, 0x1064, 0x1065, 0x1066,
+	0x1067, 0x1068, 0x1069, 0x106a, 0x106b, 0x106c, 0x106d, 0x106e,
+	0x106f, 0x1070, 0x1071, 0x1072, 0x1073, 0x1074, 0x1075, 0x1076,
+	0x1077, 0x1078, 0x1079, 0x107a, 0x107b, 0x107c, 0x107d, 0x107e,
+	0x107f, 0x1080, 0x1082, 0x1083, 0x1084, 0x1085, 0x1086, 0x1087,
+	0x1088, 0x1089, 0x108a, 0x108b, 0x108c, 0x108d, 0x108e, 0x108f,
+	0x1090, 0x1091, 0x1092, 0x1093, 0x1094, 0x1095, 0x1096, 0x1097,
+	0x1098, 0x1099, 0x109a, 0x109b, 0x109c, 0x109d, 0x109e, 0x109f,
+	0x10a0, 0x10a1, 0x10a2, 0x10a3, 0x10a4, 0x10a5, 0x10a6, 0x10a7,
+	0x10a8, 0x10a9, 0x10aa, 0x10ab, 0x10ac, 0x10ad, 0x10ae, 0x10af,
+	0x10b0, 0x10b1, 0x10b2, 0x10b3, 0x10b4, 0x10b5, 0x10b6, 0x10b7,
+	0x10b8, 0x10b9, 0x10ba, 0x10bb, 0x10bc, 0x10bd, 0x10be, 0x10bf,
+	0x10c0, 0x10c1, 0x10c2, 0x10c3, 0x10c4, 0x10c5, 0x10c6, 0x10c7,
+	0x10c8, 0x10c9, 0x10ca, 0x10cb, 0x10cc, 0x10cd, 0x10ce, 0x10cf,
+	0x10d0, 0x10d1, 0x10d2, 0x10d3, 0x10d4, 0x10d5, 0x10d6, 0x10d7,
+	0x10d8, 0x10d9, 0x10da, 0x10db, 0x10dc, 0x10dd, 0x10de, 0x10df,
+	0x10e0, 0x10e1, 0x10e2, 0x10e3, 0x10e4, 0x10e5, 0x10e6, 0x10e7,
+	0x10e8, 0x10e9, 0x10ea, 0x10eb, 0x10ec, 0x10ed, 0x10ee, 0x10ef,
+	0x10f0, 0x10f1, 0x10f2, 0x10f3, 0x10f4, 0x10f5, 0x10f6, 0x10f7,
+	0x10f8, 0x10f9, 0x10fa, 0x10fb, 0x10fc, 0x10fd, 0x10fe, 0x10ff,
+	0x1100, 0x1101, 0x1102, 0x1103, 0x1104, 0x1105, 0x1106, 0x1107,
+	0x1108, 0x1109, 0x110a, 0x110b, 0x110c, 0x110d, 0x110e, 0x110f,
+	0x1110, 0x1111, 0x1112, 0x1113, 0x1114, 0x1115, 0x1116, 0x1117,
+	0x1118, 0x1119, 0x111a, 0x111b, 0x111c, 0x111d, 0x111e, 0x111f,
+	0x1120, 0x1121, 0x1122, 0x1123, 0x1124, 0x1125, 0x1126, 0x1127,
+	0x1128, 0x1129, 0x112a, 0x112b, 0x112c, 0x112d, 0x112e, 0x112f,
+	0x1130, 0x1131, 0x1132, 0x1133, 0x1134, 0x1135, 0x1136, 0x1137,
+	0x1138, 0x1139, 0x113a, 0x113b, 0x113c, 0x113d, 0x113e, 0x113f,
+	0x1140, 0x1141, 0x1142, 0x1143, 0x1144, 0x1145, 0x1146, 0x1147,
+	0x1148, 0x1149, 0x114a, 0x114b, 0x114c, 0x114d, 0x114e, 0x114f,
+	0x1150, 0x1151, 0x1152, 0x1153, 0x1154, 0x1155, 0x1156, 0x1157,
+	0x1158, 0x1159, 0x115a, 0x115b, 0x115c, 0x115d, 0x115e, 0x115f,
+	0x1160, 0x1161, 0x1162, 0x1163, 0x1164, 0x1165, 0x1166, 0x1167,
+	0x1168, 0x1169, 0x116a, 0x116b, 0x116c, 0x116d, 0x116e, 0x116f,
+	0x1170, 0x1171, 0x1172, 0x1173, 0x1174, 0x1175, 0x1176, 0x1177,
+	0x1178, 0x1179, 0x117a, 0x117b, 0x117c, 0x117d, 0x117e, 0x117f,
+	0x1180, 0x1181, 0x1182, 0x1183, 0x1184, 0x1185, 0x1186, 0x1187,
+	0x1188, 0x1189, 0x118a, 0x118b, 0x118c, 0x118d, 0x118e, 0x118f,
+	0x1190, 0x1191, 0x1192, 0x1193, 0x1194, 0x1195, 0x1196, 0x1197,
+	0x1198, 0x1199, 0x119a, 0x119b, 0x119c, 0x119d, 0x119e, 0x119f,
+	0x11a0, 0x11a1, 0x11a2, 0x11a3, 0x11a4, 0x11a5, 0x11a6, 0x11a7,
+	0x11a8, 0x11a9, 0x11aa, 0x11ab, 0x11ac, 0x11ad, 0x11ae, 0x11af,
+	0x11b0, 0x11b1, 0x11b2, 0x11b3, 0x11b4, 0x11b5, 0x11b6, 0x11b7,
+	0x11b8, 0x11b9, 0x11ba, 0x11bb, 0x11bc, 0x11bd, 0x11be, 0x11bf,
+	0x11c0, 0x11c1, 0x11c2, 0x11c3, 0x11c4, 0x11c5, 0x11c6, 0x11c7,
+	0x11c8, 0x11c9, 0x11ca, 0x11cb, 0x11cc, 0x11cd, 0x11ce, 0x11cf,
+	0x11d0, 0x11d1, 0x11d2, 0x11d3, 0x11d4, 0x11d5, 0x11d6, 0x11d7,
+	0x11d8, 0x11d9, 0x11da, 0x11db, 0x11dc, 0x11dd, 0x11de, 0x11df,
+	0x11e0, 0x11e1, 0x11e2, 0x11e3, 0x11e4, 0x11e5, 0x11e6, 0x11e7,
+	0x11e8, 0x11e9, 0x11ea, 0x11eb, 0x11ec, 0x11ed, 0x11ee, 0x11ef,
+	0x11f0, 0x11f1, 0x11f2, 0x11f3, 0x11f4, 0x11f5, 0x11f6, 0x11f7,
+	0x11f8, 0x11f9, 0x11fa, 0x11fb, 0x11fc, 0x11fd, 0x11fe, 0x11ff,
+	0x1200, 0x1201, 0x1202, 0x1203, 0x1204, 0x1205, 0x1206, 0x1207,
+	0x1208, 0x1209, 0x120a, 0x120b, 0x120c, 0x120d, 0x120e, 0x120f,
+	0x1210, 0x1211, 0x1212, 0x1213, 0x1214, 0x1215, 0x1216, 0x1217,
+	0x1218, 0x1219, 0x121a, 0x121b, 0x121c, 0x121d, 0x121e, 0x121f,
+	0x1220, 0x1221, 0x1222, 0x1223, 0x1224, 0x1225, 0x1226, 0x1227,
+	0x1228, 0x1229, 0x122a, 0x122b, 0x122c, 0x122d, 0x122e, 0x122f,
+	0x1230, 0x1231, 0x1232, 0x1233, 0x1234, 0x1235, 0x1236, 0x1237,
+	0x1238, 0x1239, 0x123a, 0x123b, 0x123c, 0x123d, 0x123e, 0x123f,
+	0x1240, 0x1241, 0x1242, 0x1243, 0x1244, 0x1245, 0x1246, 0x1247,
+	0x1248, 0x1249, 0x124a, 0x124b, 0x124c, 0x124d, 0x124e, 0x124f,
+	0x1250, 0x1251, 0x1252, 0x1253, 0x1254, 0x1255, 0x1256, 0x1257,
+	0x1258, 0x1259, 0x125a, 0x125b, 0x125c, 0x125d, 0x125e, 0x125f,
+	0x1260, 0x1261, 0x1262, 0x1263, 0x1264, 0x1265, 0x1266, 0x1267,
+	0x1268, 0x1269, 0x126a, 0x126b, 0x126c, 0x126d, 0x126e, 0x126f,
+	0x1270, 0x1271, 0x1272, 0x1273, 0x1274, 0x1275, 0x1276, 0x1277,
+	0x1278, 0x1279, 0x127a, 0x127b, 0x127c, 0x127d, 0x127e, 0x127f,
+	0x1280, 0x1281, 0x1282, 0x1283, 0x1284, 0x1285, 0x1286, 0x1287,
+	0x1288, 0x1289, 0x128a, 0x128b, 0x128c, 0x128d, 0x128e, 0x128f,
+	0x1290, 0x1291, 0x1292, 0x1293, 0x1294, 0x1295, 0x1296, 0x1297,
+	0x1298, 0x1299, 0x129a, 0x129b, 0x129c, 0x129d, 0x129e, 0x129f,
+	0x12a0, 0x12a1, 0x12a2, 0x12a3, 0x12a4, 0x12a5, 0x12a6, 0x12a7,
+	0x12a8, 0x12a9, 0x12aa, 0x12ab, 0x12ac, 0x12ad, 0x12ae, 0x12af,
+	0x12b0, 0x12b1, 0x12b2, 0x12b3, 0x12b4, 0x12b5, 0x12b6, 0x12b7,
+	0x12b8, 0x12b9, 0x12ba, 0x12bb, 0x12bc, 0x12bd, 0x12be, 0x12bf,
+	0x12c0, 0x12c1, 0x12c2, 0x12c3, 0x12c4, 0x12c5, 0x12c6, 0x12c7,
+	0x12c8, 0x12c9, 0x12ca, 0x12cb, 0x12cc, 0x12cd, 0x12ce, 0x12cf,
+	0x12d0, 0x12d1, 0x12d2, 0x12d3, 0x12d4, 0x12d5, 0x12d6, 0x12d7,
+	0x12d8, 0x12d9, 0x12da, 0x12db, 0x12dc, 0x12dd, 0x12de, 0x12df,
+	0x12e0, 0x12e1, 0x12e2, 0x12e3, 0x12e4, 0x12e5, 0x12e6, 0x12e7,
+	0x12e8, 0x12e9, 0x12ea, 0x12eb, 0x12ec, 0x12ed, 0x12ee, 0x12ef,
+	0x12f0, 0x12f1, 0x12f2, 0x12f3, 0x12f4, 0x12f5, 0x12f6, 0x12f7,
+	0x12f8, 0x12f9, 0x12fa, 0x12fb, 0x12fc, 0x12fd, 0x12fe, 0x12ff,
+	0x1300, 0x1301, 0x1302, 0x1303, 0x1304, 0x1305, 0x1306, 0x1307,
+	0x1308, 0x1309, 0x130a, 0x130b, 0x130c, 0x130d, 0x130e, 0x130f,
+	0x1310, 0x1311, 0x1312, 0x1313, 0x1314, 0x1315, 0x1316, 0x1317,
+	0x1318, 0x1319, 0x131a, 0x131b, 0x131c, 0x131d, 0x131e, 0x131f,
+	0x1320, 0x1321, 0x1322, 0x1323, 0x1324, 0x1325, 0x1326, 0x1327,
+	0x1328, 0x1329, 0x132a, 0x132b, 0x132c, 0x132d, 0x132e, 0x132f,
+	0x1330, 0x1331, 0x1332, 0x1333, 0x1334, 0x1335, 0x1336, 0x1337,
+	0x1338, 0x1339, 0x133a, 0x133b, 0x133c, 0x133d, 0x133e, 0x133f,
+	0x1340, 0x1341, 0x1342, 0x1343, 0x1344, 0x1345, 0x1346, 0x1347,
+	0x1348, 0x1349, 0x134a, 0x134b, 0x134c, 0x134d, 0x134e, 0x134f,
+	0x1350, 0x1351, 0x1352, 0x1353, 0x1354, 0x1355, 0x1356, 0x1357,
+	0x1358, 0x1359, 0x135a, 0x135b, 0x135c, 0x135d, 0x135e, 0x135f,
+	0x1360, 0x1361, 0x1362, 0x1363, 0x1364, 0x1365, 0x1366, 0x1367,
+	0x1368, 0x1369, 0x136a, 0x136b, 0x136c, 0x136d, 0x136e, 0x136f,
+	0x1370, 0x1371, 0x1372, 0x1373, 0x1374, 0x1375, 0x1376, 0x1377,
+	0x1378, 0x1379, 0x137a, 0x137b, 0x137c, 0x137d, 0x137e, 0x137f,
+	0x1380, 0x1381, 0x1382, 0x1383, 0x1384, 0x1385, 0x1386, 0x1387,
+	0x1388, 0x1389, 0x138a, 0x138b, 0x138c, 0x138d, 0x138e, 0x138f,
+	0x1390, 0x1391, 0x1392, 0x1393, 0x1394, 0x1395, 0x1396, 0x1397,
+	0x1398, 0x1399, 0x139a, 0x139b, 0x139c, 0x139d, 0x139e, 0x139f,
+	0x13a0, 0x13a1, 0x13a2, 0x13a3, 0x13a4, 0x13a5, 0x13a6, 0x13a7,
+	0x13a8, 0x13a9, 0x13aa, 0x13ab, 0x13ac, 0x13ad, 0x13ae, 0x13af,
+	0x13b0, 0x13b1, 0x13b2, 0x13b3, 0x13b4, 0x13b5, 0x13b6, 0x13b7,
+	0x13b8, 0x13b9, 0x13ba, 0x13bb, 0x13bc, 0x13bd, 0x13be, 0x13bf,
+	0x13c0, 0x13c1, 0x13c2, 0x13c3, 0x13c4, 0x13c5, 0x13c6, 0x13c7,
+	0x13c8, 0x13c9, 0x13ca, 0x13cb, 0x13cc, 0x13cd, 0x13ce, 0x13cf,
+	0x13d0, 0x13d1, 0x13d2, 0x13d3, 0x13d4, 0x13d5, 0x13d6, 0x13d7,
+	0x13d8, 0x13d9, 0x13da, 0x13db, 0x13dc, 0x13dd, 0x13de, 0x13df,
+	0x13e0, 0x13e1, 0x13e2, 0x13e3, 0x13e4, 0x13e5, 0x13e6, 0x13e7,
+	0x13e8, 0x13e9, 0x13ea, 0x13eb, 0x13ec, 0x13ed, 0x13ee, 0x13ef,
+	0x13f0, 0x13f1, 0x13f2, 0x13f3, 0x13f4, 0x13f5, 0x13f6, 0x13f7,
+	0x13f8, 0x13f9, 0x13fa, 0x13fb, 0x13fc, 0x13fd, 0x13fe, 0x13ff,
+	0x1400, 0x1401, 0x1402, 0x1403, 0x1404, 0x1405, 0x1406, 0x1407,
+	0x1408, 0x1409, 0x140a, 0x140b, 0x140c, 0x140d, 0x140e, 0x140f,
+	0x1410, 0x1411, 0x1412, 0x1413, 0x1414, 0x1415, 0x1416, 0x1417,
+	0x1418, 0x1419, 0x141a, 0x141b, 0x141c, 0x141d, 0x141e, 0x141f,
+	0x1420, 0x1421, 0x1422, 0x1423, 0x1424, 0x1425, 0x1426, 0x1427,
+	0x1428, 0x1429, 0x142a, 0x142b, 0x142c, 0x142d, 0x142e, 0x142f,
+	0x1430, 0x1431, 0x1432, 0x1433, 0x1434, 0x1435, 0x1436, 0x1437,
+	0x1438, 0x1439, 0x143a, 0x143b, 0x143c, 0x143d, 0x143e, 0x143f,
+	0x1440, 0x1441, 0x1442, 0x1443, 0x1444, 0x1445, 0x1446, 0x1447,
+	0x1448, 0x1449, 0x144a, 0x144b, 0x144c, 0x144d, 0x144e, 0x144f,
+	0x1450, 0x1451, 0x1452, 0x1453, 0x1454, 0x1455, 0x1456, 0x1457,
+	0x1458, 0x1459, 0x145a, 0x145b, 0x145c, 0x145d, 0x145e, 0x145f,
+	0x1460, 0x1461, 0x1462, 0x1463, 0x1464, 0x1465, 0x1466, 0x1467,
+	0x1468, 0x1469, 0x146a, 0x146b, 0x146c, 0x146d, 0x146e, 0x146f,
+	0x1470, 0x1471, 0x1472, 0x1473, 0x1474, 0x1475, 0x1476, 0x1477,
+	0x1478, 0x1479, 0x147a, 0x147b, 0x147c, 0x147d, 0x147e, 0x147f,
+	0x1480, 0x1481, 0x1482, 0x1483, 0x1484, 0x1485, 0x1486, 0x1487,
+	0x1488, 0x1489, 0x148a, 0x148b, 0x148c, 0x148d, 0x148e, 0x148f,
+	0x1490, 0x1491, 0x1492, 0x1493, 0x1494, 0x1495, 0x1496, 0x1497,
+	0x1498, 0x1499, 0x149a, 0x149b, 0x149c, 0x149d, 0x149e, 0x149f,
+	0x14a0, 0x14a1, 0x14a2, 0x14a3, 0x14a4, 0x14a5, 0x14a6, 0x14a7,
+	0x14a8, 0x14a9, 0x14aa, 0x14ab, 0x14ac, 0x14ad, 0x14ae, 0x14af,
+	0x14b0, 0x14b1, 0x14b2, 0x14b3, 0x14b4, 0x14b5, 0x14b6, 0x14b7,
+	0x14b8, 0x14b9, 0x14ba, 0x14bb, 0x14bc, 0x14bd, 0x14be, 0x14bf,
+	0x14c0, 0x14c1, 0x14c2, 0x14c3, 0x14c4, 0x14c5, 0x14c6, 0x14c7,
+	0x14c8, 0x14c9, 0x14ca, 0x14cb, 0x14cc, 0x14cd, 0x14ce, 0x14cf,
+	0x14d0, 0x14d1, 0x14d2, 0x14d3, 0x14d4, 0x14d5, 0x14d6, 0x14d7,
+	0x14d8, 0x14d9, 0x14da, 0x14db, 0x14dc, 0x14dd, 0x14de, 0x14df,
+	0x14e0, 0x14e1, 0x14e2, 0x14e3, 0x14e4, 0x14e5, 0x14e6, 0x14e7,
+	0x14e8, 0x14e9, 0x14ea, 0x14eb, 0x14ec, 0x14ed, 0x14ee, 0x14ef,
+	0x14f0, 0x14f1, 0x14f2, 0x14f3, 0x14f4, 0x14f5, 0x14f6, 0x14f7,
+	0x14f8, 0x14f9, 0x14fa, 0x14fb, 0x14fc, 0x14fd, 0x14fe, 0x14ff,
+	0x1500, 0x1501, 0x1502, 0x1503, 0x1504, 0x1505, 0x1506, 0x1507,
+	0x1508, 0x1509, 0x150a, 0x150b, 0x150c, 0x150d, 0x150e, 0x150f,
+	0x1510, 0x1511, 0x1512, 0x1513, 0x1514, 0x1515, 0x1516, 0x1517,
+	0x1518, 0x1519, 0x151a, 0x151b, 0x151c, 0x151d, 0x151e, 0x151f,
+	0x1520, 0x1521, 0x1522, 0x1523, 0x1524, 0x1525, 0x1526, 0x1527,
+	0x1528, 0x1529, 0x152a, 0x152b, 0x152c, 0x152d, 0x152e, 0x152f,
+	0x1530, 0x1531, 0x1532, 0x1533, 0x1534, 0x1535, 0x1536, 0x1537,
+	0x1538, 0x1539, 0x153a, 0x153b, 0x153c, 0x153d, 0x153e, 0x153f,
+	0x1540, 0x1541, 0x1542, 0x1543, 0x1544, 0x1545, 0x1546, 0x1547,
+	0x1548, 0x1549, 0x154a, 0x154b, 0x154c, 0x154d, 0x154e, 0x154f,
+	0x1550, 0x1551, 0x1552, 0x1553, 0x1554, 0x1555, 0x1556, 0x1557,
+	0x1558, 0x1559, 0x155a, 0x155b, 0x155c, 0x155d, 0x155e, 0x155f,
+	0x1560, 0x1561, 0x1562, 0x1563, 0x1564, 0x1565, 0x1566, 0x1567,
+	0x1568, 0x1569, 0x156a, 0x156b, 0x156c, 0x156d, 0x156e, 0x156f,
+	0x1570, 0x1571, 0x1572, 0x1573, 0x1574, 0x1575, 0x1576, 0x1577,
+	0x1578, 0x1579, 0x157a, 0x157b, 0x157c, 0x157d, 0x157e, 0x157f,
+	0x1580, 0x1581, 0x1582, 0x1583, 0x1584, 0x1585, 0x1586, 0x1587,
+	0x1588, 0x1589, 0x158a, 0x158b, 0x158c, 0x158d, 0x158e, 0x158f,
+	0x1590, 0x1591, 0x1592, 0x1593, 0x1594, 0x1595, 0x1596, 0x1597,
+	0x1599, 0x159b, 0x159d, 0x159f, 0x15a1, 0x15a3, 0x15a5, 0x15a7,
+	0x15a9, 0x15ab, 0x15ad, 0x15b0, 0x15b3, 0x15b6, 0x15b9, 0x15bc,
+	0x15bf, 0x15c2, 0x15c5, 0x15c8, 0x15cb, 0x15ce, 0x15d1, 0x15d4,
+	0x15d7, 0x15da, 0x15dd, 0x15e0, 0x15e3, 0x15e6, 0x15e9, 0x15ec,
+	0x15ef, 0x15f2, 0x15f5, 0x15f8, 0x15fb, 0x15fe, 0x15ff, 0x1600,
+	0x1602, 0x1604, 0x1605, 0x1606, 0x1607, 0x1608, 0x1609, 0x160a,
+	0x160b, 0x160c, 0x160d, 0x160e, 0x160f, 0x1610, 0x1611, 0x1612,
+	0x1613, 0x1614, 0x1615, 0x1616, 0x1617, 0x1618, 0x1619, 0x161a,
+	0x161b, 0x161c, 0x161d, 0x161e, 0x1620, 0x1622, 0x1624, 0x1626,
+	0x1629, 0x162b, 0x162d, 0x162f, 0x1631, 0x1633, 0x1635, 0x1637,
+	0x1638, 0x1639, 0x163a, 0x163b, 0x163d, 0x163e, 0x163f, 0x1640,
+	0x1641, 0x1642, 0x1643, 0x1644, 0x1645, 0x1646, 0x1647, 0x1648,
+	0x1649, 0x164a, 0x164b, 0x164c, 0x164d, 0x164e, 0x164f, 0x1650,
+	0x1651, 0x1652, 0x1653, 0x1654, 0x1655, 0x1656, 0x1657, 0x1658,
+	0x1659, 0x165a, 0x165b, 0x165c, 0x165d, 0x165e, 0x165f, 0x1660,
+	0x1661, 0x1662, 0x1663, 0x1664, 0x1665, 0x1668, 0x166b, 0x166e,
+	0x1671, 0x1674, 0x1677, 0x167a, 0x167d, 0x1680, 0x1681, 0x1682,
+	0x1683, 0x1684, 0x1685, 0x1686, 0x1687, 0x1688, 0x1689, 0x168a,
+	0x168b, 0x168c,
+}
+
+var compatDecompositionRunes = [...]rune{
+	0x00020, 0x00020, 0x00308, 0x00061, 0x00020, 0x00304, 0x00032, 0x00033,
+	0x00020, 0x00301, 0x003bc, 0x00020, 0x00327, 0x00031, 0x0006f, 0x00031,
+	0x02044, 0x00034, 0x00031, 0x02044, 0x00032, 0x00033, 0x02044, 0x00034,
+	0x00049, 0x0004a, 0x00069, 0x0006a, 0x0004c, 0x000b7, 0x0006c, 0x000b7,
+	0x002bc, 0x0006e, 0x00073, 0x00044, 0x0005a, 0x0030c, 0x00044, 0x0007a,
+	0x0030c, 0x00064, 0x0007a, 0x0030c, 0x0004c, 0x0004a, 0x0004c, 0x0006a,
+	0x0006c, 0x0006a, 0x0004e, 0x0004a, 0x0004e, 0x0006a, 0x0006e, 0x0006a,
+	0x00044, 0x0005a, 0x00044, 0x0007a, 0x00064, 0x0007a, 0x00068, 0x00266,
+	0x0006a, 0x00072, 0x00279, 0x0027b, 0x00281, 0x00077, 0x00079, 0x00020,
+	0x00306, 0x00020, 0x00307, 0x00020, 0x0030a, 0x00020, 0x00328, 0x00020,
+	0x00303, 0x00020, 0x0030b, 0x00263, 0x0006c, 0x00073, 0x00078, 0x00295,
+	0x00020, 0x00345, 0x00020, 0x00301, 0x00020, 0x00308, 0x00301, 0x003b2,
+	0x003b8, 0x003a5, 0x003a5, 0x00301, 0x003a5, 0x00308, 0x003c6, 0x003c0,
+	0x003ba, 0x003c1, 0x003c2, 0x00398, 0x003b5, 0x003a3, 0x00565, 0x00582,
+	0x00627, 0x00674, 0x00648, 0x00674, 0x006c7, 0x00674, 0x0064a, 0x00674,
+	0x00e4d, 0x00e32, 0x00ecd, 0x00eb2, 0x00eab, 0x00e99, 0x00eab, 0x00ea1,
+	0x00f0b, 0x00fb2, 0x00f71, 0x00f80, 0x00fb3, 0x00f71, 0x00f80, 0x010dc,
+	0x00041, 0x000c6, 0x00042, 0x00044, 0x00045, 0x0018e, 0x00047, 0x00048,
+	0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00222,
+	0x00050, 0x00052, 0x00054, 0x00055, 0x00057, 0x00061, 0x00250, 0x00251,
+	0x01d02, 0x00062, 0x00064, 0x00065, 0x00259, 0x0025b, 0x0025c, 0x00067,
+	0x0006b, 0x0006d, 0x0014b, 0x0006f, 0x00254, 0x01d16, 0x01d17, 0x00070,
+	0x00074, 0x00075, 0x01d1d, 0x0026f, 0x00076, 0x01d25, 0x003b2, 0x003b3,
+	0x003b4, 0x003c6, 0x003c7, 0x00069, 0x00072, 0x00075, 0x00076, 0x003b2,
+	0x003b3, 0x003c1, 0x003c6, 0x003c7, 0x0043d, 0x00252, 0x00063, 0x00255,
+	0x000f0, 0x0025c, 0x00066, 0x0025f, 0x00261, 0x00265, 0x00268, 0x00269,
+	0x0026a, 0x01d7b, 0x0029d, 0x0026d, 0x01d85, 0x0029f, 0x00271, 0x00270,
+	0x00272, 0x00273, 0x00274, 0x00275, 0x00278, 0x00282, 0x00283, 0x001ab,
+	0x00289, 0x0028a, 0x01d1c, 0x0028b, 0x0028c, 0x0007a, 0x00290, 0x00291,
+	0x00292, 0x003b8, 0x00061, 0x002be, 0x00073, 0x00307, 0x00020, 0x00313,
+	0x00020, 0x00313, 0x00020, 0x00342, 0x00020, 0x00308, 0x00342, 0x00020,
+	0x00313, 0x00300, 0x00020, 0x00313, 0x00301, 0x00020, 0x00313, 0x00342,
+	0x00020, 0x00314, 0x00300, 0x00020, 0x00314, 0x00301, 0x00020, 0x00314,
+	0x00342, 0x00020, 0x00308, 0x00300, 0x00020, 0x00308, 0x00301, 0x00020,
+	0x00301, 0x00020, 0x00314, 0x00020, 0x00020, 0x00020, 0x00020, 0x00020,
+	0x00020, 0x00020, 0x00020, 0x00020, 0x00020, 0x00020, 0x02010, 0x00020,
+	0x00333, 0x0002e, 0x0002e, 0x0002e, 0x0002e, 0x0002e, 0x0002e, 0x00020,
+	0x02032, 0x02032, 0x02032, 0x02032, 0x02032, 0x02035, 0x02035, 0x02035,
+	0x02035, 0x02035, 0x00021, 0x00021, 0x00020, 0x00305, 0x0003f, 0x0003f,
+	0x0003f, 0x00021, 0x00021, 0x0003f, 0x02032, 0x02032, 0x02032, 0x02032,
+	0x00020, 0x00030, 0x00069, 0x00034, 0x00035, 0x00036, 0x00037, 0x00038,
+	0x00039, 0x0002b, 0x02212, 0x0003d, 0x00028, 0x00029, 0x0006e, 0x00030,
+	0x00031, 0x00032, 0x00033, 0x00034, 0x00035, 0x00036, 0x00037, 0x00038,
+	0x00039, 0x0002b, 0x02212, 0x0003d, 0x00028, 0x00029, 0x00061, 0x00065,
+	0x0006f, 0x00078, 0x00259, 0x00068, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x00070, 0x00073, 0x00074, 0x00052, 0x00073, 0x00061, 0x0002f, 0x00063,
+	0x00061, 0x0002f, 0x00073, 0x00043, 0x000b0, 0x00043, 0x00063, 0x0002f,
+	0x0006f, 0x00063, 0x0002f, 0x00075, 0x00190, 0x000b0, 0x00046, 0x00067,
+	0x00048, 0x00048, 0x00048, 0x00068, 0x00127, 0x00049, 0x00049, 0x0004c,
+	0x0006c, 0x0004e, 0x0004e, 0x0006f, 0x00050, 0x00051, 0x00052, 0x00052,
+	0x00052, 0x00053, 0x0004d, 0x00054, 0x00045, 0x0004c, 0x00054, 0x0004d,
+	0x0005a, 0x0005a, 0x00042, 0x00043, 0x00065, 0x00045, 0x00046, 0x0004d,
+	0x0006f, 0x005d0, 0x005d1, 0x005d2, 0x005d3, 0x00069, 0x00046, 0x00041,
+	0x00058, 0x003c0, 0x003b3, 0x00393, 0x003a0, 0x02211, 0x00044, 0x00064,
+	0x00065, 0x00069, 0x0006a, 0x00031, 0x02044, 0x00037, 0x00031, 0x02044,
+	0x00039, 0x00031, 0x02044, 0x00031, 0x00030, 0x00031, 0x02044, 0x00033,
+	0x00032, 0x02044, 0x00033, 0x00031, 0x02044, 0x00035, 0x00032, 0x02044,
+	0x00035, 0x00033, 0x02044, 0x00035, 0x00034, 0x02044, 0x00035, 0x00031,
+	0x02044, 0x00036, 0x00035, 0x02044, 0x00036, 0x00031, 0x02044, 0x00038,
+	0x00033, 0x02044, 0x00038, 0x00035, 0x02044, 0x00038, 0x00037, 0x02044,
+	0x00038, 0x00031, 0x02044, 0x00049, 0x00049, 0x00049, 0x00049, 0x00049,
+	0x00049, 0x00049, 0x00056, 0x00056, 0x00056, 0x00049, 0x00056, 0x00049,
+	0x00049, 0x00056, 0x00049, 0x00049, 0x00049, 0x00049, 0x00058, 0x00058,
+	0x00058, 0x00049, 0x00058, 0x00049, 0x00049, 0x0004c, 0x00043, 0x00044,
+	0x0004d, 0x00069, 0x00069, 0x00069, 0x00069, 0x00069, 0x00069, 0x00069,
+	0x00076, 0x00076, 0x00076, 0x00069, 0x00076, 0x00069, 0x00069, 0x00076,
+	0x00069, 0x00069, 0x00069, 0x00069, 0x00078, 0x00078, 0x00078, 0x00069,
+	0x00078, 0x00069, 0x00069, 0x0006c, 0x00063, 0x00064, 0x0006d, 0x00030,
+	0x02044, 0x00033, 0x0222b, 0x0222b, 0x0222b, 0x0222b, 0x0222b, 0x0222e,
+	0x0222e, 0x0222e, 0x0222e, 0x0222e, 0x00031, 0x00032, 0x00033, 0x00034,
+	0x00035, 0x00036, 0x00037, 0x00038, 0x00039, 0x00031, 0x00030, 0x00031,
+	0x00031, 0x00031, 0x00032, 0x00031, 0x00033, 0x00031, 0x00034, 0x00031,
+	0x00035, 0x00031, 0x00036, 0x00031, 0x00037, 0x00031, 0x00038, 0x00031,
+	0x00039, 0x00032, 0x00030, 0x00028, 0x00031, 0x00029, 0x00028, 0x00032,
+	0x00029, 0x00028, 0x00033, 0x00029, 0x00028, 0x00034, 0x00029, 0x00028,
+	0x00035, 0x00029, 0x00028, 0x00036, 0x00029, 0x00028, 0x00037, 0x00029,
+	0x00028, 0x00038, 0x00029, 0x00028, 0x00039, 0x00029, 0x00028, 0x00031,
+	0x00030, 0x00029, 0x00028, 0x00031, 0x00031, 0x00029, 0x00028, 0x00031,
+	0x00032, 0x00029, 0x00028, 0x00031, 0x00033, 0x00029, 0x00028, 0x00031,
+	0x00034, 0x00029, 0x00028, 0x00031, 0x00035, 0x00029, 0x00028, 0x00031,
+	0x00036, 0x00029, 0x00028, 0x00031, 0x00037, 0x00029, 0x00028, 0x00031,
+	0x00038, 0x00029, 0x00028, 0x00031, 0x00039, 0x00029, 0x00028, 0x00032,
+	0x00030, 0x00029, 0x00031, 0x0002e, 0x00032, 0x0002e, 0x00033, 0x0002e,
+	0x00034, 0x0002e, 0x00035, 0x0002e, 0x00036, 0x0002e, 0x00037, 0x0002e,
+	0x00038, 0x0002e, 0x00039, 0x0002e, 0x00031, 0x00030, 0x0002e, 0x00031,
+	0x00031, 0x0002e, 0x00031, 0x00032, 0x0002e, 0x00031, 0x00033, 0x0002e,
+	0x00031, 0x00034, 0x0002e, 0x00031, 0x00035, 0x0002e, 0x00031, 0x00036,
+	0x0002e, 0x00031, 0x00037, 0x0002e, 0x00031, 0x00038, 0x0002e, 0x00031,
+	0x00039, 0x0002e, 0x00032, 0x00030, 0x0002e, 0x00028, 0x00061, 0x00029,
+	0x00028, 0x00062, 0x00029, 0x00028, 0x00063, 0x00029, 0x00028, 0x00064,
+	0x00029, 0x00028, 0x00065, 0x00029, 0x00028, 0x00066, 0x00029, 0x00028,
+	0x00067, 0x00029, 0x00028, 0x00068, 0x00029, 0x00028, 0x00069, 0x00029,
+	0x00028, 0x0006a, 0x00029, 0x00028, 0x0006b, 0x00029, 0x00028, 0x0006c,
+	0x00029, 0x00028, 0x0006d, 0x00029, 0x00028, 0x0006e, 0x00029, 0x00028,
+	0x0006f, 0x00029, 0x00028, 0x00070, 0x00029, 0x00028, 0x00071, 0x00029,
+	0x00028, 0x00072, 0x00029, 0x00028, 0x00073, 0x00029, 0x00028, 0x00074,
+	0x00029, 0x00028, 0x00075, 0x00029, 0x00028, 0x00076, 0x00029, 0x00028,
+	0x00077, 0x00029, 0x00028, 0x00078, 0x00029, 0x00028, 0x00079, 0x00029,
+	0x00028, 0x0007a, 0x00029, 0x00041, 0x00042, 0x00043, 0x00044, 0x00045,
+	0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d,
+	0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054, 0x00055,
+	0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062, 0x00063,
+	0x00064, 0x00065, 0x00066, 0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b,
+	0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072, 0x00073,
+	0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a, 0x00030,
+	0x0222b, 0x0222b, 0x0222b, 0x0222b, 0x0003a, 0x0003a, 0x0003d, 0x0003d,
+	0x0003d, 0x0003d, 0x0003d, 0x0003d, 0x0006a, 0x00056, 0x02d61, 0x06bcd,
+	0x09f9f, 0x04e00, 0x04e28, 0x04e36, 0x04e3f, 0x04e59, 0x04e85, 0x04e8c,
+	0x04ea0, 0x04eba, 0x0513f, 0x05165, 0x0516b, 0x05182, 0x05196, 0x051ab,
+	0x051e0, 0x051f5, 0x05200, 0x0529b, 0x052f9, 0x05315, 0x0531a, 0x05338,
+	0x05341, 0x0535c, 0x05369, 0x05382, 0x053b6, 0x053c8, 0x053e3, 0x056d7,
+	0x0571f, 0x058eb, 0x05902, 0x0590a, 0x05915, 0x05927, 0x05973, 0x05b50,
+	0x05b80, 0x05bf8, 0x05c0f, 0x05c22, 0x05c38, 0x05c6e, 0x05c71, 0x05ddb,
+	0x05de5, 0x05df1, 0x05dfe, 0x05e72, 0x05e7a, 0x05e7f, 0x05ef4, 0x05efe,
+	0x05f0b, 0x05f13, 0x05f50, 0x05f61, 0x05f73, 0x05fc3, 0x06208, 0x06236,
+	0x0624b, 0x0652f, 0x06534, 0x06587, 0x06597, 0x065a4, 0x065b9, 0x065e0,
+	0x065e5, 0x066f0, 0x06708, 0x06728, 0x06b20, 0x06b62, 0x06b79, 0x06bb3,
+	0x06bcb, 0x06bd4, 0x06bdb, 0x06c0f, 0x06c14, 0x06c34, 0x0706b, 0x0722a,
+	0x07236, 0x0723b, 0x0723f, 0x07247, 0x07259, 0x0725b, 0x072ac, 0x07384,
+	0x07389, 0x074dc, 0x074e6, 0x07518, 0x0751f, 0x07528, 0x07530, 0x0758b,
+	0x07592, 0x07676, 0x0767d, 0x076ae, 0x076bf, 0x076ee, 0x077db, 0x077e2,
+	0x077f3, 0x0793a, 0x079b8, 0x079be, 0x07a74, 0x07acb, 0x07af9, 0x07c73,
+	0x07cf8, 0x07f36, 0x07f51, 0x07f8a, 0x07fbd, 0x08001, 0x0800c, 0x08012,
+	0x08033, 0x0807f, 0x08089, 0x081e3, 0x081ea, 0x081f3, 0x081fc, 0x0820c,
+	0x0821b, 0x0821f, 0x0826e, 0x08272, 0x08278, 0x0864d, 0x0866b, 0x08840,
+	0x0884c, 0x08863, 0x0897e, 0x0898b, 0x089d2, 0x08a00, 0x08c37, 0x08c46,
+	0x08c55, 0x08c78, 0x08c9d, 0x08d64, 0x08d70, 0x08db3, 0x08eab, 0x08eca,
+	0x08f9b, 0x08fb0, 0x08fb5, 0x09091, 0x09149, 0x091c6, 0x091cc, 0x091d1,
+	0x09577, 0x09580, 0x0961c, 0x096b6, 0x096b9, 0x096e8, 0x09751, 0x0975e,
+	0x09762, 0x09769, 0x097cb, 0x097ed, 0x097f3, 0x09801, 0x098a8, 0x098db,
+	0x098df, 0x09996, 0x09999, 0x099ac, 0x09aa8, 0x09ad8, 0x09adf, 0x09b25,
+	0x09b2f, 0x09b32, 0x09b3c, 0x09b5a, 0x09ce5, 0x09e75, 0x09e7f, 0x09ea5,
+	0x09ebb, 0x09ec3, 0x09ecd, 0x09ed1, 0x09ef9, 0x09efd, 0x09f0e, 0x09f13,
+	0x09f20, 0x09f3b, 0x09f4a, 0x09f52, 0x09f8d, 0x09f9c, 0x09fa0, 0x00020,
+	0x03012, 0x05341, 0x05344, 0x05345, 0x00020, 0x03099, 0x00020, 0x0309a,
+	0x03088, 0x0308a, 0x030b3, 0x030c8, 0x01100, 0x01101, 0x011aa, 0x01102,
+	0x011ac, 0x011ad, 0x01103, 0x01104, 0x01105, 0x011b0, 0x011b1, 0x011b2,
+	0x011b3, 0x011b4, 0x011b5, 0x0111a, 0x01106, 0x01107, 0x01108, 0x01121,
+	0x01109, 0x0110a, 0x0110b, 0x0110c, 0x0110d, 0x0110e, 0x0110f, 0x01110,
+	0x01111, 0x01112, 0x01161, 0x01162, 0x01163, 0x01164, 0x01165, 0x01166,
+	0x01167, 0x01168, 0x01169, 0x0116a, 0x0116b, 0x0116c, 0x0116d, 0x0116e,
+	0x0116f, 0x01170, 0x01171, 0x01172, 0x01173, 0x01174, 0x01175, 0x01160,
+	0x01114, 0x01115, 0x011c7, 0x011c8, 0x011cc, 0x011ce, 0x011d3, 0x011d7,
+	0x011d9, 0x0111c, 0x011dd, 0x011df, 0x0111d, 0x0111e, 0x01120, 0x01122,
+	0x01123, 0x01127, 0x01129, 0x0112b, 0x0112c, 0x0112d, 0x0112e, 0x0112f,
+	0x01132, 0x01136, 0x01140, 0x01147, 0x0114c, 0x011f1, 0x011f2, 0x01157,
+	0x01158, 0x01159, 0x01184, 0x01185, 0x01188, 0x01191, 0x01192, 0x01194,
+	0x0119e, 0x011a1, 0x04e00, 0x04e8c, 0x04e09, 0x056db, 0x04e0a, 0x04e2d,
+	0x04e0b, 0x07532, 0x04e59, 0x04e19, 0x04e01, 0x05929, 0x05730, 0x04eba,
+	0x00028, 0x01100, 0x00029, 0x00028, 0x01102, 0x00029, 0x00028, 0x01103,
+	0x00029, 0x00028, 0x01105, 0x00029, 0x00028, 0x01106, 0x00029, 0x00028,
+	0x01107, 0x00029, 0x00028, 0x01109, 0x00029, 0x00028, 0x0110b, 0x00029,
+	0x00028, 0x0110c, 0x00029, 0x00028, 0x0110e, 0x00029, 0x00028, 0x0110f,
+	0x00029, 0x00028, 0x01110, 0x00029, 0x00028, 0x01111, 0x00029, 0x00028,
+	0x01112, 0x00029, 0x00028, 0x01100, 0x01161, 0x00029, 0x00028, 0x01102,
+	0x01161, 0x00029, 0x00028, 0x01103, 0x01161, 0x00029, 0x00028, 0x01105,
+	0x01161, 0x00029, 0x00028, 0x01106, 0x01161, 0x00029, 0x00028, 0x01107,
+	0x01161, 0x00029, 0x00028, 0x01109, 0x01161, 0x00029, 0x00028, 0x0110b,
+	0x01161, 0x00029, 0x00028, 0x0110c, 0x01161, 0x00029, 0x00028, 0x0110e,
+	0x01161, 0x00029, 0x00028, 0x0110f, 0x01161, 0x00029, 0x00028, 0x01110,
+	0x01161, 0x00029, 0x00028, 0x01111, 0x01161, 0x00029, 0x00028, 0x01112,
+	0x01161, 0x00029, 0x00028, 0x0110c, 0x0116e, 0x00029, 0x00028, 0x0110b,
+	0x01169, 0x0110c, 0x01165, 0x011ab, 0x00029, 0x00028, 0x0110b, 0x01169,
+	0x01112, 0x0116e, 0x00029, 0x00028, 0x04e00, 0x00029, 0x00028, 0x04e8c,
+	0x00029, 0x00028, 0x04e09, 0x00029, 0x00028, 0x056db, 0x00029, 0x00028,
+	0x04e94, 0x00029, 0x00028, 0x0516d, 0x00029, 0x00028, 0x04e03, 0x00029,
+	0x00028, 0x0516b, 0x00029, 0x00028, 0x04e5d, 0x00029, 0x00028, 0x05341,
+	0x00029, 0x00028, 0x06708, 0x00029, 0x00028, 0x0706b, 0x00029, 0x00028,
+	0x06c34, 0x00029, 0x00028, 0x06728, 0x00029, 0x00028, 0x091d1, 0x00029,
+	0x00028, 0x0571f, 0x00029, 0x00028, 0x065e5, 0x00029, 0x00028, 0x0682a,
+	0x00029, 0x00028, 0x06709, 0x00029, 0x00028, 0x0793e, 0x00029, 0x00028,
+	0x0540d, 0x00029, 0x00028, 0x07279, 0x00029, 0x00028, 0x08ca1, 0x00029,
+	0x00028, 0x0795d, 0x00029, 0x00028, 0x052b4, 0x00029, 0x00028, 0x04ee3,
+	0x00029, 0x00028, 0x0547c, 0x00029, 0x00028, 0x05b66, 0x00029, 0x00028,
+	0x076e3, 0x00029, 0x00028, 0x04f01, 0x00029, 0x00028, 0x08cc7, 0x00029,
+	0x00028, 0x05354, 0x00029, 0x00028, 0x0796d, 0x00029, 0x00028, 0x04f11,
+	0x00029, 0x00028, 0x081ea, 0x00029, 0x00028, 0x081f3, 0x00029, 0x0554f,
+	0x05e7c, 0x06587, 0x07b8f, 0x00050, 0x00054, 0x00045, 0x00032, 0x00031,
+	0x00032, 0x00032, 0x00032, 0x00033, 0x00032, 0x00034, 0x00032, 0x00035,
+	0x00032, 0x00036, 0x00032, 0x00037, 0x00032, 0x00038, 0x00032, 0x00039,
+	0x00033, 0x00030, 0x00033, 0x00031, 0x00033, 0x00032, 0x00033, 0x00033,
+	0x00033, 0x00034, 0x00033, 0x00035, 0x01100, 0x01102, 0x01103, 0x01105,
+	0x01106, 0x01107, 0x01109, 0x0110b, 0x0110c, 0x0110e, 0x0110f, 0x01110,
+	0x01111, 0x01112, 0x01100, 0x01161, 0x01102, 0x01161, 0x01103, 0x01161,
+	0x01105, 0x01161, 0x01106, 0x01161, 0x01107, 0x01161, 0x01109, 0x01161,
+	0x0110b, 0x01161, 0x0110c, 0x01161, 0x0110e, 0x01161, 0x0110f, 0x01161,
+	0x01110, 0x01161, 0x01111, 0x01161, 0x01112, 0x01161, 0x0110e, 0x01161,
+	0x011b7, 0x01100, 0x01169, 0x0110c, 0x0116e, 0x0110b, 0x01174, 0x0110b,
+	0x0116e, 0x04e00, 0x04e8c, 0x04e09, 0x056db, 0x04e94, 0x0516d, 0x04e03,
+	0x0516b, 0x04e5d, 0x05341, 0x06708, 0x0706b, 0x06c34, 0x06728, 0x091d1,
+	0x0571f, 0x065e5, 0x0682a, 0x06709, 0x0793e, 0x0540d, 0x07279, 0x08ca1,
+	0x0795d, 0x052b4, 0x079d8, 0x07537, 0x05973, 0x09069, 0x0512a, 0x05370,
+	0x06ce8, 0x09805, 0x04f11, 0x05199, 0x06b63, 0x04e0a, 0x04e2d, 0x04e0b,
+	0x05de6, 0x053f3, 0x0533b, 0x05b97, 0x05b66, 0x076e3, 0x04f01, 0x08cc7,
+	0x05354, 0x0591c, 0x00033, 0x00036, 0x00033, 0x00037, 0x00033, 0x00038,
+	0x00033, 0x00039, 0x00034, 0x00030, 0x00034, 0x00031, 0x00034, 0x00032,
+	0x00034, 0x00033, 0x00034, 0x00034, 0x00034, 0x00035, 0x00034, 0x00036,
+	0x00034, 0x00037, 0x00034, 0x00038, 0x00034, 0x00039, 0x00035, 0x00030,
+	0x00031, 0x06708, 0x00032, 0x06708, 0x00033, 0x06708, 0x00034, 0x06708,
+	0x00035, 0x06708, 0x00036, 0x06708, 0x00037, 0x06708, 0x00038, 0x06708,
+	0x00039, 0x06708, 0x00031, 0x00030, 0x06708, 0x00031, 0x00031, 0x06708,
+	0x00031, 0x00032, 0x06708, 0x00048, 0x00067, 0x00065, 0x00072, 0x00067,
+	0x00065, 0x00056, 0x0004c, 0x00054, 0x00044, 0x030a2, 0x030a4, 0x030a6,
+	0x030a8, 0x030aa, 0x030ab, 0x030ad, 0x030af, 0x030b1, 0x030b3, 0x030b5,
+	0x030b7, 0x030b9, 0x030bb, 0x030bd, 0x030bf, 0x030c1, 0x030c4, 0x030c6,
+	0x030c8, 0x030ca, 0x030cb, 0x030cc, 0x030cd, 0x030ce, 0x030cf, 0x030d2,
+	0x030d5, 0x030d8, 0x030db, 0x030de, 0x030df, 0x030e0, 0x030e1, 0x030e2,
+	0x030e4, 0x030e6, 0x030e8, 0x030e9, 0x030ea, 0x030eb, 0x030ec, 0x030ed,
+	0x030ef, 0x030f0, 0x030f1, 0x030f2, 0x04ee4, 0x0548c, 0x030a2, 0x030cf,
+	0x0309a, 0x030fc, 0x030c8, 0x030a2, 0x030eb, 0x030d5, 0x030a1, 0x030a2,
+	0x030f3, 0x030d8, 0x0309a, 0x030a2, 0x030a2, 0x030fc, 0x030eb, 0x030a4,
+	0x030cb, 0x030f3, 0x030af, 0x03099, 0x030a4, 0x030f3, 0x030c1, 0x030a6,
+	0x030a9, 0x030f3, 0x030a8, 0x030b9, 0x030af, 0x030fc, 0x030c8, 0x03099,
+	0x030a8, 0x030fc, 0x030ab, 0x030fc, 0x030aa, 0x030f3, 0x030b9, 0x030aa,
+	0x030fc, 0x030e0, 0x030ab, 0x030a4, 0x030ea, 0x030ab, 0x030e9, 0x030c3,
+	0x030c8, 0x030ab, 0x030ed, 0x030ea, 0x030fc, 0x030ab, 0x03099, 0x030ed,
+	0x030f3, 0x030ab, 0x03099, 0x030f3, 0x030de, 0x030ad, 0x03099, 0x030ab,
+	0x03099, 0x030ad, 0x03099, 0x030cb, 0x030fc, 0x030ad, 0x030e5, 0x030ea,
+	0x030fc, 0x030ad, 0x03099, 0x030eb, 0x030bf, 0x03099, 0x030fc, 0x030ad,
+	0x030ed, 0x030ad, 0x030ed, 0x030af, 0x03099, 0x030e9, 0x030e0, 0x030ad,
+	0x030ed, 0x030e1, 0x030fc, 0x030c8, 0x030eb, 0x030ad, 0x030ed, 0x030ef,
+	0x030c3, 0x030c8, 0x030af, 0x03099, 0x030e9, 0x030e0, 0x030af, 0x03099,
+	0x030e9, 0x030e0, 0x030c8, 0x030f3, 0x030af, 0x030eb, 0x030bb, 0x03099,
+	0x030a4, 0x030ed, 0x030af, 0x030ed, 0x030fc, 0x030cd, 0x030b1, 0x030fc,
+	0x030b9, 0x030b3, 0x030eb, 0x030ca, 0x030b3, 0x030fc, 0x030db, 0x0309a,
+	0x030b5, 0x030a4, 0x030af, 0x030eb, 0x030b5, 0x030f3, 0x030c1, 0x030fc,
+	0x030e0, 0x030b7, 0x030ea, 0x030f3, 0x030af, 0x03099, 0x030bb, 0x030f3,
+	0x030c1, 0x030bb, 0x030f3, 0x030c8, 0x030bf, 0x03099, 0x030fc, 0x030b9,
+	0x030c6, 0x03099, 0x030b7, 0x030c8, 0x03099, 0x030eb, 0x030c8, 0x030f3,
+	0x030ca, 0x030ce, 0x030ce, 0x030c3, 0x030c8, 0x030cf, 0x030a4, 0x030c4,
+	0x030cf, 0x0309a, 0x030fc, 0x030bb, 0x030f3, 0x030c8, 0x030cf, 0x0309a,
+	0x030fc, 0x030c4, 0x030cf, 0x03099, 0x030fc, 0x030ec, 0x030eb, 0x030d2,
+	0x0309a, 0x030a2, 0x030b9, 0x030c8, 0x030eb, 0x030d2, 0x0309a, 0x030af,
+	0x030eb, 0x030d2, 0x0309a, 0x030b3, 0x030d2, 0x03099, 0x030eb, 0x030d5,
+	0x030a1, 0x030e9, 0x030c3, 0x030c8, 0x03099, 0x030d5, 0x030a3, 0x030fc,
+	0x030c8, 0x030d5, 0x03099, 0x030c3, 0x030b7, 0x030a7, 0x030eb, 0x030d5,
+	0x030e9, 0x030f3, 0x030d8, 0x030af, 0x030bf, 0x030fc, 0x030eb, 0x030d8,
+	0x0309a, 0x030bd, 0x030d8, 0x0309a, 0x030cb, 0x030d2, 0x030d8, 0x030eb,
+	0x030c4, 0x030d8, 0x0309a, 0x030f3, 0x030b9, 0x030d8, 0x0309a, 0x030fc,
+	0x030b7, 0x03099, 0x030d8, 0x03099, 0x030fc, 0x030bf, 0x030db, 0x0309a,
+	0x030a4, 0x030f3, 0x030c8, 0x030db, 0x03099, 0x030eb, 0x030c8, 0x030db,
+	0x030f3, 0x030db, 0x0309a, 0x030f3, 0x030c8, 0x03099, 0x030db, 0x030fc,
+	0x030eb, 0x030db, 0x030fc, 0x030f3, 0x030de, 0x030a4, 0x030af, 0x030ed,
+	0x030de, 0x030a4, 0x030eb, 0x030de, 0x030c3, 0x030cf, 0x030de, 0x030eb,
+	0x030af, 0x030de, 0x030f3, 0x030b7, 0x030e7, 0x030f3, 0x030df, 0x030af,
+	0x030ed, 0x030f3, 0x030df, 0x030ea, 0x030df, 0x030ea, 0x030cf, 0x03099,
+	0x030fc, 0x030eb, 0x030e1, 0x030ab, 0x03099, 0x030e1, 0x030ab, 0x03099,
+	0x030c8, 0x030f3, 0x030e1, 0x030fc, 0x030c8, 0x030eb, 0x030e4, 0x030fc,
+	0x030c8, 0x03099, 0x030e4, 0x030fc, 0x030eb, 0x030e6, 0x030a2, 0x030f3,
+	0x030ea, 0x030c3, 0x030c8, 0x030eb, 0x030ea, 0x030e9, 0x030eb, 0x030d2,
+	0x0309a, 0x030fc, 0x030eb, 0x030fc, 0x030d5, 0x03099, 0x030eb, 0x030ec,
+	0x030e0, 0x030ec, 0x030f3, 0x030c8, 0x030b1, 0x03099, 0x030f3, 0x030ef,
+	0x030c3, 0x030c8, 0x00030, 0x070b9, 0x00031, 0x070b9, 0x00032, 0x070b9,
+	0x00033, 0x070b9, 0x00034, 0x070b9, 0x00035, 0x070b9, 0x00036, 0x070b9,
+	0x00037, 0x070b9, 0x00038, 0x070b9, 0x00039, 0x070b9, 0x00031, 0x00030,
+	0x070b9, 0x00031, 0x00031, 0x070b9, 0x00031, 0x00032, 0x070b9, 0x00031,
+	0x00033, 0x070b9, 0x00031, 0x00034, 0x070b9, 0x00031, 0x00035, 0x070b9,
+	0x00031, 0x00036, 0x070b9, 0x00031, 0x00037, 0x070b9, 0x00031, 0x00038,
+	0x070b9, 0x00031, 0x00039, 0x070b9, 0x00032, 0x00030, 0x070b9, 0x00032,
+	0x00031, 0x070b9, 0x00032, 0x00032, 0x070b9, 0x00032, 0x00033, 0x070b9,
+	0x00032, 0x00034, 0x070b9, 0x00068, 0x00050, 0x00061, 0x00064, 0x00061,
+	0x00041, 0x00055, 0x00062, 0x00061, 0x00072, 0x0006f, 0x00056, 0x00070,
+	0x00063, 0x00064, 0x0006d, 0x00064, 0x0006d, 0x00032, 0x00064, 0x0006d,
+	0x00033, 0x00049, 0x00055, 0x05e73, 0x06210, 0x0662d, 0x0548c, 0x05927,
+	0x06b63, 0x0660e, 0x06cbb, 0x0682a, 0x05f0f, 0x04f1a, 0x0793e, 0x00070,
+	0x00041, 0x0006e, 0x00041, 0x003bc, 0x00041, 0x0006d, 0x00041, 0x0006b,
+	0x00041, 0x0004b, 0x00042, 0x0004d, 0x00042, 0x00047, 0x00042, 0x00063,
+	0x00061, 0x0006c, 0x0006b, 0x00063, 0x00061, 0x0006c, 0x00070, 0x00046,
+	0x0006e, 0x00046, 0x003bc, 0x00046, 0x003bc, 0x00067, 0x0006d, 0x00067,
+	0x0006b, 0x00067, 0x00048, 0x0007a, 0x0006b, 0x00048, 0x0007a, 0x0004d,
+	0x00048, 0x0007a, 0x00047, 0x00048, 0x0007a, 0x00054, 0x00048, 0x0007a,
+	0x003bc, 0x0006c, 0x0006d, 0x0006c, 0x00064, 0x0006c, 0x0006b, 0x0006c,
+	0x00066, 0x0006d, 0x0006e, 0x0006d, 0x003bc, 0x0006d, 0x0006d, 0x0006d,
+	0x00063, 0x0006d, 0x0006b, 0x0006d, 0x0006d, 0x0006d, 0x00032, 0x00063,
+	0x0006d, 0x00032, 0x0006d, 0x00032, 0x0006b, 0x0006d, 0x00032, 0x0006d,
+	0x0006d, 0x00033, 0x00063, 0x0006d, 0x00033, 0x0006d, 0x00033, 0x0006b,
+	0x0006d, 0x00033, 0x0006d, 0x02215, 0x00073, 0x0006d, 0x02215, 0x00073,
+	0x00032, 0x00050, 0x00061, 0x0006b, 0x00050, 0x00061, 0x0004d, 0x00050,
+	0x00061, 0x00047, 0x00050, 0x00061, 0x00072, 0x00061, 0x00064, 0x00072,
+	0x00061, 0x00064, 0x02215, 0x00073, 0x00072, 0x00061, 0x00064, 0x02215,
+	0x00073, 0x00032, 0x00070, 0x00073, 0x0006e, 0x00073, 0x003bc, 0x00073,
+	0x0006d, 0x00073, 0x00070, 0x00056, 0x0006e, 0x00056, 0x003bc, 0x00056,
+	0x0006d, 0x00056, 0x0006b, 0x00056, 0x0004d, 0x00056, 0x00070, 0x00057,
+	0x0006e, 0x00057, 0x003bc, 0x00057, 0x0006d, 0x00057, 0x0006b, 0x00057,
+	0x0004d, 0x00057, 0x0006b, 0x003a9, 0x0004d, 0x003a9, 0x00061, 0x0002e,
+	0x0006d, 0x0002e, 0x00042, 0x00071, 0x00063, 0x00063, 0x00063, 0x00064,
+	0x00043, 0x02215, 0x0006b, 0x00067, 0x00043, 0x0006f, 0x0002e, 0x00064,
+	0x00042, 0x00047, 0x00079, 0x00068, 0x00061, 0x00048, 0x00050, 0x00069,
+	0x0006e, 0x0004b, 0x0004b, 0x0004b, 0x0004d, 0x0006b, 0x00074, 0x0006c,
+	0x0006d, 0x0006c, 0x0006e, 0x0006c, 0x0006f, 0x00067, 0x0006c, 0x00078,
+	0x0006d, 0x00062, 0x0006d, 0x00069, 0x0006c, 0x0006d, 0x0006f, 0x0006c,
+	0x00050, 0x00048, 0x00070, 0x0002e, 0x0006d, 0x0002e, 0x00050, 0x00050,
+	0x0004d, 0x00050, 0x00052, 0x00073, 0x00072, 0x00053, 0x00076, 0x00057,
+	0x00062, 0x00056, 0x02215, 0x0006d, 0x00041, 0x02215, 0x0006d, 0x00031,
+	0x065e5, 0x00032, 0x065e5, 0x00033, 0x065e5, 0x00034, 0x065e5, 0x00035,
+	0x065e5, 0x00036, 0x065e5, 0x00037, 0x065e5, 0x00038, 0x065e5, 0x00039,
+	0x065e5, 0x00031, 0x00030, 0x065e5, 0x00031, 0x00031, 0x065e5, 0x00031,
+	0x00032, 0x065e5, 0x00031, 0x00033, 0x065e5, 0x00031, 0x00034, 0x065e5,
+	0x00031, 0x00035, 0x065e5, 0x00031, 0x00036, 0x065e5, 0x00031, 0x00037,
+	0x065e5, 0x00031, 0x00038, 0x065e5, 0x00031, 0x00039, 0x065e5, 0x00032,
+	0x00030, 0x065e5, 0x00032, 0x00031, 0x065e5, 0x00032, 0x00032, 0x065e5,
+	0x00032, 0x00033, 0x065e5, 0x00032, 0x00034, 0x065e5, 0x00032, 0x00035,
+	0x065e5, 0x00032, 0x00036, 0x065e5, 0x00032, 0x00037, 0x065e5, 0x00032,
+	0x00038, 0x065e5, 0x00032, 0x00039, 0x065e5, 0x00033, 0x00030, 0x065e5,
+	0x00033, 0x00031, 0x065e5, 0x00067, 0x00061, 0x0006c, 0x0044a, 0x0044c,
+	0x0a76f, 0x00053, 0x00043, 0x00046, 0x00051, 0x00126, 0x00153, 0x0a727,
+	0x0ab37, 0x0026b, 0x0ab52, 0x0028d, 0x00066, 0x00066, 0x00066, 0x00069,
+	0x00066, 0x0006c, 0x00066, 0x00066, 0x00069, 0x00066, 0x00066, 0x0006c,
+	0x00073, 0x00074, 0x00073, 0x00074, 0x00574, 0x00576, 0x00574, 0x00565,
+	0x00574, 0x0056b, 0x0057e, 0x00576, 0x00574, 0x0056d, 0x005e2, 0x005d0,
+	0x005d3, 0x005d4, 0x005db, 0x005dc, 0x005dd, 0x005e8, 0x005ea, 0x0002b,
+	0x005d0, 0x005dc, 0x00671, 0x00671, 0x0067b, 0x0067b, 0x0067b, 0x0067b,
+	0x0067e, 0x0067e, 0x0067e, 0x0067e, 0x00680, 0x00680, 0x00680, 0x00680,
+	0x0067a, 0x0067a, 0x0067a, 0x0067a, 0x0067f, 0x0067f, 0x0067f, 0x0067f,
+	0x00679, 0x00679, 0x00679, 0x00679, 0x006a4, 0x006a4, 0x006a4, 0x006a4,
+	0x006a6, 0x006a6, 0x006a6, 0x006a6, 0x00684, 0x00684, 0x00684, 0x00684,
+	0x00683, 0x00683, 0x00683, 0x00683, 0x00686, 0x00686, 0x00686, 0x00686,
+	0x00687, 0x00687, 0x00687, 0x00687, 0x0068d, 0x0068d, 0x0068c, 0x0068c,
+	0x0068e, 0x0068e, 0x00688, 0x00688, 0x00698, 0x00698, 0x00691, 0x00691,
+	0x006a9, 0x006a9, 0x006a9, 0x006a9, 0x006af, 0x006af, 0x006af, 0x006af,
+	0x006b3, 0x006b3, 0x006b3, 0x006b3, 0x006b1, 0x006b1, 0x006b1, 0x006b1,
+	0x006ba, 0x006ba, 0x006bb, 0x006bb, 0x006bb, 0x006bb, 0x006d5, 0x00654,
+	0x006d5, 0x00654, 0x006c1, 0x006c1, 0x006c1, 0x006c1, 0x006be, 0x006be,
+	0x006be, 0x006be, 0x006d2, 0x006d2, 0x006d2, 0x00654, 0x006d2, 0x00654,
+	0x006ad, 0x006ad, 0x006ad, 0x006ad, 0x006c7, 0x006c7, 0x006c6, 0x006c6,
+	0x006c8, 0x006c8, 0x006c7, 0x00674, 0x006cb, 0x006cb, 0x006c5, 0x006c5,
+	0x006c9, 0x006c9, 0x006d0, 0x006d0, 0x006d0, 0x006d0, 0x00649, 0x00649,
+	0x0064a, 0x00654, 0x00627, 0x0064a, 0x00654, 0x00627, 0x0064a, 0x00654,
+	0x006d5, 0x0064a, 0x00654, 0x006d5, 0x0064a, 0x00654, 0x00648, 0x0064a,
+	0x00654, 0x00648, 0x0064a, 0x00654, 0x006c7, 0x0064a, 0x00654, 0x006c7,
+	0x0064a, 0x00654, 0x006c6, 0x0064a, 0x00654, 0x006c6, 0x0064a, 0x00654,
+	0x006c8, 0x0064a, 0x00654, 0x006c8, 0x0064a, 0x00654, 0x006d0, 0x0064a,
+	0x00654, 0x006d0, 0x0064a, 0x00654, 0x006d0, 0x0064a, 0x00654, 0x00649,
+	0x0064a, 0x00654, 0x00649, 0x0064a, 0x00654, 0x00649, 0x006cc, 0x006cc,
+	0x006cc, 0x006cc, 0x0064a, 0x00654, 0x0062c, 0x0064a, 0x00654, 0x0062d,
+	0x0064a, 0x00654, 0x00645, 0x0064a, 0x00654, 0x00649, 0x0064a, 0x00654,
+	0x0064a, 0x00628, 0x0062c, 0x00628, 0x0062d, 0x00628, 0x0062e, 0x00628,
+	0x00645, 0x00628, 0x00649, 0x00628, 0x0064a, 0x0062a, 0x0062c, 0x0062a,
+	0x0062d, 0x0062a, 0x0062e, 0x0062a, 0x00645, 0x0062a, 0x00649, 0x0062a,
+	0x0064a, 0x0062b, 0x0062c, 0x0062b, 0x00645, 0x0062b, 0x00649, 0x0062b,
+	0x0064a, 0x0062c, 0x0062d, 0x0062c, 0x00645, 0x0062d, 0x0062c, 0x0062d,
+	0x00645, 0x0062e, 0x0062c, 0x0062e, 0x0062d, 0x0062e, 0x00645, 0x00633,
+	0x0062c, 0x00633, 0x0062d, 0x00633, 0x0062e, 0x00633, 0x00645, 0x00635,
+	0x0062d, 0x00635, 0x00645, 0x00636, 0x0062c, 0x00636, 0x0062d, 0x00636,
+	0x0062e, 0x00636, 0x00645, 0x00637, 0x0062d, 0x00637, 0x00645, 0x00638,
+	0x00645, 0x00639, 0x0062c, 0x00639, 0x00645, 0x0063a, 0x0062c, 0x0063a,
+	0x00645, 0x00641, 0x0062c, 0x00641, 0x0062d, 0x00641, 0x0062e, 0x00641,
+	0x00645, 0x00641, 0x00649, 0x00641, 0x0064a, 0x00642, 0x0062d, 0x00642,
+	0x00645, 0x00642, 0x00649, 0x00642, 0x0064a, 0x00643, 0x00627, 0x00643,
+	0x0062c, 0x00643, 0x0062d, 0x00643, 0x0062e, 0x00643, 0x00644, 0x00643,
+	0x00645, 0x00643, 0x00649, 0x00643, 0x0064a, 0x00644, 0x0062c, 0x00644,
+	0x0062d, 0x00644, 0x0062e, 0x00644, 0x00645, 0x00644, 0x00649, 0x00644,
+	0x0064a, 0x00645, 0x0062c, 0x00645, 0x0062d, 0x00645, 0x0062e, 0x00645,
+	0x00645, 0x00645, 0x00649, 0x00645, 0x0064a, 0x00646, 0x0062c, 0x00646,
+	0x0062d, 0x00646, 0x0062e, 0x00646, 0x00645, 0x00646, 0x00649, 0x00646,
+	0x0064a, 0x00647, 0x0062c, 0x00647, 0x00645, 0x00647, 0x00649, 0x00647,
+	0x0064a, 0x0064a, 0x0062c, 0x0064a, 0x0062d, 0x0064a, 0x0062e, 0x0064a,
+	0x00645, 0x0064a, 0x00649, 0x0064a, 0x0064a, 0x00630, 0x00670, 0x00631,
+	0x00670, 0x00649, 0x00670, 0x00020, 0x0064c, 0x00651, 0x00020, 0x0064d,
+	0x00651, 0x00020, 0x0064e, 0x00651, 0x00020, 0x0064f, 0x00651, 0x00020,
+	0x00650, 0x00651, 0x00020, 0x00651, 0x00670, 0x0064a, 0x00654, 0x00631,
+	0x0064a, 0x00654, 0x00632, 0x0064a, 0x00654, 0x00645, 0x0064a, 0x00654,
+	0x00646, 0x0064a, 0x00654, 0x00649, 0x0064a, 0x00654, 0x0064a, 0x00628,
+	0x00631, 0x00628, 0x00632, 0x00628, 0x00645, 0x00628, 0x00646, 0x00628,
+	0x00649, 0x00628, 0x0064a, 0x0062a, 0x00631, 0x0062a, 0x00632, 0x0062a,
+	0x00645, 0x0062a, 0x00646, 0x0062a, 0x00649, 0x0062a, 0x0064a, 0x0062b,
+	0x00631, 0x0062b, 0x00632, 0x0062b, 0x00645, 0x0062b, 0x00646, 0x0062b,
+	0x00649, 0x0062b, 0x0064a, 0x00641, 0x00649, 0x00641, 0x0064a, 0x00642,
+	0x00649, 0x00642, 0x0064a, 0x00643, 0x00627, 0x00643, 0x00644, 0x00643,
+	0x00645, 0x00643, 0x00649, 0x00643, 0x0064a, 0x00644, 0x00645, 0x00644,
+	0x00649, 0x00644, 0x0064a, 0x00645, 0x00627, 0x00645, 0x00645, 0x00646,
+	0x00631, 0x00646, 0x00632, 0x00646, 0x00645, 0x00646, 0x00646, 0x00646,
+	0x00649, 0x00646, 0x0064a, 0x00649, 0x00670, 0x0064a, 0x00631, 0x0064a,
+	0x00632, 0x0064a, 0x00645, 0x0064a, 0x00646, 0x0064a, 0x00649, 0x0064a,
+	0x0064a, 0x0064a, 0x00654, 0x0062c, 0x0064a, 0x00654, 0x0062d, 0x0064a,
+	0x00654, 0x0062e, 0x0064a, 0x00654, 0x00645, 0x0064a, 0x00654, 0x00647,
+	0x00628, 0x0062c, 0x00628, 0x0062d, 0x00628, 0x0062e, 0x00628, 0x00645,
+	0x00628, 0x00647, 0x0062a, 0x0062c, 0x0062a, 0x0062d, 0x0062a, 0x0062e,
+	0x0062a, 0x00645, 0x0062a, 0x00647, 0x0062b, 0x00645, 0x0062c, 0x0062d,
+	0x0062c, 0x00645, 0x0062d, 0x0062c, 0x0062d, 0x00645, 0x0062e, 0x0062c,
+	0x0062e, 0x00645, 0x00633, 0x0062c, 0x00633, 0x0062d, 0x00633, 0x0062e,
+	0x00633, 0x00645, 0x00635, 0x0062d, 0x00635, 0x0062e, 0x00635, 0x00645,
+	0x00636, 0x0062c, 0x00636, 0x0062d, 0x00636, 0x0062e, 0x00636, 0x00645,
+	0x00637, 0x0062d, 0x00638, 0x00645, 0x00639, 0x0062c, 0x00639, 0x00645,
+	0x0063a, 0x0062c, 0x0063a, 0x00645, 0x00641, 0x0062c, 0x00641, 0x0062d,
+	0x00641, 0x0062e, 0x00641, 0x00645, 0x00642, 0x0062d, 0x00642, 0x00645,
+	0x00643, 0x0062c, 0x00643, 0x0062d, 0x00643, 0x0062e, 0x00643, 0x00644,
+	0x00643, 0x00645, 0x00644, 0x0062c, 0x00644, 0x0062d, 0x00644, 0x0062e,
+	0x00644, 0x00645, 0x00644, 0x00647, 0x00645, 0x0062c, 0x00645, 0x0062d,
+	0x00645, 0x0062e, 0x00645, 0x00645, 0x00646, 0x0062c, 0x00646, 0x0062d,
+	0x00646, 0x0062e, 0x00646, 0x00645, 0x00646, 0x00647, 0x00647, 0x0062c,
+	0x00647, 0x00645, 0x00647, 0x00670, 0x0064a, 0x0062c, 0x0064a, 0x0062d,
+	0x0064a, 0x0062e, 0x0064a, 0x00645, 0x0064a, 0x00647, 0x0064a, 0x00654,
+	0x00645, 0x0064a, 0x00654, 0x00647, 0x00628, 0x00645, 0x00628, 0x00647,
+	0x0062a, 0x00645, 0x0062a, 0x00647, 0x0062b, 0x00645, 0x0062b, 0x00647,
+	0x00633, 0x00645, 0x00633, 0x00647, 0x00634, 0x00645, 0x00634, 0x00647,
+	0x00643, 0x00644, 0x00643, 0x00645, 0x00644, 0x00645, 0x00646, 0x00645,
+	0x00646, 0x00647, 0x0064a, 0x00645, 0x0064a, 0x00647, 0x00640, 0x0064e,
+	0x00651, 0x00640, 0x0064f, 0x00651, 0x00640, 0x00650, 0x00651, 0x00637,
+	0x00649, 0x00637, 0x0064a, 0x00639, 0x00649, 0x00639, 0x0064a, 0x0063a,
+	0x00649, 0x0063a, 0x0064a, 0x00633, 0x00649, 0x00633, 0x0064a, 0x00634,
+	0x00649, 0x00634, 0x0064a, 0x0062d, 0x00649, 0x0062d, 0x0064a, 0x0062c,
+	0x00649, 0x0062c, 0x0064a, 0x0062e, 0x00649, 0x0062e, 0x0064a, 0x00635,
+	0x00649, 0x00635, 0x0064a, 0x00636, 0x00649, 0x00636, 0x0064a, 0x00634,
+	0x0062c, 0x00634, 0x0062d, 0x00634, 0x0062e, 0x00634, 0x00645, 0x00634,
+	0x00631, 0x00633, 0x00631, 0x00635, 0x00631, 0x00636, 0x00631, 0x00637,
+	0x00649, 0x00637, 0x0064a, 0x00639, 0x00649, 0x00639, 0x0064a, 0x0063a,
+	0x00649, 0x0063a, 0x0064a, 0x00633, 0x00649, 0x00633, 0x0064a, 0x00634,
+	0x00649, 0x00634, 0x0064a, 0x0062d, 0x00649, 0x0062d, 0x0064a, 0x0062c,
+	0x00649, 0x0062c, 0x0064a, 0x0062e, 0x00649, 0x0062e, 0x0064a, 0x00635,
+	0x00649, 0x00635, 0x0064a, 0x00636, 0x00649, 0x00636, 0x0064a, 0x00634,
+	0x0062c, 0x00634, 0x0062d, 0x00634, 0x0062e, 0x00634, 0x00645, 0x00634,
+	0x00631, 0x00633, 0x00631, 0x00635, 0x00631, 0x00636, 0x00631, 0x00634,
+	0x0062c, 0x00634, 0x0062d, 0x00634, 0x0062e, 0x00634, 0x00645, 0x00633,
+	0x00647, 0x00634, 0x00647, 0x00637, 0x00645, 0x00633, 0x0062c, 0x00633,
+	0x0062d, 0x00633, 0x0062e, 0x00634, 0x0062c, 0x00634, 0x0062d, 0x00634,
+	0x0062e, 0x00637, 0x00645, 0x00638, 0x00645, 0x00627, 0x0064b, 0x00627,
+	0x0064b, 0x0062a, 0x0062c, 0x00645, 0x0062a, 0x0062d, 0x0062c, 0x0062a,
+	0x0062d, 0x0062c, 0x0062a, 0x0062d, 0x00645, 0x0062a, 0x0062e, 0x00645,
+	0x0062a, 0x00645, 0x0062c, 0x0062a, 0x00645, 0x0062d, 0x0062a, 0x00645,
+	0x0062e, 0x0062c, 0x00645, 0x0062d, 0x0062c, 0x00645, 0x0062d, 0x0062d,
+	0x00645, 0x0064a, 0x0062d, 0x00645, 0x00649, 0x00633, 0x0062d, 0x0062c,
+	0x00633, 0x0062c, 0x0062d, 0x00633, 0x0062c, 0x00649, 0x00633, 0x00645,
+	0x0062d, 0x00633, 0x00645, 0x0062d, 0x00633, 0x00645, 0x0062c, 0x00633,
+	0x00645, 0x00645, 0x00633, 0x00645, 0x00645, 0x00635, 0x0062d, 0x0062d,
+	0x00635, 0x0062d, 0x0062d, 0x00635, 0x00645, 0x00645, 0x00634, 0x0062d,
+	0x00645, 0x00634, 0x0062d, 0x00645, 0x00634, 0x0062c, 0x0064a, 0x00634,
+	0x00645, 0x0062e, 0x00634, 0x00645, 0x0062e, 0x00634, 0x00645, 0x00645,
+	0x00634, 0x00645, 0x00645, 0x00636, 0x0062d, 0x00649, 0x00636, 0x0062e,
+	0x00645, 0x00636, 0x0062e, 0x00645, 0x00637, 0x00645, 0x0062d, 0x00637,
+	0x00645, 0x0062d, 0x00637, 0x00645, 0x00645, 0x00637, 0x00645, 0x0064a,
+	0x00639, 0x0062c, 0x00645, 0x00639, 0x00645, 0x00645, 0x00639, 0x00645,
+	0x00645, 0x00639, 0x00645, 0x00649, 0x0063a, 0x00645, 0x00645, 0x0063a,
+	0x00645, 0x0064a, 0x0063a, 0x00645, 0x00649, 0x00641, 0x0062e, 0x00645,
+	0x00641, 0x0062e, 0x00645, 0x00642, 0x00645, 0x0062d, 0x00642, 0x00645,
+	0x00645, 0x00644, 0x0062d, 0x00645, 0x00644, 0x0062d, 0x0064a, 0x00644,
+	0x0062d, 0x00649, 0x00644, 0x0062c, 0x0062c, 0x00644, 0x0062c, 0x0062c,
+	0x00644, 0x0062e, 0x00645, 0x00644, 0x0062e, 0x00645, 0x00644, 0x00645,
+	0x0062d, 0x00644, 0x00645, 0x0062d, 0x00645, 0x0062d, 0x0062c, 0x00645,
+	0x0062d, 0x00645, 0x00645, 0x0062d, 0x0064a, 0x00645, 0x0062c, 0x0062d,
+	0x00645, 0x0062c, 0x00645, 0x00645, 0x0062e, 0x0062c, 0x00645, 0x0062e,
+	0x00645, 0x00645, 0x0062c, 0x0062e, 0x00647, 0x00645, 0x0062c, 0x00647,
+	0x00645, 0x00645, 0x00646, 0x0062d, 0x00645, 0x00646, 0x0062d, 0x00649,
+	0x00646, 0x0062c, 0x00645, 0x00646, 0x0062c, 0x00645, 0x00646, 0x0062c,
+	0x00649, 0x00646, 0x00645, 0x0064a, 0x00646, 0x00645, 0x00649, 0x0064a,
+	0x00645, 0x00645, 0x0064a, 0x00645, 0x00645, 0x00628, 0x0062e, 0x0064a,
+	0x0062a, 0x0062c, 0x0064a, 0x0062a, 0x0062c, 0x00649, 0x0062a, 0x0062e,
+	0x0064a, 0x0062a, 0x0062e, 0x00649, 0x0062a, 0x00645, 0x0064a, 0x0062a,
+	0x00645, 0x00649, 0x0062c, 0x00645, 0x0064a, 0x0062c, 0x0062d, 0x00649,
+	0x0062c, 0x00645, 0x00649, 0x00633, 0x0062e, 0x00649, 0x00635, 0x0062d,
+	0x0064a, 0x00634, 0x0062d, 0x0064a, 0x00636, 0x0062d, 0x0064a, 0x00644,
+	0x0062c, 0x0064a, 0x00644, 0x00645, 0x0064a, 0x0064a, 0x0062d, 0x0064a,
+	0x0064a, 0x0062c, 0x0064a, 0x0064a, 0x00645, 0x0064a, 0x00645, 0x00645,
+	0x0064a, 0x00642, 0x00645, 0x0064a, 0x00646, 0x0062d, 0x0064a, 0x00642,
+	0x00645, 0x0062d, 0x00644, 0x0062d, 0x00645, 0x00639, 0x00645, 0x0064a,
+	0x00643, 0x00645, 0x0064a, 0x00646, 0x0062c, 0x0062d, 0x00645, 0x0062e,
+	0x0064a, 0x00644, 0x0062c, 0x00645, 0x00643, 0x00645, 0x00645, 0x00644,
+	0x0062c, 0x00645, 0x00646, 0x0062c, 0x0062d, 0x0062c, 0x0062d, 0x0064a,
+	0x0062d, 0x0062c, 0x0064a, 0x00645, 0x0062c, 0x0064a, 0x00641, 0x00645,
+	0x0064a, 0x00628, 0x0062d, 0x0064a, 0x00643, 0x00645, 0x00645, 0x00639,
+	0x0062c, 0x00645, 0x00635, 0x00645, 0x00645, 0x00633, 0x0062e, 0x0064a,
+	0x00646, 0x0062c, 0x0064a, 0x00635, 0x00644, 0x006d2, 0x00642, 0x00644,
+	0x006d2, 0x00627, 0x00644, 0x00644, 0x00647, 0x00627, 0x00643, 0x00628,
+	0x00631, 0x00645, 0x0062d, 0x00645, 0x0062f, 0x00635, 0x00644, 0x00639,
+	0x00645, 0x00631, 0x00633, 0x00648, 0x00644, 0x00639, 0x00644, 0x0064a,
+	0x00647, 0x00648, 0x00633, 0x00644, 0x00645, 0x00635, 0x00644, 0x00649,
+	0x00635, 0x00644, 0x00649, 0x00020, 0x00627, 0x00644, 0x00644, 0x00647,
+	0x00020, 0x00639, 0x00644, 0x0064a, 0x00647, 0x00020, 0x00648, 0x00633,
+	0x00644, 0x00645, 0x0062c, 0x00644, 0x00020, 0x0062c, 0x00644, 0x00627,
+	0x00644, 0x00647, 0x00631, 0x006cc, 0x00627, 0x00644, 0x0002c, 0x03001,
+	0x03002, 0x0003a, 0x0003b, 0x00021, 0x0003f, 0x03016, 0x03017, 0x0002e,
+	0x0002e, 0x0002e, 0x0002e, 0x0002e, 0x02014, 0x02013, 0x0005f, 0x0005f,
+	0x00028, 0x00029, 0x0007b, 0x0007d, 0x03014, 0x03015, 0x03010, 0x03011,
+	0x0300a, 0x0300b, 0x03008, 0x03009, 0x0300c, 0x0300d, 0x0300e, 0x0300f,
+	0x0005b, 0x0005d, 0x00020, 0x00305, 0x00020, 0x00305, 0x00020, 0x00305,
+	0x00020, 0x00305, 0x0005f, 0x0005f, 0x0005f, 0x0002c, 0x03001, 0x0002e,
+	0x0003b, 0x0003a, 0x0003f, 0x00021, 0x02014, 0x00028, 0x00029, 0x0007b,
+	0x0007d, 0x03014, 0x03015, 0x00023, 0x00026, 0x0002a, 0x0002b, 0x0002d,
+	0x0003c, 0x0003e, 0x0003d, 0x0005c, 0x00024, 0x00025, 0x00040, 0x00020,
+	0x0064b, 0x00640, 0x0064b, 0x00020, 0x0064c, 0x00020, 0x0064d, 0x00020,
+	0x0064e, 0x00640, 0x0064e, 0x00020, 0x0064f, 0x00640, 0x0064f, 0x00020,
+	0x00650, 0x00640, 0x00650, 0x00020, 0x00651, 0x00640, 0x00651, 0x00020,
+	0x00652, 0x00640, 0x00652, 0x00621, 0x00627, 0x00653, 0x00627, 0x00653,
+	0x00627, 0x00654, 0x00627, 0x00654, 0x00648, 0x00654, 0x00648, 0x00654,
+	0x00627, 0x00655, 0x00627, 0x00655, 0x0064a, 0x00654, 0x0064a, 0x00654,
+	0x0064a, 0x00654, 0x0064a, 0x00654, 0x00627, 0x00627, 0x00628, 0x00628,
+	0x00628, 0x00628, 0x00629, 0x00629, 0x0062a, 0x0062a, 0x0062a, 0x0062a,
+	0x0062b, 0x0062b, 0x0062b, 0x0062b, 0x0062c, 0x0062c, 0x0062c, 0x0062c,
+	0x0062d, 0x0062d, 0x0062d, 0x0062d, 0x0062e, 0x0062e, 0x0062e, 0x0062e,
+	0x0062f, 0x0062f, 0x00630, 0x00630, 0x00631, 0x00631, 0x00632, 0x00632,
+	0x00633, 0x00633, 0x00633, 0x00633, 0x00634, 0x00634, 0x00634, 0x00634,
+	0x00635, 0x00635, 0x00635, 0x00635, 0x00636, 0x00636, 0x00636, 0x00636,
+	0x00637, 0x00637, 0x00637, 0x00637, 0x00638, 0x00638, 0x00638, 0x00638,
+	0x00639, 0x00639, 0x00639, 0x00639, 0x0063a, 0x0063a, 0x0063a, 0x0063a,
+	0x00641, 0x00641, 0x00641, 0x00641, 0x00642, 0x00642, 0x00642, 0x00642,
+	0x00643, 0x00643, 0x00643, 0x00643, 0x00644, 0x00644, 0x00644, 0x00644,
+	0x00645, 0x00645, 0x00645, 0x00645, 0x00646, 0x00646, 0x00646, 0x00646,
+	0x00647, 0x00647, 0x00647, 0x00647, 0x00648, 0x00648, 0x00649, 0x00649,
+	0x0064a, 0x0064a, 0x0064a, 0x0064a, 0x00644, 0x00627, 0x00653, 0x00644,
+	0x00627, 0x00653, 0x00644, 0x00627, 0x00654, 0x00644, 0x00627, 0x00654,
+	0x00644, 0x00627, 0x00655, 0x00644, 0x00627, 0x00655, 0x00644, 0x00627,
+	0x00644, 0x00627, 0x00021, 0x00022, 0x00023, 0x00024, 0x00025, 0x00026,
+	0x00027, 0x00028, 0x00029, 0x0002a, 0x0002b, 0x0002c, 0x0002d, 0x0002e,
+	0x0002f, 0x00030, 0x00031, 0x00032, 0x00033, 0x00034, 0x00035, 0x00036,
+	0x00037, 0x00038, 0x00039, 0x0003a, 0x0003b, 0x0003c, 0x0003d, 0x0003e,
+	0x0003f, 0x00040, 0x00041, 0x00042, 0x00043, 0x00044, 0x00045, 0x00046,
+	0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e,
+	0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054, 0x00055, 0x00056,
+	0x00057, 0x00058, 0x00059, 0x0005a, 0x0005b, 0x0005c, 0x0005d, 0x0005e,
+	0x0005f, 0x00060, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066,
+	0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x0006f, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x0007b, 0x0007c, 0x0007d, 0x0007e,
+	0x02985, 0x02986, 0x03002, 0x0300c, 0x0300d, 0x03001, 0x030fb, 0x030f2,
+	0x030a1, 0x030a3, 0x030a5, 0x030a7, 0x030a9, 0x030e3, 0x030e5, 0x030e7,
+	0x030c3, 0x030fc, 0x030a2, 0x030a4, 0x030a6, 0x030a8, 0x030aa, 0x030ab,
+	0x030ad, 0x030af, 0x030b1, 0x030b3, 0x030b5, 0x030b7, 0x030b9, 0x030bb,
+	0x030bd, 0x030bf, 0x030c1, 0x030c4, 0x030c6, 0x030c8, 0x030ca, 0x030cb,
+	0x030cc, 0x030cd, 0x030ce, 0x030cf, 0x030d2, 0x030d5, 0x030d8, 0x030db,
+	0x030de, 0x030df, 0x030e0, 0x030e1, 0x030e2, 0x030e4, 0x030e6, 0x030e8,
+	0x030e9, 0x030ea, 0x030eb, 0x030ec, 0x030ed, 0x030ef, 0x030f3, 0x03099,
+	0x0309a, 0x01160, 0x01100, 0x01101, 0x011aa, 0x01102, 0x011ac, 0x011ad,
+	0x01103, 0x01104, 0x01105, 0x011b0, 0x011b1, 0x011b2, 0x011b3, 0x011b4,
+	0x011b5, 0x0111a, 0x01106, 0x01107, 0x01108, 0x01121, 0x01109, 0x0110a,
+	0x0110b, 0x0110c, 0x0110d, 0x0110e, 0x0110f, 0x01110, 0x01111, 0x01112,
+	0x01161, 0x01162, 0x01163, 0x01164, 0x01165, 0x01166, 0x01167, 0x01168,
+	0x01169, 0x0116a, 0x0116b, 0x0116c, 0x0116d, 0x0116e, 0x0116f, 0x01170,
+	0x01171, 0x01172, 0x01173, 0x01174, 0x01175, 0x000a2, 0x000a3, 0x000ac,
+	0x00020, 0x00304, 0x000a6, 0x000a5, 0x020a9, 0x02502, 0x02190, 0x02191,
+	0x02192, 0x02193, 0x025a0, 0x025cb, 0x002d0, 0x002d1, 0x000e6, 0x00299,
+	0x00253, 0x002a3, 0x0ab66, 0x002a5, 0x002a4, 0x00256, 0x00257, 0x01d91,
+	0x00258, 0x0025e, 0x002a9, 0x00264, 0x00262, 0x00260, 0x0029b, 0x00127,
+	0x0029c, 0x00267, 0x00284, 0x002aa, 0x002ab, 0x0026c, 0x1df04, 0x0a78e,
+	0x0026e, 0x1df05, 0x0028e, 0x1df06, 0x000f8, 0x00276, 0x00277, 0x00071,
+	0x0027a, 0x1df08, 0x0027d, 0x0027e, 0x00280, 0x002a8, 0x002a6, 0x0ab67,
+	0x002a7, 0x00288, 0x02c71, 0x0028f, 0x002a1, 0x002a2, 0x00298, 0x001c0,
+	0x001c1, 0x001c2, 0x1df0a, 0x1df1e, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00030, 0x00031,
+	0x00032, 0x00033, 0x00034, 0x00035, 0x00036, 0x00037, 0x00038, 0x00039,
+	0x00041, 0x00042, 0x00043, 0x00044, 0x00045, 0x00046, 0x00047, 0x00048,
+	0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050,
+	0x00051, 0x00052, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057, 0x00058,
+	0x00059, 0x0005a, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066,
+	0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x0006f, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062,
+	0x00063, 0x00064, 0x00065, 0x00066, 0x00067, 0x00069, 0x0006a, 0x0006b,
+	0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072, 0x00073,
+	0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a, 0x00041,
+	0x00042, 0x00043, 0x00044, 0x00045, 0x00046, 0x00047, 0x00048, 0x00049,
+	0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051,
+	0x00052, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057, 0x00058, 0x00059,
+	0x0005a, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066, 0x00067,
+	0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e, 0x0006f,
+	0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076, 0x00077,
+	0x00078, 0x00079, 0x0007a, 0x00041, 0x00043, 0x00044, 0x00047, 0x0004a,
+	0x0004b, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00053, 0x00054, 0x00055,
+	0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062, 0x00063,
+	0x00064, 0x00066, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d,
+	0x0006e, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062,
+	0x00063, 0x00064, 0x00065, 0x00066, 0x00067, 0x00068, 0x00069, 0x0006a,
+	0x0006b, 0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072,
+	0x00073, 0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a,
+	0x00041, 0x00042, 0x00044, 0x00045, 0x00046, 0x00047, 0x0004a, 0x0004b,
+	0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x00061, 0x00062, 0x00063,
+	0x00064, 0x00065, 0x00066, 0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b,
+	0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072, 0x00073,
+	0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a, 0x00041,
+	0x00042, 0x00044, 0x00045, 0x00046, 0x00047, 0x00049, 0x0004a, 0x0004b,
+	0x0004c, 0x0004d, 0x0004f, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057,
+	0x00058, 0x00059, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066,
+	0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x0006f, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062,
+	0x00063, 0x00064, 0x00065, 0x00066, 0x00067, 0x00068, 0x00069, 0x0006a,
+	0x0006b, 0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072,
+	0x00073, 0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a,
+	0x00041, 0x00042, 0x00043, 0x00044, 0x00045, 0x00046, 0x00047, 0x00048,
+	0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050,
+	0x00051, 0x00052, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057, 0x00058,
+	0x00059, 0x0005a, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066,
+	0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x0006f, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062,
+	0x00063, 0x00064, 0x00065, 0x00066, 0x00067, 0x00068, 0x00069, 0x0006a,
+	0x0006b, 0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072,
+	0x00073, 0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a,
+	0x00041, 0x00042, 0x00043, 0x00044, 0x00045, 0x00046, 0x00047, 0x00048,
+	0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050,
+	0x00051, 0x00052, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057, 0x00058,
+	0x00059, 0x0005a, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066,
+	0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x0006f, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00061, 0x00062,
+	0x00063, 0x00064, 0x00065, 0x00066, 0x00067, 0x00068, 0x00069, 0x0006a,
+	0x0006b, 0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070, 0x00071, 0x00072,
+	0x00073, 0x00074, 0x00075, 0x00076, 0x00077, 0x00078, 0x00079, 0x0007a,
+	0x00041, 0x00042, 0x00043, 0x00044, 0x00045, 0x00046, 0x00047, 0x00048,
+	0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050,
+	0x00051, 0x00052, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057, 0x00058,
+	0x00059, 0x0005a, 0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066,
+	0x00067, 0x00068, 0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e,
+	0x0006f, 0x00070, 0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076,
+	0x00077, 0x00078, 0x00079, 0x0007a, 0x00131, 0x00237, 0x00391, 0x00392,
+	0x00393, 0x00394, 0x00395, 0x00396, 0x00397, 0x00398, 0x00399, 0x0039a,
+	0x0039b, 0x0039c, 0x0039d, 0x0039e, 0x0039f, 0x003a0, 0x003a1, 0x00398,
+	0x003a3, 0x003a4, 0x003a5, 0x003a6, 0x003a7, 0x003a8, 0x003a9, 0x02207,
+	0x003b1, 0x003b2, 0x003b3, 0x003b4, 0x003b5, 0x003b6, 0x003b7, 0x003b8,
+	0x003b9, 0x003ba, 0x003bb, 0x003bc, 0x003bd, 0x003be, 0x003bf, 0x003c0,
+	0x003c1, 0x003c2, 0x003c3, 0x003c4, 0x003c5, 0x003c6, 0x003c7, 0x003c8,
+	0x003c9, 0x02202, 0x003b5, 0x003b8, 0x003ba, 0x003c6, 0x003c1, 0x003c0,
+	0x00391, 0x00392, 0x00393, 0x00394, 0x00395, 0x00396, 0x00397, 0x00398,
+	0x00399, 0x0039a, 0x0039b, 0x0039c, 0x0039d, 0x0039e, 0x0039f, 0x003a0,
+	0x003a1, 0x00398, 0x003a3, 0x003a4, 0x003a5, 0x003a6, 0x003a7, 0x003a8,
+	0x003a9, 0x02207, 0x003b1, 0x003b2, 0x003b3, 0x003b4, 0x003b5, 0x003b6,
+	0x003b7, 0x003b8, 0x003b9, 0x003ba, 0x003bb, 0x003bc, 0x003bd, 0x003be,
+	0x003bf, 0x003c0, 0x003c1, 0x003c2, 0x003c3, 0x003c4, 0x003c5, 0x003c6,
+	0x003c7, 0x003c8, 0x003c9, 0x02202, 0x003b5, 0x003b8, 0x003ba, 0x003c6,
+	0x003c1, 0x003c0, 0x00391, 0x00392, 0x00393, 0x00394, 0x00395, 0x00396,
+	0x00397, 0x00398, 0x00399, 0x0039a, 0x0039b, 0x0039c, 0x0039d, 0x0039e,
+	0x0039f, 0x003a0, 0x003a1, 0x00398, 0x003a3, 0x003a4, 0x003a5, 0x003a6,
+	0x003a7, 0x003a8, 0x003a9, 0x02207, 0x003b1, 0x003b2, 0x003b3, 0x003b4,
+	0x003b5, 0x003b6, 0x003b7, 0x003b8, 0x003b9, 0x003ba, 0x003bb, 0x003bc,
+	0x003bd, 0x003be, 0x003bf, 0x003c0, 0x003c1, 0x003c2, 0x003c3, 0x003c4,
+	0x003c5, 0x003c6, 0x003c7, 0x003c8, 0x003c9, 0x02202, 0x003b5, 0x003b8,
+	0x003ba, 0x003c6, 0x003c1, 0x003c0, 0x00391, 0x00392, 0x00393, 0x00394,
+	0x00395, 0x00396, 0x00397, 0x00398, 0x00399, 0x0039a, 0x0039b, 0x0039c,
+	0x0039d, 0x0039e, 0x0039f, 0x003a0, 0x003a1, 0x00398, 0x003a3, 0x003a4,
+	0x003a5, 0x003a6, 0x003a7, 0x003a8, 0x003a9, 0x02207, 0x003b1, 0x003b2,
+	0x003b3, 0x003b4, 0x003b5, 0x003b6, 0x003b7, 0x003b8, 0x003b9, 0x003ba,
+	0x003bb, 0x003bc, 0x003bd, 0x003be, 0x003bf, 0x003c0, 0x003c1, 0x003c2,
+	0x003c3, 0x003c4, 0x003c5, 0x003c6, 0x003c7, 0x003c8, 0x003c9, 0x02202,
+	0x003b5, 0x003b8, 0x003ba, 0x003c6, 0x003c1, 0x003c0, 0x00391, 0x00392,
+	0x00393, 0x00394, 0x00395, 0x00396, 0x00397, 0x00398, 0x00399, 0x0039a,
+	0x0039b, 0x0039c, 0x0039d, 0x0039e, 0x0039f, 0x003a0, 0x003a1, 0x00398,
+	0x003a3, 0x003a4, 0x003a5, 0x003a6, 0x003a7, 0x003a8, 0x003a9, 0x02207,
+	0x003b1, 0x003b2, 0x003b3, 0x003b4, 0x003b5, 0x003b6, 0x003b7, 0x003b8,
+	0x003b9, 0x003ba, 0x003bb, 0x003bc, 0x003bd, 0x003be, 0x003bf, 0x003c0,
+	0x003c1, 0x003c2, 0x003c3, 0x003c4, 0x003c5, 0x003c6, 0x003c7, 0x003c8,
+	0x003c9, 0x02202, 0x003b5, 0x003b8, 0x003ba, 0x003c6, 0x003c1, 0x003c0,
+	0x003dc, 0x003dd, 0x00030, 0x00031, 0x00032, 0x00033, 0x00034, 0x00035,
+	0x00036, 0x00037, 0x00038, 0x00039, 0x00030, 0x00031, 0x00032, 0x00033,
+	0x00034, 0x00035, 0x00036, 0x00037, 0x00038, 0x00039, 0x00030, 0x00031,
+	0x00032, 0x00033, 0x00034, 0x00035, 0x00036, 0x00037, 0x00038, 0x00039,
+	0x00030, 0x00031, 0x00032, 0x00033, 0x00034, 0x00035, 0x00036, 0x00037,
+	0x00038, 0x00039, 0x00030, 0x00031, 0x00032, 0x00033, 0x00034, 0x00035,
+	0x00036, 0x00037, 0x00038, 0x00039, 0x00430, 0x00431, 0x00432, 0x00433,
+	0x00434, 0x00435, 0x00436, 0x00437, 0x00438, 0x0043a, 0x0043b, 0x0043c,
+	0x0043e, 0x0043f, 0x00440, 0x00441, 0x00442, 0x00443, 0x00444, 0x00445,
+	0x00446, 0x00447, 0x00448, 0x0044b, 0x0044d, 0x0044e, 0x0a689, 0x004d9,
+	0x00456, 0x00458, 0x004e9, 0x004af, 0x004cf, 0x00430, 0x00431, 0x00432,
+	0x00433, 0x00434, 0x00435, 0x00436, 0x00437, 0x00438, 0x0043a, 0x0043b,
+	0x0043e, 0x0043f, 0x00441, 0x00443, 0x00444, 0x00445, 0x00446, 0x00447,
+	0x00448, 0x0044a, 0x0044b, 0x00491, 0x00456, 0x00455, 0x0045f, 0x004ab,
+	0x0a651, 0x004b1, 0x00627, 0x00628, 0x0062c, 0x0062f, 0x00648, 0x00632,
+	0x0062d, 0x00637, 0x0064a, 0x00643, 0x00644, 0x00645, 0x00646, 0x00633,
+	0x00639, 0x00641, 0x00635, 0x00642, 0x00631, 0x00634, 0x0062a, 0x0062b,
+	0x0062e, 0x00630, 0x00636, 0x00638, 0x0063a, 0x0066e, 0x006ba, 0x006a1,
+	0x0066f, 0x00628, 0x0062c, 0x00647, 0x0062d, 0x0064a, 0x00643, 0x00644,
+	0x00645, 0x00646, 0x00633, 0x00639, 0x00641, 0x00635, 0x00642, 0x00634,
+	0x0062a, 0x0062b, 0x0062e, 0x00636, 0x0063a, 0x0062c, 0x0062d, 0x0064a,
+	0x00644, 0x00646, 0x00633, 0x00639, 0x00635, 0x00642, 0x00634, 0x0062e,
+	0x00636, 0x0063a, 0x006ba, 0x0066f, 0x00628, 0x0062c, 0x00647, 0x0062d,
+	0x00637, 0x0064a, 0x00643, 0x00645, 0x00646, 0x00633, 0x00639, 0x00641,
+	0x00635, 0x00642, 0x00634, 0x0062a, 0x0062b, 0x0062e, 0x00636, 0x00638,
+	0x0063a, 0x0066e, 0x006a1, 0x00627, 0x00628, 0x0062c, 0x0062f, 0x00647,
+	0x00648, 0x00632, 0x0062d, 0x00637, 0x0064a, 0x00644, 0x00645, 0x00646,
+	0x00633, 0x00639, 0x00641, 0x00635, 0x00642, 0x00631, 0x00634, 0x0062a,
+	0x0062b, 0x0062e, 0x00630, 0x00636, 0x00638, 0x0063a, 0x00628, 0x0062c,
+	0x0062f, 0x00648, 0x00632, 0x0062d, 0x00637, 0x0064a, 0x00644, 0x00645,
+	0x00646, 0x00633, 0x00639, 0x00641, 0x00635, 0x00642, 0x00631, 0x00634,
+	0x0062a, 0x0062b, 0x0062e, 0x00630, 0x00636, 0x00638, 0x0063a, 0x00030,
+	0x0002e, 0x00030, 0x0002c, 0x00031, 0x0002c, 0x00032, 0x0002c, 0x00033,
+	0x0002c, 0x00034, 0x0002c, 0x00035, 0x0002c, 0x00036, 0x0002c, 0x00037,
+	0x0002c, 0x00038, 0x0002c, 0x00039, 0x0002c, 0x00028, 0x00041, 0x00029,
+	0x00028, 0x00042, 0x00029, 0x00028, 0x00043, 0x00029, 0x00028, 0x00044,
+	0x00029, 0x00028, 0x00045, 0x00029, 0x00028, 0x00046, 0x00029, 0x00028,
+	0x00047, 0x00029, 0x00028, 0x00048, 0x00029, 0x00028, 0x00049, 0x00029,
+	0x00028, 0x0004a, 0x00029, 0x00028, 0x0004b, 0x00029, 0x00028, 0x0004c,
+	0x00029, 0x00028, 0x0004d, 0x00029, 0x00028, 0x0004e, 0x00029, 0x00028,
+	0x0004f, 0x00029, 0x00028, 0x00050, 0x00029, 0x00028, 0x00051, 0x00029,
+	0x00028, 0x00052, 0x00029, 0x00028, 0x00053, 0x00029, 0x00028, 0x00054,
+	0x00029, 0x00028, 0x00055, 0x00029, 0x00028, 0x00056, 0x00029, 0x00028,
+	0x00057, 0x00029, 0x00028, 0x00058, 0x00029, 0x00028, 0x00059, 0x00029,
+	0x00028, 0x0005a, 0x00029, 0x03014, 0x00053, 0x03015, 0x00043, 0x00052,
+	0x00043, 0x00044, 0x00057, 0x0005a, 0x00041, 0x00042, 0x00043, 0x00044,
+	0x00045, 0x00046, 0x00047, 0x00048, 0x00049, 0x0004a, 0x0004b, 0x0004c,
+	0x0004d, 0x0004e, 0x0004f, 0x00050, 0x00051, 0x00052, 0x00053, 0x00054,
+	0x00055, 0x00056, 0x00057, 0x00058, 0x00059, 0x0005a, 0x00048, 0x00056,
+	0x0004d, 0x00056, 0x00053, 0x00044, 0x00053, 0x00053, 0x00050, 0x00050,
+	0x00056, 0x00057, 0x00043, 0x0004d, 0x00043, 0x0004d, 0x00044, 0x0004d,
+	0x00052, 0x00044, 0x0004a, 0x0307b, 0x0304b, 0x030b3, 0x030b3, 0x030b5,
+	0x0624b, 0x05b57, 0x053cc, 0x030c6, 0x03099, 0x04e8c, 0x0591a, 0x089e3,
+	0x05929, 0x04ea4, 0x06620, 0x07121, 0x06599, 0x0524d, 0x05f8c, 0x0518d,
+	0x065b0, 0x0521d, 0x07d42, 0x0751f, 0x08ca9, 0x058f0, 0x05439, 0x06f14,
+	0x06295, 0x06355, 0x04e00, 0x04e09, 0x0904a, 0x05de6, 0x04e2d, 0x053f3,
+	0x06307, 0x08d70, 0x06253, 0x07981, 0x07a7a, 0x05408, 0x06e80, 0x06709,
+	0x06708, 0x07533, 0x05272, 0x055b6, 0x0914d, 0x03014, 0x0672c, 0x03015,
+	0x03014, 0x04e09, 0x03015, 0x03014, 0x04e8c, 0x03015, 0x03014, 0x05b89,
+	0x03015, 0x03014, 0x070b9, 0x03015, 0x03014, 0x06253, 0x03015, 0x03014,
+	0x076d7, 0x03015, 0x03014, 0x052dd, 0x03015, 0x03014, 0x06557, 0x03015,
+	0x05f97, 0x053ef, 0x00030, 0x00031, 0x00032, 0x00033, 0x00034, 0x00035,
+	0x00036, 0x00037, 0x00038, 0x00039,
+}
+
+var foldKeys = [...]rune{
+	0x00041, 0x00042, 0x00043, 0x00044, 0x00045, 0x00046, 0x00047, 0x00048,
+	0x00049, 0x0004a, 0x0004b, 0x0004c, 0x0004d, 0x0004e, 0x0004f, 0x00050,
+	0x00051, 0x00052, 0x00053, 0x00054, 0x00055, 0x00056, 0x00057, 0x00058,
+	0x00059, 0x0005a, 0x000b5, 0x000c0, 0x000c1, 0x000c2, 0x000c3, 0x000c4,
+	0x000c5, 0x000c6, 0x000c7, 0x000c8, 0x000c9, 0x000ca, 0x000cb, 0x000cc,
+	0x000cd, 0x000ce, 0x000cf, 0x000d0, 0x000d1, 0x000d2, 0x000d3, 0x000d4,
+	0x000d5, 0x000d6, 0x000d8, 0x000d9, 0x000da, 0x000db, 0x000dc, 0x000dd,
+	0x000de, 0x000df, 0x00100, 0x00102, 0x00104, 0x00106, 0x00108, 0x0010a,
+	0x0010c, 0x0010e, 0x00110, 0x00112, 0x00114, 0x00116, 0x00118, 0x0011a,
+	0x0011c, 0x0011e, 0x00120, 0x00122, 0x00124, 0x00126, 0x00128, 0x0012a,
+	0x0012c, 0x0012e, 0x00130, 0x00132, 0x00134, 0x00136, 0x00139, 0x0013b,
+	0x0013d, 0x0013f, 0x00141, 0x00143, 0x00145, 0x00147, 0x00149, 0x0014a,
+	0x0014c, 0x0014e, 0x00150, 0x00152, 0x00154, 0x00156, 0x00158, 0x0015a,
+	0x0015c, 0x0015e, 0x00160, 0x00162, 0x00164, 0x00166, 0x00168, 0x0016a,
+	0x0016c, 0x0016e, 0x00170, 0x00172, 0x00174, 0x00176, 0x00178, 0x00179,
+	0x0017b, 0x0017d, 0x0017f, 0x00181, 0x00182, 0x00184, 0x00186, 0x00187,
+	0x00189, 0x0018a, 0x0018b, 0x0018e, 0x0018f, 0x00190, 0x00191, 0x00193,
+	0x00194, 0x00196, 0x00197, 0x00198, 0x0019c, 0x0019d, 0x0019f, 0x001a0,
+	0x001a2, 0x001a4, 0x001a6, 0x001a7, 0x001a9, 0x001ac, 0x001ae, 0x001af,
+	0x001b1, 0x001b2, 0x001b3, 0x001b5, 0x001b7, 0x001b8, 0x001bc, 0x001c4,
+	0x001c5, 0x001c7, 0x001c8, 0x001ca, 0x001cb, 0x001cd, 0x001cf, 0x001d1,
+	0x001d3, 0x001d5, 0x001d7, 0x001d9, 0x001db, 0x001de, 0x001e0, 0x001e2,
+	0x001e4, 0x001e6, 0x001e8, 0x001ea, 0x001ec, 0x001ee, 0x001f0, 0x001f1,
+	0x001f2, 0x001f4, 0x001f6, 0x001f7, 0x001f8, 0x001fa, 0x001fc, 0x001fe,
+	0x00200, 0x00202, 0x00204, 0x00206, 0x00208, 0x0020a, 0x0020c, 0x0020e,
+	0x00210, 0x00212, 0x00214, 0x00216, 0x00218, 0x0021a, 0x0021c, 0x0021e,
+	0x00220, 0x00222, 0x00224, 0x00226, 0x00228, 0x0022a, 0x0022c, 0x0022e,
+	0x00230, 0x00232, 0x0023a, 0x0023b, 0x0023d, 0x0023e, 0x00241, 0x00243,
+	0x00244, 0x00245, 0x00246, 0x00248, 0x0024a, 0x0024c, 0x0024e, 0x00345,
+	0x00370, 0x00372, 0x00376, 0x0037f, 0x00386, 0x00388, 0x00389, 0x0038a,
+	0x0038c, 0x0038e, 0x0038f, 0x00390, 0x00391, 0x00392, 0x00393, 0x00394,
+	0x00395, 0x00396, 0x00397, 0x00398, 0x00399, 0x0039a, 0x0039b, 0x0039c,
+	0x0039d, 0x0039e, 0x0039f, 0x003a0, 0x003a1, 0x003a3, 0x003a4, 0x003a5,
+	0x003a6, 0x003a7, 0x003a8, 0x003a9, 0x003aa, 0x003ab, 0x003b0, 0x003c2,
+	0x003cf, 0x003d0, 0x003d1, 0x003d5, 0x003d6, 0x003d8, 0x003da, 0x003dc,
+	0x003de, 0x003e0, 0x003e2, 0x003e4, 0x003e6, 0x003e8, 0x003ea, 0x003ec,
+	0x003ee, 0x003f0, 0x003f1, 0x003f4, 0x003f5, 0x003f7, 0x003f9, 0x003fa,
+	0x003fd, 0x003fe, 0x003ff, 0x00400, 0x00401, 0x00402, 0x00403, 0x00404,
+	0x00405, 0x00406, 0x00407, 0x00408, 0x00409, 0x0040a, 0x0040b, 0x0040c,
+	0x0040d, 0x0040e, 0x0040f, 0x00410, 0x00411, 0x00412, 0x00413, 0x00414,
+	0x00415, 0x00416, 0x00417, 0x00418, 0x00419, 0x0041a, 0x0041b, 0x0041c,
+	0x0041d, 0x0041e, 0x0041f, 0x00420, 0x00421, 0x00422, 0x00423, 0x00424,
+	0x00425, 0x00426, 0x00427, 0x00428, 0x00429, 0x0042a, 0x0042b, 0x0042c,
+	0x0042d, 0x0042e, 0x0042f, 0x00460, 0x00462, 0x00464, 0x00466, 0x00468,
+	0x0046a, 0x0046c, 0x0046e, 0x00470, 0x00472, 0x00474, 0x00476, 0x00478,
+	0x0047a, 0x0047c, 0x0047e, 0x00480, 0x0048a, 0x0048c, 0x0048e, 0x00490,
+	0x00492, 0x00494, 0x00496, 0x00498, 0x0049a, 0x0049c, 0x0049e, 0x004a0,
+	0x004a2, 0x004a4, 0x004a6, 0x004a8, 0x004aa, 0x004ac, 0x004ae, 0x004b0,
+	0x004b2, 0x004b4, 0x004b6, 0x004b8, 0x004ba, 0x004bc, 0x004be, 0x004c0,
+	0x004c1, 0x004c3, 0x004c5, 0x004c7, 0x004c9, 0x004cb, 0x004cd, 0x004d0,
+	0x004d2, 0x004d4, 0x004d6, 0x004d8, 0x004da, 0x004dc, 0x004de, 0x004e0,
+	0x004e2, 0x004e4, 0x004e6, 0x004e8, 0x004ea, 0x004ec, 0x004ee, 0x004f0,
+	0x004f2, 0x004f4, 0x004f6, 0x004f8, 0x004fa, 0x004fc, 0x004fe, 0x00500,
+	0x00502, 0x00504, 0x00506, 0x00508, 0x0050a, 0x0050c, 0x0050e, 0x00510,
+	0x00512, 0x00514, 0x00516, 0x00518, 0x0051a, 0x0051c, 0x0051e, 0x00520,
+	0x00522, 0x00524, 0x00526, 0x00528, 0x0052a, 0x0052c, 0x0052e, 0x00531,
+	0x00532, 0x00533, 0x00534, 0x00535, 0x00536, 0x00537, 0x00538, 0x00539,
+	0x0053a, 0x0053b, 0x0053c, 0x0053d, 0x0053e, 0x0053f, 0x00540, 0x00541,
+	0x00542, 0x00543, 0x00544, 0x00545, 0x00546, 0x00547, 0x00548, 0x00549,
+	0x0054a, 0x0054b, 0x0054c, 0x0054d, 0x0054e, 0x0054f, 0x00550, 0x00551,
+	0x00552, 0x00553, 0x00554, 0x00555, 0x00556, 0x00587, 0x010a0, 0x010a1,
+	0x010a2, 0x010a3, 0x010a4, 0x010a5, 0x010a6, 0x010a7, 0x010a8, 0x010a9,
+	0x010aa, 0x010ab, 0x010ac, 0x010ad, 0x010ae, 0x010af, 0x010b0, 0x010b1,
+	0x010b2, 0x010b3, 0x010b4, 0x010b5, 0x010b6, 0x010b7, 0x010b8, 0x010b9,
+	0x010ba, 0x010bb, 0x010bc, 0x010bd, 0x010be, 0x010bf, 0x010c0, 0x010c1,
+	0x010c2, 0x010c3, 0x010c4, 0x010c5, 0x010c7, 0x010cd, 0x013a0, 0x013a1,
+	0x013a2, 0x013a3, 0x013a4, 0x013a5, 0x013a6, 0x013a7, 0x013a8, 0x013a9,
+	0x013aa, 0x013ab, 0x013ac, 0x013ad, 0x013ae, 0x013af, 0x013b0, 0x013b1,
+	0x013b2, 0x013b3, 0x013b4, 0x013b5, 0x013b6, 0x013b7, 0x013b8, 0x013b9,
+	0x013ba, 0x013bb, 0x013bc, 0x013bd, 0x013be, 0x013bf, 0x013c0, 0x013c1,
+	0x013c2, 0x013c3, 0x013c4, 0x013c5, 0x013c6, 0x013c7, 0x013c8, 0x013c9,
+	0x013ca, 0x013cb, 0x013cc, 0x013cd, 0x013ce, 0x013cf, 0x013d0, 0x013d1,
+	0x013d2, 0x013d3, 0x013d4, 0x013d5, 0x013d6, 0x013d7, 0x013d8, 0x013d9,
+	0x013da, 0x013db, 0x013dc, 0x013dd, 0x013de, 0x013df, 0x013e0, 0x013e1,
+	0x013e2, 0x013e3, 0x013e4, 0x013e5, 0x013e6, 0x013e7, 0x013e8, 0x013e9,
+	0x013ea, 0x013eb, 0x013ec, 0x013ed, 0x013ee, 0x013ef, 0x013f0, 0x013f1,
+	0x013f2, 0x013f3, 0x013f4, 0x013f5, 0x013f8, 0x013f9, 0x013fa, 0x013fb,
+	0x013fc, 0x013fd, 0x01c80, 0x01c81, 0x01c82, 0x01c83, 0x01c84, 0x01c85,
+	0x01c86, 0x01c87, 0x01c88, 0x01c89, 0x01c90, 0x01c91, 0x01c92, 0x01c93,
+	0x01c94, 0x01c95, 0x01c96, 0x01c97, 0x01c98, 0x01c99, 0x01c9a, 0x01c9b,
+	0x01c9c, 0x01c9d, 0x01c9e, 0x01c9f, 0x01ca0, 0x01ca1, 0x01ca2, 0x01ca3,
+	0x01ca4, 0x01ca5, 0x01ca6, 0x01ca7, 0x01ca8, 0x01ca9, 0x01caa, 0x01cab,
+	0x01cac, 0x01cad, 0x01cae, 0x01caf, 0x01cb0, 0x01cb1, 0x01cb2, 0x01cb3,
+	0x01cb4, 0x01cb5, 0x01cb6, 0x01cb7, 0x01cb8, 0x01cb9, 0x01cba, 0x01cbd,
+	0x01cbe, 0x01cbf, 0x01e00, 0x01e02, 0x01e04, 0x01e06, 0x01e08, 0x01e0a,
+	0x01e0c, 0x01e0e, 0x01e10, 0x01e12, 0x01e14, 0x01e16, 0x01e18, 0x01e1a,
+	0x01e1c, 0x01e1e, 0x01e20, 0x01e22, 0x01e24, 0x01e26, 0x01e28, 0x01e2a,
+	0x01e2c, 0x01e2e, 0x01e30, 0x01e32, 0x01e34, 0x01e36, 0x01e38, 0x01e3a,
+	0x01e3c, 0x01e3e, 0x01e40, 0x01e42, 0x01e44, 0x01e46, 0x01e48, 0x01e4a,
+	0x01e4c, 0x01e4e, 0x01e50, 0x01e52, 0x01e54, 0x01e56, 0x01e58, 0x01e5a,
+	0x01e5c, 0x01e5e, 0x01e60, 0x01e62, 0x01e64, 0x01e66, 0x01e68, 0x01e6a,
+	0x01e6c, 0x01e6e, 0x01e70, 0x01e72, 0x01e74, 0x01e76, 0x01e78, 0x01e7a,
+	0x01e7c, 0x01e7e, 0x01e80, 0x01e82, 0x01e84, 0x01e86, 0x01e88, 0x01e8a,
+	0x01e8c, 0x01e8e, 0x01e90, 0x01e92, 0x01e94, 0x01e96, 0x01e97, 0x01e98,
+	0x01e99, 0x01e9a, 0x01e9b, 0x01e9e, 0x01ea0, 0x01ea2, 0x01ea4, 0x01ea6,
+	0x01ea8, 0x01eaa, 0x01eac, 0x01eae, 0x01eb0, 0x01eb2, 0x01eb4, 0x01eb6,
+	0x01eb8, 0x01eba, 0x01ebc, 0x01ebe, 0x01ec0, 0x01ec2, 0x01ec4, 0x01ec6,
+	0x01ec8, 0x01eca, 0x01ecc, 0x01ece, 0x01ed0, 0x01ed2, 0x01ed4, 0x01ed6,
+	0x01ed8, 0x01eda, 0x01edc, 0x01ede, 0x01ee0, 0x01ee2, 0x01ee4, 0x01ee6,
+	0x01ee8, 0x01eea, 0x01eec, 0x01eee, 0x01ef0, 0x01ef2, 0x01ef4, 0x01ef6,
+	0x01ef8, 0x01efa, 0x01efc, 0x01efe, 0x01f08, 0x01f09, 0x01f0a, 0x01f0b,
+	0x01f0c, 0x01f0d, 0x01f0e, 0x01f0f, 0x01f18, 0x01f19, 0x01f1a, 0x01f1b,
+	0x01f1c, 0x01f1d, 0x01f28, 0x01f29, 0x01f2a, 0x01f2b, 0x01f2c, 0x01f2d,
+	0x01f2e, 0x01f2f, 0x01f38, 0x01f39, 0x01f3a, 0x01f3b, 0x01f3c, 0x01f3d,
+	0x01f3e, 0x01f3f, 0x01f48, 0x01f49, 0x01f4a, 0x01f4b, 0x01f4c, 0x01f4d,
+	0x01f50, 0x01f52, 0x01f54, 0x01f56, 0x01f59, 0x01f5b, 0x01f5d, 0x01f5f,
+	0x01f68, 0x01f69, 0x01f6a, 0x01f6b, 0x01f6c, 0x01f6d, 0x01f6e, 0x01f6f,
+	0x01f80, 0x01f81, 0x01f82, 0x01f83, 0x01f84, 0x01f85, 0x01f86, 0x01f87,
+	0x01f88, 0x01f89, 0x01f8a, 0x01f8b, 0x01f8c, 0x01f8d, 0x01f8e, 0x01f8f,
+	0x01f90, 0x01f91, 0x01f92, 0x01f93, 0x01f94, 0x01f95, 0x01f96, 0x01f97,
+	0x01f98, 0x01f99, 0x01f9a, 0x01f9b, 0x01f9c, 0x01f9d, 0x01f9e, 0x01f9f,
+	0x01fa0, 0x01fa1, 0x01fa2, 0x01fa3, 0x01fa4, 0x01fa5, 0x01fa6, 0x01fa7,
+	0x01fa8, 0x01fa9, 0x01faa, 0x01fab, 0x01fac, 0x01fad, 0x01fae, 0x01faf,
+	0x01fb2, 0x01fb3, 0x01fb4, 0x01fb6, 0x01fb7, 0x01fb8, 0x01fb9, 0x01fba,
+	0x01fbb, 0x01fbc, 0x01fbe, 0x01fc2, 0x01fc3, 0x01fc4, 0x01fc6, 0x01fc7,
+	0x01fc8, 0x01fc9, 0x01fca, 0x01fcb, 0x01fcc, 0x01fd2, 0x01fd3, 0x01fd6,
+	0x01fd7, 0x01fd8, 0x01fd9, 0x01fda, 0x01fdb, 0x01fe2, 0x01fe3, 0x01fe4,
+	0x01fe6, 0x01fe7, 0x01fe8, 0x01fe9, 0x01fea, 0x01feb, 0x01fec, 0x01ff2,
+	0x01ff3, 0x01ff4, 0x01ff6, 0x01ff7, 0x01ff8, 0x01ff9, 0x01ffa, 0x01ffb,
+	0x01ffc, 0x02126, 0x0212a, 0x0212b, 0x02132, 0x02160, 0x02161, 0x02162,
+	0x02163, 0x02164, 0x02165, 0x02166, 0x02167, 0x02168, 0x02169, 0x0216a,
+	0x0216b, 0x0216c, 0x0216d, 0x0216e, 0x0216f, 0x02183, 0x024b6, 0x024b7,
+	0x024b8, 0x024b9, 0x024ba, 0x024bb, 0x024bc, 0x024bd, 0x024be, 0x024bf,
+	0x024c0, 0x024c1, 0x024c2, 0x024c3, 0x024c4, 0x024c5, 0x024c6, 0x024c7,
+	0x024c8, 0x024c9, 0x024ca, 0x024cb, 0x024cc, 0x024cd, 0x024ce, 0x024cf,
+	0x02c00, 0x02c01, 0x02c02, 0x02c03, 0x02c04, 0x02c05, 0x02c06, 0x02c07,
+	0x02c08, 0x02c09, 0x02c0a, 0x02c0b, 0x02c0c, 0x02c0d, 0x02c0e, 0x02c0f,
+	0x02c10, 0x02c11, 0x02c12, 0x02c13, 0x02c14, 0x02c15, 0x02c16, 0x02c17,
+	0x02c18, 0x02c19, 0x02c1a, 0x02c1b, 0x02c1c, 0x02c1d, 0x02c1e, 0x02c1f,
+	0x02c20, 0x02c21, 0x02c22, 0x02c23, 0x02c24, 0x02c25, 0x02c26, 0x02c27,
+	0x02c28, 0x02c29, 0x02c2a, 0x02c2b, 0x02c2c, 0x02c2d, 0x02c2e, 0x02c2f,
+	0x02c60, 0x02c62, 0x02c63, 0x02c64, 0x02c67, 0x02c69, 0x02c6b, 0x02c6d,
+	0x02c6e, 0x02c6f, 0x02c70, 0x02c72, 0x02c75, 0x02c7e, 0x02c7f, 0x02c80,
+	0x02c82, 0x02c84, 0x02c86, 0x02c88, 0x02c8a, 0x02c8c, 0x02c8e, 0x02c90,
+	0x02c92, 0x02c94, 0x02c96, 0x02c98, 0x02c9a, 0x02c9c, 0x02c9e, 0x02ca0,
+	0x02ca2, 0x02ca4, 0x02ca6, 0x02ca8, 0x02caa, 0x02cac, 0x02cae, 0x02cb0,
+	0x02cb2, 0x02cb4, 0x02cb6, 0x02cb8, 0x02cba, 0x02cbc, 0x02cbe, 0x02cc0,
+	0x02cc2, 0x02cc4, 0x02cc6, 0x02cc8, 0x02cca, 0x02ccc, 0x02cce, 0x02cd0,
+	0x02cd2, 0x02cd4, 0x02cd6, 0x02cd8, 0x02cda, 0x02cdc, 0x02cde, 0x02ce0,
+	0x02ce2, 0x02ceb, 0x02ced, 0x02cf2, 0x0a640, 0x0a642, 0x0a644, 0x0a646,
+	0x0a648, 0x0a64a, 0x0a64c, 0x0a64e, 0x0a650, 0x0a652, 0x0a654, 0x0a656,
+	0x0a658, 0x0a65a, 0x0a65c, 0x0a65e, 0x0a660, 0x0a662, 0x0a664, 0x0a666,
+	0x0a668, 0x0a66a, 0x0a66c, 0x0a680, 0x0a682, 0x0a684, 0x0a686, 0x0a688,
+	0x0a68a, 0x0a68c, 0x0a68e, 0x0a690, 0x0a692, 0x0a694, 0x0a696, 0x0a698,
+	0x0a69a, 0x0a722, 0x0a724, 0x0a726, 0x0a728, 0x0a72a, 0x0a72c, 0x0a72e,
+	0x0a732, 0x0a734, 0x0a736, 0x0a738, 0x0a73a, 0x0a73c, 0x0a73e, 0x0a740,
+	0x0a742, 0x0a744, 0x0a746, 0x0a748, 0x0a74a, 0x0a74c, 0x0a74e, 0x0a750,
+	0x0a752, 0x0a754, 0x0a756, 0x0a758, 0x0a75a, 0x0a75c, 0x0a75e, 0x0a760,
+	0x0a762, 0x0a764, 0x0a766, 0x0a768, 0x0a76a, 0x0a76c, 0x0a76e, 0x0a779,
+	0x0a77b, 0x0a77d, 0x0a77e, 0x0a780, 0x0a782, 0x0a784, 0x0a786, 0x0a78b,
+	0x0a78d, 0x0a790, 0x0a792, 0x0a796, 0x0a798, 0x0a79a, 0x0a79c, 0x0a79e,
+	0x0a7a0, 0x0a7a2, 0x0a7a4, 0x0a7a6, 0x0a7a8, 0x0a7aa, 0x0a7ab, 0x0a7ac,
+	0x0a7ad, 0x0a7ae, 0x0a7b0, 0x0a7b1, 0x0a7b2, 0x0a7b3, 0x0a7b4, 0x0a7b6,
+	0x0a7b8, 0x0a7ba, 0x0a7bc, 0x0a7be, 0x0a7c0, 0x0a7c2, 0x0a7c4, 0x0a7c5,
+	0x0a7c6, 0x0a7c7, 0x0a7c9, 0x0a7cb, 0x0a7cc, 0x0a7ce, 0x0a7d0, 0x0a7d2,
+	0x0a7d4, 0x0a7d6, 0x0a7d8, 0x0a7da, 0x0a7dc, 0x0a7f5, 0x0ab70, 0x0ab71,
+	0x0ab72, 0x0ab73, 0x0ab74, 0x0ab75, 0x0ab76, 0x0ab77, 0x0ab78, 0x0ab79,
+	0x0ab7a, 0x0ab7b, 0x0ab7c, 0x0ab7d, 0x0ab7e, 0x0ab7f, 0x0ab80, 0x0ab81,
+	0x0ab82, 0x0ab83, 0x0ab84, 0x0ab85, 0x0ab86, 0x0ab87, 0x0ab88, 0x0ab89,
+	0x0ab8a, 0x0ab8b, 0x0ab8c, 0x0ab8d, 0x0ab8e, 0x0ab8f, 0x0ab90, 0x0ab91,
+	0x0ab92, 0x0ab93, 0x0ab94, 0x0ab95, 0x0ab96, 0x0ab97, 0x0ab98, 0x0ab99,
+	0x0ab9a, 0x0ab9b, 0x0ab9c, 0x0ab9d, 0x0ab9e, 0x0ab9f, 0x0aba0, 0x0aba1,
+	0x0aba2, 0x0aba3, 0x0aba4, 0x0aba5, 0x0aba6, 0x0aba7, 0x0aba8, 0x0aba9,
+	0x0abaa, 0x0abab, 0x0abac, 0x0abad, 0x0abae, 0x0abaf, 0x0abb0, 0x0abb1,
+	0x0abb2, 0x0abb3, 0x0abb4, 0x0abb5, 0x0abb6, 0x0abb7, 0x0abb8, 0x0abb9,
+	0x0abba, 0x0abbb, 0x0abbc, 0x0abbd, 0x0abbe, 0x0abbf, 0x0fb00, 0x0fb01,
+	0x0fb02, 0x0fb03, 0x0fb04, 0x0fb05, 0x0fb06, 0x0fb13, 0x0fb14, 0x0fb15,
+	0x0fb16, 0x0fb17, 0x0ff21, 0x0ff22, 0x0ff23, 0x0ff24, 0x0ff25, 0x0ff26,
+	0x0ff27, 0x0ff28, 0x0ff29, 0x0ff2a, 0x0ff2b, 0x0ff2c, 0x0ff2d, 0x0ff2e,
+	0x0ff2f, 0x0ff30, 0x0ff31, 0x0ff32, 0x0ff33, 0x0ff34, 0x0ff35, 0x0ff36,
+	0x0ff37, 0x0ff38, 0x0ff39, 0x0ff3a, 0x10400, 0x10401, 0x10402, 0x10403,
+	0x10404, 0x10405, 0x10406, 0x10407, 0x10408, 0x10409, 0x1040a, 0x1040b,
+	0x1040c, 0x1040d, 0x1040e, 0x1040f, 0x10410, 0x10411, 0x10412, 0x10413,
+	0x10414, 0x10415, 0x10416, 0x10417, 0x10418, 0x10419, 0x1041a, 0x1041b,
+	0x1041c, 0x1041d, 0x1041e, 0x1041f, 0x10420, 0x10421, 0x10422, 0x10423,
+	0x10424, 0x10425, 0x10426, 0x10427, 0x104b0, 0x104b1, 0x104b2, 0x104b3,
+	0x104b4, 0x104b5, 0x104b6, 0x104b7, 0x104b8, 0x104b9, 0x104ba, 0x104bb,
+	0x104bc, 0x104bd, 0x104be, 0x104bf, 0x104c0, 0x104c1, 0x104c2, 0x104c3,
+	0x104c4, 0x104c5, 0x104c6, 0x104c7, 0x104c8, 0x104c9, 0x104ca, 0x104cb,
+	0x104cc, 0x104cd, 0x104ce, 0x104cf, 0x104d0, 0x104d1, 0x104d2, 0x104d3,
+	0x10570, 0x10571, 0x10572, 0x10573, 0x10574, 0x10575, 0x10576, 0x10577,
+	0x10578, 0x10579, 0x1057a, 0x1057c, 0x1057d, 0x1057e, 0x1057f, 0x10580,
+	0x10581, 0x10582, 0x10583, 0x10584, 0x10585, 0x10586, 0x10587, 0x10588,
+	0x10589, 0x1058a, 0x1058c, 0x1058d, 0x1058e, 0x1058f, 0x10590, 0x10591,
+	0x10592, 0x10594, 0x10595, 0x10c80, 0x10c81, 0x10c82, 0x10c83, 0x10c84,
+	0x10c85, 0x10c86, 0x10c87, 0x10c88, 0x10c89, 0x10c8a, 0x10c8b, 0x10c8c,
+	0x10c8d, 0x10c8e, 0x10c8f, 0x10c90, 0x10c91, 0x10c92, 0x10c93, 0x10c94,
+	0x10c95, 0x10c96, 0x10c97, 0x10c98, 0x10c99, 0x10c9a, 0x10c9b, 0x10c9c,
+	0x10c9d, 0x10c9e, 0x10c9f, 0x10ca0, 0x10ca1, 0x10ca2, 0x10ca3, 0x10ca4,
+	0x10ca5, 0x10ca6, 0x10ca7, 0x10ca8, 0x10ca9, 0x10caa, 0x10cab, 0x10cac,
+	0x10cad, 0x10cae, 0x10caf, 0x10cb0, 0x10cb1, 0x10cb2, 0x10d50, 0x10d51,
+	0x10d52, 0x10d53, 0x10d54, 0x10d55, 0x10d56, 0x10d57, 0x10d58, 0x10d59,
+	0x10d5a, 0x10d5b, 0x10d5c, 0x10d5d, 0x10d5e, 0x10d5f, 0x10d60, 0x10d61,
+	0x10d62, 0x10d63, 0x10d64, 0x10d65, 0x118a0, 0x118a1, 0x118a2, 0x118a3,
+	0x118a4, 0x118a5, 0x118a6, 0x118a7, 0x118a8, 0x118a9, 0x118aa, 0x118ab,
+	0x118ac, 0x118ad, 0x118ae, 0x118af, 0x118b0, 0x118b1, 0x118b2, 0x118b3,
+	0x118b4, 0x118b5, 0x118b6, 0x118b7, 0x118b8, 0x118b9, 0x118ba, 0x118bb,
+	0x118bc, 0x118bd, 0x118be, 0x118bf, 0x16e40, 0x16e41, 0x16e42, 0x16e43,
+	0x16e44, 0x16e45, 0x16e46, 0x16e47, 0x16e48, 0x16e49, 0x16e4a, 0x16e4b,
+	0x16e4c, 0x16e4d, 0x16e4e, 0x16e4f, 0x16e50, 0x16e51, 0x16e52, 0x16e53,
+	0x16e54, 0x16e55, 0x16e56, 0x16e57, 0x16e58, 0x16e59, 0x16e5a, 0x16e5b,
+	0x16e5c, 0x16e5d, 0x16e5e, 0x16e5f, 0x16ea0, 0x16ea1, 0x16ea2, 0x16ea3,
+	0x16ea4, 0x16ea5, 0x16ea6, 0x16ea7, 0x16ea8, 0x16ea9, 0x16eaa, 0x16eab,
+	0x16eac, 0x16ead, 0x16eae, 0x16eaf, 0x16eb0, 0x16eb1, 0x16eb2, 0x16eb3,
+	0x16eb4, 0x16eb5, 0x16eb6, 0x16eb7, 0x16eb8, 0x1e900, 0x1e901, 0x1e902,
+	0x1e903, 0x1e904, 0x1e905, 0x1e906, 0x1e907, 0x1e908, 0x1e909, 0x1e90a,
+	0x1e90b, 0x1e90c, 0x1e90d, 0x1e90e, 0x1e90f, 0x1e910, 0x1e911, 0x1e912,
+	0x1e913, 0x1e914, 0x1e915, 0x1e916, 0x1e917, 0x1e918, 0x1e919, 0x1e91a,
+	0x1e91b, 0x1e91c, 0x1e91d, 0x1e91e, 0x1e91f, 0x1e920, 0x1e921,
+}
+
+var foldOffsets = [...]uint16{
+	0x0000, 0x0001, 0x0002, 0x0003, 0x0004, 0x0005, 0x0006, 0x0007,
+	0x0008, 0x0009, 0x000a, 0x000b, 0x000c, 0x000d, 0x000e, 0x000f,
+	0x0010, 0x0011, 0x0012, 0x0013, 0x0014, 0x0015, 0x0016, 0x0017,
+	0x0018, 0x0019, 0x001a, 0x001b, 0x001c, 0x001d, 0x001e, 0x001f,
+	0x0020, 0x0021, 0x0022, 0x0023, 0x0024, 0x0025, 0x0026, 0x0027,
+	0x0028, 0x0029, 0x002a, 0x002b, 0x002c, 0x002d, 0x002e, 0x002f,
+	0x0030, 0x0031, 0x0032, 0x0033, 0x0034, 0x0035, 0x0036, 0x0037,
+	0x0038, 0x0039, 0x003b, 0x003c, 0x003d, 0x003e, 0x003f, 0x0040,
+	0x0041, 0x0042, 0x0043, 0x0044, 0x0045, 0x0046, 0x0047, 0x0048,
+	0x0049, 0x004a, 0x004b, 0x004c, 0x004d, 0x004e, 0x004f, 0x0050,
+	0x0051, 0x0052, 0x0053, 0x0055, 0x0056, 0x0057, 0x0058, 0x0059,
+	0x005a, 0x005b, 0x005c, 0x005d, 0x005e, 0x005f, 0x0060, 0x0062,
+	0x0063, 0x0064, 0x0065, 0x0066, 0x0067, 0x0068, 0x0069, 0x006a,
+	0x006b, 0x006c, 0x006d, 0x006e, 0x006f, 0x0070, 0x0071, 0x0072,
+	0x0073, 0x0074, 0x0075, 0x0076, 0x0077, 0x0078, 0x0079, 0x007a,
+	0x007b, 0x007c, 0x007d, 0x007e, 0x007f, 0x0080, 0x0081, 0x0082,
+	0x0083, 0x0084, 0x0085, 0x0086, 0x0087, 0x0088, 0x0089, 0x008a,
+	0x008b, 0x008c, 0x008d, 0x008e, 0x008f, 0x0090, 0x0091, 0x0092,
+	0x0093, 0x0094, 0x0095, 0x0096, 0x0097, 0x0098, 0x0099, 0x009a,
+	0x009b, 0x009c, 0x009d, 0x009e, 0x009f, 0x00a0, 0x00a1, 0x00a2,
+	0x00a3, 0x00a4, 0x00a5, 0x00a6, 0x00a7, 0x00a8, 0x00a9, 0x00aa,
+	0x00ab, 0x00ac, 0x00ad, 0x00ae, 0x00af, 0x00b0, 0x00b1, 0x00b2,
+	0x00b3, 0x00b4, 0x00b5, 0x00b6, 0x00b7, 0x00b8, 0x00b9, 0x00bb,
+	0x00bc, 0x00bd, 0x00be, 0x00bf, 0x00c0, 0x00c1, 0x00c2, 0x00c3,
+	0x00c4, 0x00c5, 0x00c6, 0x00c7, 0x00c8, 0x00c9, 0x00ca, 0x00cb,
+	0x00cc, 0x00cd, 0x00ce, 0x00cf, 0x00d0, 0x00d1, 0x00d2, 0x00d3,
+	0x00d4, 0x00d5, 0x00d6, 0x00d7, 0x00d8, 0x00d9, 0x00da, 0x00db,
+	0x00dc, 0x00dd, 0x00de, 0x00df, 0x00e0, 0x00e1, 0x00e2, 0x00e3,
+	0x00e4, 0x00e5, 0x00e6, 0x00e7, 0x00e8, 0x00e9, 0x00ea, 0x00eb,
+	0x00ec, 0x00ed, 0x00ee, 0x00ef, 0x00f0, 0x00f1, 0x00f2, 0x00f3,
+	0x00f4, 0x00f5, 0x00f6, 0x00f7, 0x00fa, 0x00fb, 0x00fc, 0x00fd,
+	0x00fe, 0x00ff, 0x0100, 0x0101, 0x0102, 0x0103, 0x0104, 0x0105,
+	0x0106, 0x0107, 0x0108, 0x0109, 0x010a, 0x010b, 0x010c, 0x010d,
+	0x010e, 0x010f, 0x0110, 0x0111, 0x0112, 0x0113, 0x0114, 0x0117,
+	0x0118, 0x0119, 0x011a, 0x011b, 0x011c, 0x011d, 0x011e, 0x011f,
+	0x0120, 0x0121, 0x0122, 0x0123, 0x0124, 0x0125, 0x0126, 0x0127,
+	0x0128, 0x0129, 0x012a, 0x012b, 0x012c, 0x012d, 0x012e, 0x012f,
+	0x0130, 0x0131, 0x0132, 0x0133, 0x0134, 0x0135, 0x0136, 0x0137,
+	0x0138, 0x0139, 0x013a, 0x013b, 0x013c, 0x013d, 0x013e, 0x013f,
+	0x0140, 0x0141, 0x0142, 0x0143, 0x0144, 0x0145, 0x0146, 0x0147,
+	0x0148, 0x0149, 0x014a, 0x014b, 0x014c, 0x014d, 0x014e, 0x014f,
+	0x0150, 0x0151, 0x0152, 0x0153, 0x0154, 0x0155, 0x0156, 0x0157,
+	0x0158, 0x0159, 0x015a, 0x015b, 0x015c, 0x015d, 0x015e, 0x015f,
+	0x0160, 0x0161, 0x0162, 0x0163, 0x0164, 0x0165, 0x0166, 0x0167,
+	0x0168, 0x0169, 0x016a, 0x016b, 0x016c, 0x016d, 0x016e, 0x016f,
+	0x0170, 0x0171, 0x0172, 0x0173, 0x0174, 0x0175, 0x0176, 0x0177,
+	0x0178, 0x0179, 0x017a, 0x017b, 0x017c, 0x017d, 0x017e, 0x017f,
+	0x0180, 0x0181, 0x0182, 0x0183, 0x0184, 0x0185, 0x0186, 0x0187,
+	0x0188, 0x0189, 0x018a, 0x018b, 0x018c, 0x018d, 0x018e, 0x018f,
+	0x0190, 0x0191, 0x0192, 0x0193, 0x0194, 0x0195, 0x0196, 0x0197,
+	0x0198, 0x0199, 0x019a, 0x019b, 0x019c, 0x019d, 0x019e, 0x019f,
+	0x01a0, 0x01a1, 0x01a2, 0x01a3, 0x01a4, 0x01a5, 0x01a6, 0x01a7,
+	0x01a8, 0x01a9, 0x01aa, 0x01ab, 0x01ac, 0x01ad, 0x01ae, 0x01af,
+	0x01b0, 0x01b1, 0x01b2, 0x01b3, 0x01b4, 0x01b5, 0x01b6, 0x01b7,
+	0x01b8, 0x01b9, 0x01ba, 0x01bb, 0x01bc, 0x01bd, 0x01be, 0x01bf,
+	0x01c0, 0x01c1, 0x01c2, 0x01c3, 0x01c4, 0x01c5, 0x01c6, 0x01c7,
+	0x01c8, 0x01c9, 0x01ca, 0x01cb, 0x01cc, 0x01cd, 0x01ce, 0x01cf,
+	0x01d0, 0x01d1, 0x01d2, 0x01d3, 0x01d4, 0x01d5, 0x01d6, 0x01d7,
+	0x01d8, 0x01d9, 0x01da, 0x01db, 0x01dc, 0x01dd, 0x01de, 0x01df,
+	0x01e0, 0x01e1, 0x01e2, 0x01e3, 0x01e4, 0x01e5, 0x01e6, 0x01e7,
+	0x01e8, 0x01e9, 0x01ea, 0x01eb, 0x01ec, 0x01ed, 0x01ef, 0x01f0,
+	0x01f1, 0x01f2, 0x01f3, 0x01f4, 0x01f5, 0x01f6, 0x01f7, 0x01f8,
+	0x01f9, 0x01fa, 0x01fb, 0x01fc, 0x01fd, 0x01fe, 0x01ff, 0x0200,
+	0x0201, 0x0202, 0x0203, 0x0204, 0x0205, 0x0206, 0x0207, 0x0208,
+	0x0209, 0x020a, 0x020b, 0x020c, 0x020d, 0x020e, 0x020f, 0x0210,
+	0x0211, 0x0212, 0x0213, 0x0214, 0x0215, 0x0216, 0x0217, 0x0218,
+	0x0219, 0x021a, 0x021b, 0x021c, 0x021d, 0x021e, 0x021f, 0x0220,
+	0x0221, 0x0222, 0x0223, 0x0224, 0x0225, 0x0226, 0x0227, 0x0228,
+	0x0229, 0x022a, 0x022b, 0x022c, 0x022d, 0x022e, 0x022f, 0x0230,
+	0x0231, 0x0232, 0x0233, 0x0234, 0x0235, 0x0236, 0x0237, 0x0238,
+	0x0239, 0x023a, 0x023b, 0x023c, 0x023d, 0x023e, 0x023f, 0x0240,
+	0x0241, 0x0242, 0x0243, 0x0244, 0x0245, 0x0246, 0x0247, 0x0248,
+	0x0249, 0x024a, 0x024b, 0x024c, 0x024d, 0x024e, 0x024f, 0x0250,
+	0x0251, 0x0252, 0x0253, 0x0254, 0x0255, 0x0256, 0x0257, 0x0258,
+	0x0259, 0x025a, 0x025b, 0x025c, 0x025d, 0x025e, 0x025f, 0x0260,
+	0x0261, 0x0262, 0x0263, 0x0264, 0x0265, 0x0266, 0x0267, 0x0268,
+	0x0269, 0x026a, 0x026b, 0x026c, 0x026d, 0x026e, 0x026f, 0x0270,
+	0x0271, 0x0272, 0x0273, 0x0274, 0x0275, 0x0276, 0x0277, 0x0278,
+	0x0279, 0x027a, 0x027b, 0x027c, 0x027d, 0x027e, 0x027f, 0x0280,
+	0x0281, 0x0282, 0x0283, 0x0284, 0x0285, 0x0286, 0x0287, 0x0288,
+	0x0289, 0x028a, 0x028b, 0x028c, 0x028d, 0x028e, 0x028f, 0x0290,
+	0x0291, 0x0292, 0x0293, 0x0294, 0x0295, 0x0296, 0x0297, 0x0298,
+	0x0299, 0x029a, 0x029b, 0x029c, 0x029d, 0x029e, 0x029f, 0x02a0,
+	0x02a1, 0x02a2, 0x02a3, 0x02a4, 0x02a5, 0x02a6, 0x02a7, 0x02a8,
+	0x02a9, 0x02aa, 0x02ab, 0x02ac, 0x02ad, 0x02ae, 0x02af, 0x02b0,
+	0x02b1, 0x02b2, 0x02b3, 0x02b4, 0x02b5, 0x02b6, 0x02b7, 0x02b8,
+	0x02b9, 0x02ba, 0x02bb, 0x02bc, 0x02bd, 0x02be, 0x02bf, 0x02c0,
+	0x02c1, 0x02c2, 0x02c3, 0x02c4, 0x02c5, 0x02c6, 0x02c7, 0x02c8,
+	0x02c9, 0x02ca, 0x02cb, 0x02cc, 0x02cd, 0x02ce, 0x02cf, 0x02d0,
+	0x02d1, 0x02d2, 0x02d3, 0x02d4, 0x02d5, 0x02d6, 0x02d7, 0x02d8,
+	0x02d9, 0x02da, 0x02db, 0x02dc, 0x02dd, 0x02de, 0x02df, 0x02e0,
+	0x02e1, 0x02e2, 0x02e3, 0x02e4, 0x02e5, 0x02e6, 0x02e7, 0x02e8,
+	0x02e9, 0x02ea, 0x02eb, 0x02ec, 0x02ed, 0x02ee, 0x02ef, 0x02f0,
+	0x02f1, 0x02f2, 0x02f3, 0x02f4, 0x02f5, 0x02f6, 0x02f8, 0x02fa,
+	0x02fc, 0x02fe, 0x0300, 0x0301, 0x0303, 0x0304, 0x0305, 0x0306,
+	0x0307, 0x0308, 0x0309, 0x030a, 0x030b, 0x030c, 0x030d, 0x030e,
+	0x030f, 0x0310, 0x0311, 0x0312, 0x0313, 0x0314, 0x0315, 0x0316,
+	0x0317, 0x0318, 0x0319, 0x031a, 0x031b, 0x031c, 0x031d, 0x031e,
+	0x031f, 0x0320, 0x0321, 0x0322, 0x0323, 0x0324, 0x0325, 0x0326,
+	0x0327, 0x0328, 0x0329, 0x032a, 0x032b, 0x032c, 0x032d, 0x032e,
+	0x032f, 0x0330, 0x0331, 0x0332, 0x0333, 0x0334, 0x0335, 0x0336,
+	0x0337, 0x0338, 0x0339, 0x033a, 0x033b, 0x033c, 0x033d, 0x033e,
+	0x033f, 0x0340, 0x0341, 0x0342, 0x0343, 0x0344, 0x0345, 0x0346,
+	0x0347, 0x0348, 0x0349, 0x034a, 0x034b, 0x034c, 0x034d, 0x034e,
+	0x034f, 0x0350, 0x0351, 0x0352, 0x0353, 0x0354, 0x0355, 0x0356,
+	0x0357, 0x0359, 0x035c, 0x035f, 0x0362, 0x0363, 0x0364, 0x0365,
+	0x0366, 0x0367, 0x0368, 0x0369, 0x036a, 0x036b, 0x036c, 0x036d,
+	0x036e, 0x0370, 0x0372, 0x0374, 0x0376, 0x0378, 0x037a, 0x037c,
+	0x037e, 0x0380, 0x0382, 0x0384, 0x0386, 0x0388, 0x038a, 0x038c,
+	0x038e, 0x0390, 0x0392, 0x0394, 0x0396, 0x0398, 0x039a, 0x039c,
+	0x039e, 0x03a0, 0x03a2, 0x03a4, 0x03a6, 0x03a8, 0x03aa, 0x03ac,
+	0x03ae, 0x03b0, 0x03b2, 0x03b4, 0x03b6, 0x03b8, 0x03ba, 0x03bc,
+	0x03be, 0x03c0, 0x03c2, 0x03c4, 0x03c6, 0x03c8, 0x03ca, 0x03cc,
+	0x03ce, 0x03d0, 0x03d2, 0x03d4, 0x03d6, 0x03d9, 0x03da, 0x03db,
+	0x03dc, 0x03dd, 0x03df, 0x03e0, 0x03e2, 0x03e4, 0x03e6, 0x03e8,
+	0x03eb, 0x03ec, 0x03ed, 0x03ee, 0x03ef, 0x03f1, 0x03f4, 0x03f7,
+	0x03f9, 0x03fc, 0x03fd, 0x03fe, 0x03ff, 0x0400, 0x0403, 0x0406,
+	0x0408, 0x040a, 0x040d, 0x040e, 0x040f, 0x0410, 0x0411, 0x0412,
+	0x0414, 0x0416, 0x0418, 0x041a, 0x041d, 0x041e, 0x041f, 0x0420,
+	0x0421, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427, 0x0428, 0x0429,
+	0x042a, 0x042b, 0x042c, 0x042d, 0x042e, 0x042f, 0x0430, 0x0431,
+	0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437, 0x0438, 0x0439,
+	0x043a, 0x043b, 0x043c, 0x043d, 0x043e, 0x043f, 0x0440, 0x0441,
+	0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447, 0x0448, 0x0449,
+	0x044a, 0x044b, 0x044c, 0x044d, 0x044e, 0x044f, 0x0450, 0x0451,
+	0x0452, 0x0453, 0x0454, 0x0455, 0x0456, 0x0457, 0x0458, 0x0459,
+	0x045a, 0x045b, 0x045c, 0x045d, 0x045e, 0x045f, 0x0460, 0x0461,
+	0x0462, 0x0463, 0x0464, 0x0465, 0x0466, 0x0467, 0x0468, 0x0469,
+	0x046a, 0x046b, 0x046c, 0x046d, 0x046e, 0x046f, 0x0470, 0x0471,
+	0x0472, 0x0473, 0x0474, 0x0475, 0x0476, 0x0477, 0x0478, 0x0479,
+	0x047a, 0x047b, 0x047c, 0x047d, 0x047e, 0x047f, 0x0480, 0x0481,
+	0x0482, 0x0483, 0x0484, 0x0485, 0x0486, 0x0487, 0x0488, 0x0489,
+	0x048a, 0x048b, 0x048c, 0x048d, 0x048e, 0x048f, 0x0490, 0x0491,
+	0x0492, 0x0493, 0x0494, 0x0495, 0x0496, 0x0497, 0x0498, 0x0499,
+	0x049a, 0x049b, 0x049c, 0x049d, 0x049e, 0x049f, 0x04a0, 0x04a1,
+	0x04a2, 0x04a3, 0x04a4, 0x04a5, 0x04a6, 0x04a7, 0x04a8, 0x04a9,
+	0x04aa, 0x04ab, 0x04ac, 0x04ad, 0x04ae, 0x04af, 0x04b0, 0x04b1,
+	0x04b2, 0x04b3, 0x04b4, 0x04b5, 0x04b6, 0x04b7, 0x04b8, 0x04b9,
+	0x04ba, 0x04bb, 0x04bc, 0x04bd, 0x04be, 0x04bf, 0x04c0, 0x04c1,
+	0x04c2, 0x04c3, 0x04c4, 0x04c5, 0x04c6, 0x04c7, 0x04c8, 0x04c9,
+	0x04ca, 0x04cb, 0x04cc, 0x04cd, 0x04ce, 0x04cf, 0x04d0, 0x04d1,
+	0x04d2, 0x04d3, 0x04d4, 0x04d5, 0x04d6, 0x04d7, 0x04d8, 0x04d9,
+	0x04da, 0x04db, 0x04dc, 0x04dd, 0x04de, 0x04df, 0x04e0, 0x04e1,
+	0x04e2, 0x04e3, 0x04e4, 0x04e5, 0x04e6, 0x04e7, 0x04e8, 0x04e9,
+	0x04ea, 0x04eb, 0x04ec, 0x04ed, 0x04ee, 0x04ef, 0x04f0, 0x04f1,
+	0x04f2, 0x04f3, 0x04f4, 0x04f5, 0x04f6, 0x04f7, 0x04f8, 0x04f9,
+	0x04fa, 0x04fb, 0x04fc, 0x04fd, 0x04fe, 0x04ff, 0x0500, 0x0501,
+	0x0502, 0x0503, 0x0504, 0x0505, 0x0506, 0x0507, 0x0508, 0x0509,
+	0x050a, 0x050b, 0x050c, 0x050d, 0x050e, 0x050f, 0x0510, 0x0511,
+	0x0512, 0x0513, 0x0514, 0x0515, 0x0516, 0x0517, 0x0518, 0x0519,
+	0x051a, 0x051b, 0x051c, 0x051d, 0x051e, 0x051f, 0x0520, 0x0521,
+	0x0522, 0x0523, 0x0524, 0x0525, 0x0526, 0x0527, 0x0528, 0x0529,
+	0x052a, 0x052b, 0x052c, 0x052d, 0x052e, 0x052f, 0x0530, 0x0531,
+	0x0532, 0x0533, 0x0534, 0x0535, 0x0536, 0x0537, 0x0538, 0x0539,
+	0x053a, 0x053b, 0x053c, 0x053d, 0x053e, 0x053f, 0x0540, 0x0541,
+	0x0542, 0x0543, 0x0544, 0x0545, 0x0546, 0x0547, 0x0548, 0x0549,
+	0x054a, 0x054b, 0x054c, 0x054d, 0x054e, 0x054f, 0x0550, 0x0551,
+	0x0552, 0x0553, 0x0554, 0x0555, 0x0556, 0x0557, 0x0558, 0x0559,
+	0x055a, 0x055b, 0x055c, 0x055d, 0x055e, 0x055f, 0x0560, 0x0561,
+	0x0562, 0x0563, 0x0564, 0x0565, 0x0566, 0x0567, 0x0568, 0x0569,
+	0x056a, 0x056b, 0x056c, 0x056d, 0x056e, 0x056f, 0x0570, 0x0571,
+	0x0572, 0x0573, 0x0574, 0x0575, 0x0576, 0x0577, 0x0578, 0x0579,
+	0x057a, 0x057b, 0x057c, 0x057d, 0x057e, 0x057f, 0x0580, 0x0581,
+	0x0582, 0x0583, 0x0584, 0x0585, 0x0586, 0x0587, 0x0588, 0x0589,
+	0x058a, 0x058b, 0x058c, 0x058d, 0x058e, 0x058f, 0x0590, 0x0591,
+	0x0592, 0x0593, 0x0594, 0x0595, 0x0596, 0x0597, 0x0598, 0x059a,
+	0x059c, 0x059e, 0x05a1, 0x05a4, 0x05a6, 0x05a8, 0x05aa, 0x05ac,
+	0x05ae, 0x05b0, 0x05b2, 0x05b3, 0x05b4, 0x05b5, 0x05b6, 0x05b7,
+	0x05b8, 0x05b9, 0x05ba, 0x05bb, 0x05bc, 0x05bd, 0x05be, 0x05bf,
+	0x05c0, 0x05c1, 0x05c2, 0x05c3, 0x05c4, 0x05c5, 0x05c6, 0x05c7,
+	0x05c8, 0x05c9, 0x05ca, 0x05cb, 0x05cc, 0x05cd, 0x05ce, 0x05cf,
+	0x05d0, 0x05d1, 0x05d2, 0x05d3, 0x05d4, 0x05d5, 0x05d6, 0x05d7,
+	0x05d8, 0x05d9, 0x05da, 0x05db, 0x05dc, 0x05dd, 0x05de, 0x05df,
+	0x05e0, 0x05e1, 0x05e2, 0x05e3, 0x05e4, 0x05e5, 0x05e6, 0x05e7,
+	0x05e8, 0x05e9, 0x05ea, 0x05eb, 0x05ec, 0x05ed, 0x05ee, 0x05ef,
+	0x05f0, 0x05f1, 0x05f2, 0x05f3, 0x05f4, 0x05f5, 0x05f6, 0x05f7,
+	0x05f8, 0x05f9, 0x05fa, 0x05fb, 0x05fc, 0x05fd, 0x05fe, 0x05ff,
+	0x0600, 0x0601, 0x0602, 0x0603, 0x0604, 0x0605, 0x0606, 0x0607,
+	0x0608, 0x0609, 0x060a, 0x060b, 0x060c, 0x060d, 0x060e, 0x060f,
+	0x0610, 0x0611, 0x0612, 0x0613, 0x0614, 0x0615, 0x0616, 0x0617,
+	0x0618, 0x0619, 0x061a, 0x061b, 0x061c, 0x061d, 0x061e, 0x061f,
+	0x0620, 0x0621, 0x0622, 0x0623, 0x0624, 0x0625, 0x0626, 0x0627,
+	0x0628, 0x0629, 0x062a, 0x062b, 0x062c, 0x062d, 0x062e, 0x062f,
+	0x0630, 0x0631, 0x0632, 0x0633, 0x0634, 0x0635, 0x0636, 0x0637,
+	0x0638, 0x0639, 0x063a, 0x063b, 0x063c, 0x063d, 0x063e, 0x063f,
+	0x0640, 0x0641, 0x0642, 0x0643, 0x0644, 0x0645, 0x0646, 0x0647,
+	0x0648, 0x0649, 0x064a, 0x064b, 0x064c, 0x064d, 0x064e, 0x064f,
+	0x0650, 0x0651, 0x0652, 0x0653, 0x0654, 0x0655, 0x0656, 0x0657,
+	0x0658, 0x0659, 0x065a, 0x065b, 0x065c, 0x065d, 0x065e, 0x065f,
+	0x0660, 0x0661, 0x0662, 0x0663, 0x0664, 0x0665, 0x0666, 0x0667,
+	0x0668, 0x0669, 0x066a, 0x066b, 0x066c, 0x066d, 0x066e, 0x066f,
+	0x0670, 0x0671, 0x0672, 0x0673, 0x0674, 0x0675, 0x0676, 0x0677,
+	0x0678, 0x0679, 0x067a, 0x067b, 0x067c, 0x067d, 0x067e, 0x067f,
+	0x0680, 0x0681, 0x0682, 0x0683, 0x0684, 0x0685, 0x0686, 0x0687,
+	0x0688, 0x0689, 0x068a, 0x068b, 0x068c, 0x068d, 0x068e, 0x068f,
+	0x0690, 0x0691, 0x0692, 0x0693, 0x0694, 0x0695, 0x0696, 0x0697,
+	0x0698, 0x0699, 0x069a, 0x069b, 0x069c, 0x069d, 0x069e, 0x069f,
+	0x06a0, 0x06a1, 0x06a2, 0x06a3, 0x06a4, 0x06a5, 0x06a6, 0x06a7,
+	0x06a8, 0x06a9, 0x06aa, 0x06ab, 0x06ac, 0x06ad, 0x06ae, 0x06af,
+	0x06b0, 0x06b1, 0x06b2, 0x06b3, 0x06b4, 0x06b5, 0x06b6, 0x06b7,
+	0x06b8, 0x06b9, 0x06ba, 0x06bb, 0x06bc, 0x06bd, 0x06be, 0x06bf,
+	0x06c0, 0x06c1, 0x06c2, 0x06c3, 0x06c4, 0x06c5, 0x06c6, 0x06c7,
+	0x06c8, 0x06c9, 0x06ca, 0x06cb, 0x06cc, 0x06cd, 0x06ce, 0x06cf,
+	0x06d0, 0x06d1, 0x06d2, 0x06d3, 0x06d4, 0x06d5, 0x06d6, 0x06d7,
+	0x06d8, 0x06d9, 0x06da, 0x06db, 0x06dc, 0x06dd, 0x06de, 0x06df,
+	0x06e0, 0x06e1, 0x06e2, 0x06e3, 0x06e4, 0x06e5, 0x06e6, 0x06e7,
+	0x06e8, 0x06e9, 0x06ea, 0x06eb, 0x06ec, 0x06ed, 0x06ee, 0x06ef,
+	0x06f0, 0x06f1, 0x06f2, 0x06f3, 0x06f4, 0x06f5, 0x06f6, 0x06f7,
+	0x06f8, 0x06f9, 0x06fa, 0x06fb, 0x06fc, 0x06fd, 0x06fe, 0x06ff,
+}
+
+var foldRunes = [...]rune{
+	0x00061, 0x00062, 0x00063, 0x00064, 0x00065, 0x00066, 0x00067, 0x00068,
+	0x00069, 0x0006a, 0x0006b, 0x0006c, 0x0006d, 0x0006e, 0x0006f, 0x00070,
+	0x00071, 0x00072, 0x00073, 0x00074, 0x00075, 0x00076, 0x00077, 0x00078,
+	0x00079, 0x0007a, 0x003bc, 0x000e0, 0x000e1, 0x000e2, 0x000e3, 0x000e4,
+	0x000e5, 0x000e6, 0x000e7, 0x000e8, 0x000e9, 0x000ea, 0x000eb, 0x000ec,
+	0x000ed, 0x000ee, 0x000ef, 0x000f0, 0x000f1, 0x000f2, 0x000f3, 0x000f4,
+	0x000f5, 0x000f6, 0x000f8, 0x000f9, 0x000fa, 0x000fb, 0x000fc, 0x000fd,
+	0x000fe, 0x00073, 0x00073, 0x00101, 0x00103, 0x00105, 0x00107, 0x00109,
+	0x0010b, 0x0010d, 0x0010f, 0x00111, 0x00113, 0x00115, 0x00117, 0x00119,
+	0x0011b, 0x0011d, 0x0011f, 0x00121, 0x00123, 0x00125, 0x00127, 0x00129,
+	0x0012b, 0x0012d, 0x0012f, 0x00069, 0x00307, 0x00133, 0x00135, 0x00137,
+	0x0013a, 0x0013c, 0x0013e, 0x00140, 0x00142, 0x00144, 0x00146, 0x00148,
+	0x002bc, 0x0006e, 0x0014b, 0x0014d, 0x0014f, 0x00151, 0x00153, 0x00155,
+	0x00157, 0x00159, 0x0015b, 0x0015d, 0x0015f, 0x00161, 0x00163, 0x00165,
+	0x00167, 0x00169, 0x0016b, 0x0016d, 0x0016f, 0x00171, 0x00173, 0x00175,
+	0x00177, 0x000ff, 0x0017a, 0x0017c, 0x0017e, 0x00073, 0x00253, 0x00183,
+	0x00185, 0x00254, 0x00188, 0x00256, 0x00257, 0x0018c, 0x001dd, 0x00259,
+	0x0025b, 0x00192, 0x00260, 0x00263, 0x00269, 0x00268, 0x00199, 0x0026f,
+	0x00272, 0x00275, 0x001a1, 0x001a3, 0x001a5, 0x00280, 0x001a8, 0x00283,
+	0x001ad, 0x00288, 0x001b0, 0x0028a, 0x0028b, 0x001b4, 0x001b6, 0x00292,
+	0x001b9, 0x001bd, 0x001c6, 0x001c6, 0x001c9, 0x001c9, 0x001cc, 0x001cc,
+	0x001ce, 0x001d0, 0x001d2, 0x001d4, 0x001d6, 0x001d8, 0x001da, 0x001dc,
+	0x001df, 0x001e1, 0x001e3, 0x001e5, 0x001e7, 0x001e9, 0x001eb, 0x001ed,
+	0x001ef, 0x0006a, 0x0030c, 0x001f3, 0x001f3, 0x001f5, 0x00195, 0x001bf,
+	0x001f9, 0x001fb, 0x001fd, 0x001ff, 0x00201, 0x00203, 0x00205, 0x00207,
+	0x00209, 0x0020b, 0x0020d, 0x0020f, 0x00211, 0x00213, 0x00215, 0x00217,
+	0x00219, 0x0021b, 0x0021d, 0x0021f, 0x0019e, 0x00223, 0x00225, 0x00227,
+	0x00229, 0x0022b, 0x0022d, 0x0022f, 0x00231, 0x00233, 0x02c65, 0x0023c,
+	0x0019a, 0x02c66, 0x00242, 0x00180, 0x00289, 0x0028c, 0x00247, 0x00249,
+	0x0024b, 0x0024d, 0x0024f, 0x003b9, 0x00371, 0x00373, 0x00377, 0x003f3,
+	0x003ac, 0x003ad, 0x003ae, 0x003af, 0x003cc, 0x003cd, 0x003ce, 0x003b9,
+	0x00308, 0x00301, 0x003b1, 0x003b2, 0x003b3, 0x003b4, 0x003b5, 0x003b6,
+	0x003b7, 0x003b8, 0x003b9, 0x003ba, 0x003bb, 0x003bc, 0x003bd, 0x003be,
+	0x003bf, 0x003c0, 0x003c1, 0x003c3, 0x003c4, 0x003c5, 0x003c6, 0x003c7,
+	0x003c8, 0x003c9, 0x003ca, 0x003cb, 0x003c5, 0x00308, 0x00301, 0x003c3,
+	0x003d7, 0x003b2, 0x003b8, 0x003c6, 0x003c0, 0x003d9, 0x003db, 0x003dd,
+	0x003df, 0x003e1, 0x003e3, 0x003e5, 0x003e7, 0x003e9, 0x003eb, 0x003ed,
+	0x003ef, 0x003ba, 0x003c1, 0x003b8, 0x003b5, 0x003f8, 0x003f2, 0x003fb,
+	0x0037b, 0x0037c, 0x0037d, 0x00450, 0x00451, 0x00452, 0x00453, 0x00454,
+	0x00455, 0x00456, 0x00457, 0x00458, 0x00459, 0x0045a, 0x0045b, 0x0045c,
+	0x0045d, 0x0045e, 0x0045f, 0x00430, 0x00431, 0x00432, 0x00433, 0x00434,
+	0x00435, 0x00436, 0x00437, 0x00438, 0x00439, 0x0043a, 0x0043b, 0x0043c,
+	0x0043d, 0x0043e, 0x0043f, 0x00440, 0x00441, 0x00442, 0x00443, 0x00444,
+	0x00445, 0x00446, 0x00447, 0x00448, 0x00449, 0x0044a, 0x0044b, 0x0044c,
+	0x0044d, 0x0044e, 0x0044f, 0x00461, 0x00463, 0x00465, 0x00467, 0x00469,
+	0x0046b, 0x0046d, 0x0046f, 0x00471, 0x00473, 0x00475, 0x00477, 0x00479,
+	0x0047b, 0x0047d, 0x0047f, 0x00481, 0x0048b, 0x0048d, 0x0048f, 0x00491,
+	0x00493, 0x00495, 0x00497, 0x00499, 0x0049b, 0x0049d, 0x0049f, 0x004a1,
+	0x004a3, 0x004a5, 0x004a7, 0x004a9, 0x004ab, 0x004ad, 0x004af, 0x004b1,
+	0x004b3, 0x004b5, 0x004b7, 0x004b9, 0x004bb, 0x004bd, 0x004bf, 0x004cf,
+	0x004c2, 0x004c4, 0x004c6, 0x004c8, 0x004ca, 0x004cc, 0x004ce, 0x004d1,
+	0x004d3, 0x004d5, 0x004d7, 0x004d9, 0x004db, 0x004dd, 0x004df, 0x004e1,
+	0x004e3, 0x004e5, 0x004e7, 0x004e9, 0x004eb, 0x004ed, 0x004ef, 0x004f1,
+	0x004f3, 0x004f5, 0x004f7, 0x004f9, 0x004fb, 0x004fd, 0x004ff, 0x00501,
+	0x00503, 0x00505, 0x00507, 0x00509, 0x0050b, 0x0050d, 0x0050f, 0x00511,
+	0x00513, 0x00515, 0x00517, 0x00519, 0x0051b, 0x0051d, 0x0051f, 0x00521,
+	0x00523, 0x00525, 0x00527, 0x00529, 0x0052b, 0x0052d, 0x0052f, 0x00561,
+	0x00562, 0x00563, 0x00564, 0x00565, 0x00566, 0x00567, 0x00568, 0x00569,
+	0x0056a, 0x0056b, 0x0056c, 0x0056d, 0x0056e, 0x0056f, 0x00570, 0x00571,
+	0x00572, 0x00573, 0x00574, 0x00575, 0x00576, 0x00577, 0x00578, 0x00579,
+	0x0057a, 0x0057b, 0x0057c, 0x0057d, 0x0057e, 0x0057f, 0x00580, 0x00581,
+	0x00582, 0x00583, 0x00584, 0x00585, 0x00586, 0x00565, 0x00582, 0x02d00,
+	0x02d01, 0x02d02, 0x02d03, 0x02d04, 0x02d05, 0x02d06, 0x02d07, 0x02d08,
+	0x02d09, 0x02d0a, 0x02d0b, 0x02d0c, 0x02d0d, 0x02d0e, 0x02d0f, 0x02d10,
+	0x02d11, 0x02d12, 0x02d13, 0x02d14, 0x02d15, 0x02d16, 0x02d17, 0x02d18,
+	0x02d19, 0x02d1a, 0x02d1b, 0x02d1c, 0x02d1d, 0x02d1e, 0x02d1f, 0x02d20,
+	0x02d21, 0x02d22, 0x02d23, 0x02d24, 0x02d25, 0x02d27, 0x02d2d, 0x0ab70,
+	0x0ab71, 0x0ab72, 0x0ab73, 0x0ab74, 0x0ab75, 0x0ab76, 0x0ab77, 0x0ab78,
+	0x0ab79, 0x0ab7a, 0x0ab7b, 0x0ab7c, 0x0ab7d, 0x0ab7e, 0x0ab7f, 0x0ab80,
+	0x0ab81, 0x0ab82, 0x0ab83, 0x0ab84, 0x0ab85, 0x0ab86, 0x0ab87, 0x0ab88,
+	0x0ab89, 0x0ab8a, 0x0ab8b, 0x0ab8c, 0x0ab8d, 0x0ab8e, 0x0ab8f, 0x0ab90,
+	0x0ab91, 0x0ab92, 0x0ab93, 0x0ab94, 0x0ab95, 0x0ab96, 0x0ab97, 0x0ab98,
+	0x0ab99, 0x0ab9a, 0x0ab9b, 0x0ab9c, 0x0ab9d, 0x0ab9e, 0x0ab9f, 0x0aba0,
+	0x0aba1, 0x0aba2, 0x0aba3, 0x0aba4, 0x0aba5, 0x0aba6, 0x0aba7, 0x0aba8,
+	0x0aba9, 0x0abaa, 0x0abab, 0x0abac, 0x0abad, 0x0abae, 0x0abaf, 0x0abb0,
+	0x0abb1, 0x0abb2, 0x0abb3, 0x0abb4, 0x0abb5, 0x0abb6, 0x0abb7, 0x0abb8,
+	0x0abb9, 0x0abba, 0x0abbb, 0x0abbc, 0x0abbd, 0x0abbe, 0x0abbf, 0x013f8,
+	0x013f9, 0x013fa, 0x013fb, 0x013fc, 0x013fd, 0x013f0, 0x013f1, 0x013f2,
+	0x013f3, 0x013f4, 0x013f5, 0x00432, 0x00434, 0x0043e, 0x00441, 0x00442,
+	0x00442, 0x0044a, 0x00463, 0x0a64b, 0x01c8a, 0x010d0, 0x010d1, 0x010d2,
+	0x010d3, 0x010d4, 0x010d5, 0x010d6, 0x010d7, 0x010d8, 0x010d9, 0x010da,
+	0x010db, 0x010dc, 0x010dd, 0x010de, 0x010df, 0x010e0, 0x010e1, 0x010e2,
+	0x010e3, 0x010e4, 0x010e5, 0x010e6, 0x010e7, 0x010e8, 0x010e9, 0x010ea,
+	0x010eb, 0x010ec, 0x010ed, 0x010ee, 0x010ef, 0x010f0, 0x010f1, 0x010f2,
+	0x010f3, 0x010f4, 0x010f5, 0x010f6, 0x010f7, 0x010f8, 0x010f9, 0x010fa,
+	0x010fd, 0x010fe, 0x010ff, 0x01e01, 0x01e03, 0x01e05, 0x01e07, 0x01e09,
+	0x01e0b, 0x01e0d, 0x01e0f, 0x01e11, 0x01e13, 0x01e15, 0x01e17, 0x01e19,
+	0x01e1b, 0x01e1d, 0x01e1f, 0x01e21, 0x01e23, 0x01e25, 0x01e27, 0x01e29,
+	0x01e2b, 0x01e2d, 0x01e2f, 0x01e31, 0x01e33, 0x01e35, 0x01e37, 0x01e39,
+	0x01e3b, 0x01e3d, 0x01e3f, 0x01e41, 0x01e43, 0x01e45, 0x01e47, 0x01e49,
+	0x01e4b, 0x01e4d, 0x01e4f, 0x01e51, 0x01e53, 0x01e55, 0x01e57, 0x01e59,
+	0x01e5b, 0x01e5d, 0x01e5f, 0x01e61, 0x01e63, 0x01e65, 0x01e67, 0x01e69,
+	0x01e6b, 0x01e6d, 0x01e6f, 0x01e71, 0x01e73, 0x01e75, 0x01e77, 0x01e79,
+	0x01e7b, 0x01e7d, 0x01e7f, 0x01e81, 0x01e83, 0x01e85, 0x01e87, 0x01e89,
+	0x01e8b, 0x01e8d, 0x01e8f, 0x01e91, 0x01e93, 0x01e95, 0x00068, 0x00331,
+	0x00074, 0x00308, 0x00077, 0x0030a, 0x00079, 0x0030a, 0x00061, 0x002be,
+	0x01e61, 0x00073, 0x00073, 0x01ea1, 0x01ea3, 0x01ea5, 0x01ea7, 0x01ea9,
+	0x01eab, 0x01ead, 0x01eaf, 0x01eb1, 0x01eb3, 0x01eb5, 0x01eb7, 0x01eb9,
+	0x01ebb, 0x01ebd, 0x01ebf, 0x01ec1, 0x01ec3, 0x01ec5, 0x01ec7, 0x01ec9,
+	0x01ecb, 0x01ecd, 0x01ecf, 0x01ed1, 0x01ed3, 0x01ed5, 0x01ed7, 0x01ed9,
+	0x01edb, 0x01edd, 0x01edf, 0x01ee1, 0x01ee3, 0x01ee5, 0x01ee7, 0x01ee9,
+	0x01eeb, 0x01eed, 0x01eef, 0x01ef1, 0x01ef3, 0x01ef5, 0x01ef7, 0x01ef9,
+	0x01efb, 0x01efd, 0x01eff, 0x01f00, 0x01f01, 0x01f02, 0x01f03, 0x01f04,
+	0x01f05, 0x01f06, 0x01f07, 0x01f10, 0x01f11, 0x01f12, 0x01f13, 0x01f14,
+	0x01f15, 0x01f20, 0x01f21, 0x01f22, 0x01f23, 0x01f24, 0x01f25, 0x01f26,
+	0x01f27, 0x01f30, 0x01f31, 0x01f32, 0x01f33, 0x01f34, 0x01f35, 0x01f36,
+	0x01f37, 0x01f40, 0x01f41, 0x01f42, 0x01f43, 0x01f44, 0x01f45, 0x003c5,
+	0x00313, 0x003c5, 0x00313, 0x00300, 0x003c5, 0x00313, 0x00301, 0x003c5,
+	0x00313, 0x00342, 0x01f51, 0x01f53, 0x01f55, 0x01f57, 0x01f60, 0x01f61,
+	0x01f62, 0x01f63, 0x01f64, 0x01f65, 0x01f66, 0x01f67, 0x01f00, 0x003b9,
+	0x01f01, 0x003b9, 0x01f02, 0x003b9, 0x01f03, 0x003b9, 0x01f04, 0x003b9,
+	0x01f05, 0x003b9, 0x01f06, 0x003b9, 0x01f07, 0x003b9, 0x01f00, 0x003b9,
+	0x01f01, 0x003b9, 0x01f02, 0x003b9, 0x01f03, 0x003b9, 0x01f04, 0x003b9,
+	0x01f05, 0x003b9, 0x01f06, 0x003b9, 0x01f07, 0x003b9, 0x01f20, 0x003b9,
+	0x01f21, 0x003b9, 0x01f22, 0x003b9, 0x01f23, 0x003b9, 0x01f24, 0x003b9,
+	0x01f25, 0x003b9, 0x01f26, 0x003b9, 0x01f27, 0x003b9, 0x01f20, 0x003b9,
+	0x01f21, 0x003b9, 0x01f22, 0x003b9, 0x01f23, 0x003b9, 0x01f24, 0x003b9,
+	0x01f25, 0x003b9, 0x01f26, 0x003b9, 0x01f27, 0x003b9, 0x01f60, 0x003b9,
+	0x01f61, 0x003b9, 0x01f62, 0x003b9, 0x01f63, 0x003b9, 0x01f64, 0x003b9,
+	0x01f65, 0x003b9, 0x01f66, 0x003b9, 0x01f67, 0x003b9, 0x01f60, 0x003b9,
+	0x01f61, 0x003b9, 0x01f62, 0x003b9, 0x01f63, 0x003b9, 0x01f64, 0x003b9,
+	0x01f65, 0x003b9, 0x01f66, 0x003b9, 0x01f67, 0x003b9, 0x01f70, 0x003b9,
+	0x003b1, 0x003b9, 0x003ac, 0x003b9, 0x003b1, 0x00342, 0x003b1, 0x00342,
+	0x003b9, 0x01fb0, 0x01fb1, 0x01f70, 0x01f71, 0x003b1, 0x003b9, 0x003b9,
+	0x01f74, 0x003b9, 0x003b7, 0x003b9, 0x003ae, 0x003b9, 0x003b7, 0x00342,
+	0x003b7, 0x00342, 0x003b9, 0x01f72, 0x01f73, 0x01f74, 0x01f75, 0x003b7,
+	0x003b9, 0x003b9, 0x00308, 0x00300, 0x003b9, 0x00308, 0x00301, 0x003b9,
+	0x00342, 0x003b9, 0x00308, 0x00342, 0x01fd0, 0x01fd1, 0x01f76, 0x01f77,
+	0x003c5, 0x00308, 0x00300, 0x003c5, 0x00308, 0x00301, 0x003c1, 0x00313,
+	0x003c5, 0x00342, 0x003c5, 0x00308, 0x00342, 0x01fe0, 0x01fe1, 0x01f7a,
+	0x01f7b, 0x01fe5, 0x01f7c, 0x003b9, 0x003c9, 0x003b9, 0x003ce, 0x003b9,
+	0x003c9, 0x00342, 0x003c9, 0x00342, 0x003b9, 0x01f78, 0x01f79, 0x01f7c,
+	0x01f7d, 0x003c9, 0x003b9, 0x003c9, 0x0006b, 0x000e5, 0x0214e, 0x02170,
+	0x02171, 0x02172, 0x02173, 0x02174, 0x02175, 0x02176, 0x02177, 0x02178,
+	0x02179, 0x0217a, 0x0217b, 0x0217c, 0x0217d, 0x0217e, 0x0217f, 0x02184,
+	0x024d0, 0x024d1, 0x024d2, 0x024d3, 0x024d4, 0x024d5, 0x024d6, 0x024d7,
+	0x024d8, 0x024d9, 0x024da, 0x024db, 0x024dc, 0x024dd, 0x024de, 0x024df,
+	0x024e0, 0x024e1, 0x024e2, 0x024e3, 0x024e4, 0x024e5, 0x024e6, 0x024e7,
+	0x024e8, 0x024e9, 0x02c30, 0x02c31, 0x02c32, 0x02c33, 0x02c34, 0x02c35,
+	0x02c36, 0x02c37, 0x02c38, 0x02c39, 0x02c3a, 0x02c3b, 0x02c3c, 0x02c3d,
+	0x02c3e, 0x02c3f, 0x02c40, 0x02c41, 0x02c42, 0x02c43, 0x02c44, 0x02c45,
+	0x02c46, 0x02c47, 0x02c48, 0x02c49, 0x02c4a, 0x02c4b, 0x02c4c, 0x02c4d,
+	0x02c4e, 0x02c4f, 0x02c50, 0x02c51, 0x02c52, 0x02c53, 0x02c54, 0x02c55,
+	0x02c56, 0x02c57, 0x02c58, 0x02c59, 0x02c5a, 0x02c5b, 0x02c5c, 0x02c5d,
+	0x02c5e, 0x02c5f, 0x02c61, 0x0026b, 0x01d7d, 0x0027d, 0x02c68, 0x02c6a,
+	0x02c6c, 0x00251, 0x00271, 0x00250, 0x00252, 0x02c73, 0x02c76, 0x0023f,
+	0x00240, 0x02c81, 0x02c83, 0x02c85, 0x02c87, 0x02c89, 0x02c8b, 0x02c8d,
+	0x02c8f, 0x02c91, 0x02c93, 0x02c95, 0x02c97, 0x02c99, 0x02c9b, 0x02c9d,
+	0x02c9f, 0x02ca1, 0x02ca3, 0x02ca5, 0x02ca7, 0x02ca9, 0x02cab, 0x02cad,
+	0x02caf, 0x02cb1, 0x02cb3, 0x02cb5, 0x02cb7, 0x02cb9, 0x02cbb, 0x02cbd,
+	0x02cbf, 0x02cc1, 0x02cc3, 0x02cc5, 0x02cc7, 0x02cc9, 0x02ccb, 0x02ccd,
+	0x02ccf, 0x02cd1, 0x02cd3, 0x02cd5, 0x02cd7, 0x02cd9, 0x02cdb, 0x02cdd,
+	0x02cdf, 0x02ce1, 0x02ce3, 0x02cec, 0x02cee, 0x02cf3, 0x0a641, 0x0a643,
+	0x0a645, 0x0a647, 0x0a649, 0x0a64b, 0x0a64d, 0x0a64f, 0x0a651, 0x0a653,
+	0x0a655, 0x0a657, 0x0a659, 0x0a65b, 0x0a65d, 0x0a65f, 0x0a661, 0x0a663,
+	0x0a665, 0x0a667, 0x0a669, 0x0a66b, 0x0a66d, 0x0a681, 0x0a683, 0x0a685,
+	0x0a687, 0x0a689, 0x0a68b, 0x0a68d, 0x0a68f, 0x0a691, 0x0a693, 0x0a695,
+	0x0a697, 0x0a699, 0x0a69b, 0x0a723, 0x0a725, 0x0a727, 0x0a729, 0x0a72b,
+	0x0a72d, 0x0a72f, 0x0a733, 0x0a735, 0x0a737, 0x0a739, 0x0a73b, 0x0a73d,
+	0x0a73f, 0x0a741, 0x0a743, 0x0a745, 0x0a747, 0x0a749, 0x0a74b, 0x0a74d,
+	0x0a74f, 0x0a751, 0x0a753, 0x0a755, 0x0a757, 0x0a759, 0x0a75b, 0x0a75d,
+	0x0a75f, 0x0a761, 0x0a763, 0x0a765, 0x0a767, 0x0a769, 0x0a76b, 0x0a76d,
+	0x0a76f, 0x0a77a, 0x0a77c, 0x01d79, 0x0a77f, 0x0a781, 0x0a783, 0x0a785,
+	0x0a787, 0x0a78c, 0x00265, 0x0a791, 0x0a793, 0x0a797, 0x0a799, 0x0a79b,
+	0x0a79d, 0x0a79f, 0x0a7a1, 0x0a7a3, 0x0a7a5, 0x0a7a7, 0x0a7a9, 0x00266,
+	0x0025c, 0x00261, 0x0026c, 0x0026a, 0x0029e, 0x00287, 0x0029d, 0x0ab53,
+	0x0a7b5, 0x0a7b7, 0x0a7b9, 0x0a7bb, 0x0a7bd, 0x0a7bf, 0x0a7c1, 0x0a7c3,
+	0x0a794, 0x00282, 0x01d8e, 0x0a7c8, 0x0a7ca, 0x00264, 0x0a7cd, 0x0a7cf,
+	0x0a7d1, 0x0a7d3, 0x0a7d5, 0x0a7d7, 0x0a7d9, 0x0a7db, 0x0019b, 0x0a7f6,
+	0x013a0, 0x013a1, 0x013a2, 0x013a3, 0x013a4, 0x013a5, 0x013a6, 0x013a7,
+	0x013a8, 0x013a9, 0x013aa, 0x013ab, 0x013ac, 0x013ad, 0x013ae, 0x013af,
+	0x013b0, 0x013b1, 0x013b2, 0x013b3, 0x013b4, 0x013b5, 0x013b6, 0x013b7,
+	0x013b8, 0x013b9, 0x013ba, 0x013bb, 0x013bc, 0x013bd, 0x013be, 0x013bf,
+	0x013c0, 0x013c1, 0x013c2, 0x013c3, 0x013c4, 0x013c5, 0x013c6, 0x013c7,
+	0x013c8, 0x013c9, 0x013ca, 0x013cb, 0x013cc, 0x013cd, 0x013ce, 0x013cf,
+	0x013d0, 0x013d1, 0x013d2, 0x013d3, 0x013d4, 0x013d5, 0x013d6, 0x013d7,
+	0x013d8, 0x013d9, 0x013da, 0x013db, 0x013dc, 0x013dd, 0x013de, 0x013df,
+	0x013e0, 0x013e1, 0x013e2, 0x013e3, 0x013e4, 0x013e5, 0x013e6, 0x013e7,
+	0x013e8, 0x013e9, 0x013ea, 0x013eb, 0x013ec, 0x013ed, 0x013ee, 0x013ef,
+	0x00066, 0x00066, 0x00066, 0x00069, 0x00066, 0x0006c, 0x00066, 0x00066,
+	0x00069, 0x00066, 0x00066, 0x0006c, 0x00073, 0x00074, 0x00073, 0x00074,
+	0x00574, 0x00576, 0x00574, 0x00565, 0x00574, 0x0056b, 0x0057e, 0x00576,
+	0x00574, 0x0056d, 0x0ff41, 0x0ff42, 0x0ff43, 0x0ff44, 0x0ff45, 0x0ff46,
+	0x0ff47, 0x0ff48, 0x0ff49, 0x0ff4a, 0x0ff4b, 0x0ff4c, 0x0ff4d, 0x0ff4e,
+	0x0ff4f, 0x0ff50, 0x0ff51, 0x0ff52, 0x0ff53, 0x0ff54, 0x0ff55, 0x0ff56,
+	0x0ff57, 0x0ff58, 0x0ff59, 0x0ff5a, 0x10428, 0x10429, 0x1042a, 0x1042b,
+	0x1042c, 0x1042d, 0x1042e, 0x1042f, 0x10430, 0x10431, 0x10432, 0x10433,
+	0x10434, 0x10435, 0x10436, 0x10437, 0x10438, 0x10439, 0x1043a, 0x1043b,
+	0x1043c, 0x1043d, 0x1043e, 0x1043f, 0x10440, 0x10441, 0x10442, 0x10443,
+	0x10444, 0x10445, 0x10446, 0x10447, 0x10448, 0x10449, 0x1044a, 0x1044b,
+	0x1044c, 0x1044d, 0x1044e, 0x1044f, 0x104d8, 0x104d9, 0x104da, 0x104db,
+	0x104dc, 0x104dd, 0x104de, 0x104df, 0x104e0, 0x104e1, 0x104e2, 0x104e3,
+	0x104e4, 0x104e5, 0x104e6, 0x104e7, 0x104e8, 0x104e9, 0x104ea, 0x104eb,
+	0x104ec, 0x104ed, 0x104ee, 0x104ef, 0x104f0, 0x104f1, 0x104f2, 0x104f3,
+	0x104f4, 0x104f5, 0x104f6, 0x104f7, 0x104f8, 0x104f9, 0x104fa, 0x104fb,
+	0x10597, 0x10598, 0x10599, 0x1059a, 0x1059b, 0x1059c, 0x1059d, 0x1059e,
+	0x1059f, 0x105a0, 0x105a1, 0x105a3, 0x105a4, 0x105a5, 0x105a6, 0x105a7,
+	0x105a8, 0x105a9, 0x105aa, 0x105ab, 0x105ac, 0x105ad, 0x105ae, 0x105af,
+	0x105b0, 0x105b1, 0x105b3, 0x105b4, 0x105b5, 0x105b6, 0x105b7, 0x105b8,
+	0x105b9, 0x105bb, 0x105bc, 0x10cc0, 0x10cc1, 0x10cc2, 0x10cc3, 0x10cc4,
+	0x10cc5, 0x10cc6, 0x10cc7, 0x10cc8, 0x10cc9, 0x10cca, 0x10ccb, 0x10ccc,
+	0x10ccd, 0x10cce, 0x10ccf, 0x10cd0, 0x10cd1, 0x10cd2, 0x10cd3, 0x10cd4,
+	0x10cd5, 0x10cd6, 0x10cd7, 0x10cd8, 0x10cd9, 0x10cda, 0x10cdb, 0x10cdc,
+	0x10cdd, 0x10cde, 0x10cdf, 0x10ce0, 0x10ce1, 0x10ce2, 0x10ce3, 0x10ce4,
+	0x10ce5, 0x10ce6, 0x10ce7, 0x10ce8, 0x10ce9, 0x10cea, 0x10ceb, 0x10cec,
+	0x10ced, 0x10cee, 0x10cef, 0x10cf0, 0x10cf1, 0x10cf2, 0x10d70, 0x10d71,
+	0x10d72, 0x10d73, 0x10d74, 0x10d75, 0x10d76, 0x10d77, 0x10d78, 0x10d79,
+	0x10d7a, 0x10d7b, 0x10d7c, 0x10d7d, 0x10d7e, 0x10d7f, 0x10d80, 0x10d81,
+	0x10d82, 0x10d83, 0x10d84, 0x10d85, 0x118c0, 0x118c1, 0x118c2, 0x118c3,
+	0x118c4, 0x118c5, 0x118c6, 0x118c7, 0x118c8, 0x118c9, 0x118ca, 0x118cb,
+	0x118cc, 0x118cd, 0x118ce, 0x118cf, 0x118d0, 0x118d1, 0x118d2, 0x118d3,
+	0x118d4, 0x118d5, 0x118d6, 0x118d7, 0x118d8, 0x118d9, 0x118da, 0x118db,
+	0x118dc, 0x118dd, 0x118de, 0x118df, 0x16e60, 0x16e61, 0x16e62, 0x16e63,
+	0x16e64, 0x16e65, 0x16e66, 0x16e67, 0x16e68, 0x16e69, 0x16e6a, 0x16e6b,
+	0x16e6c, 0x16e6d, 0x16e6e, 0x16e6f, 0x16e70, 0x16e71, 0x16e72, 0x16e73,
+	0x16e74, 0x16e75, 0x16e76, 0x16e77, 0x16e78, 0x16e79, 0x16e7a, 0x16e7b,
+	0x16e7c, 0x16e7d, 0x16e7e, 0x16e7f, 0x16ebb, 0x16ebc, 0x16ebd, 0x16ebe,
+	0x16ebf, 0x16ec0, 0x16ec1, 0x16ec2, 0x16ec3, 0x16ec4, 0x16ec5, 0x16ec6,
+	0x16ec7, 0x16ec8, 0x16ec9, 0x16eca, 0x16ecb, 0x16ecc, 0x16ecd, 0x16ece,
+	0x16ecf, 0x16ed0, 0x16ed1, 0x16ed2, 0x16ed3, 0x1e922, 0x1e923, 0x1e924,
+	0x1e925, 0x1e926, 0x1e927, 0x1e928, 0x1e929, 0x1e92a, 0x1e92b, 0x1e92c,
+	0x1e92d, 0x1e92e, 0x1e92f, 0x1e930, 0x1e931, 0x1e932, 0x1e933, 0x1e934,
+	0x1e935, 0x1e936, 0x1e937, 0x1e938, 0x1e939, 0x1e93a, 0x1e93b, 0x1e93c,
+	0x1e93d, 0x1e93e, 0x1e93f, 0x1e940, 0x1e941, 0x1e942, 0x1e943,
+}
+
+var compositionPairs = [...]uint64{
+	0x00007800338, 0x00007a00338, 0x00007c00338, 0x00008200300,
+	0x00008200301, 0x00008200302, 0x00008200303, 0x00008200304,
+	0x00008200306, 0x00008200307, 0x00008200308, 0x00008200309,
+	0x0000820030a, 0x0000820030c, 0x0000820030f, 0x00008200311,
+	0x00008200323, 0x00008200325, 0x00008200328, 0x00008400307,
+	0x00008400323, 0x00008400331, 0x00008600301, 0x00008600302,
+	0x00008600307, 0x0000860030c, 0x00008600327, 0x00008800307,
+	0x0000880030c, 0x00008800323, 0x00008800327, 0x0000880032d,
+	0x00008800331, 0x00008a00300, 0x00008a00301, 0x00008a00302,
+	0x00008a00303, 0x00008a00304, 0x00008a00306, 0x00008a00307,
+	0x00008a00308, 0x00008a00309, 0x00008a0030c, 0x00008a0030f,
+	0x00008a00311, 0x00008a00323, 0x00008a00327, 0x00008a00328,
+	0x00008a0032d, 0x00008a00330, 0x00008c00307, 0x00008e00301,
+	0x00008e00302, 0x00008e00304, 0x00008e00306, 0x00008e00307,
+	0x00008e0030c, 0x00008e00327, 0x00009000302, 0x00009000307,
+	0x00009000308, 0x0000900030c, 0x00009000323, 0x00009000327,
+	0x0000900032e, 0x00009200300, 0x00009200301, 0x00009200302,
+	0x00009200303, 0x00009200304, 0x00009200306, 0x00009200307,
+	0x00009200308, 0x00009200309, 0x0000920030c, 0x0000920030f,
+	0x00009200311, 0x00009200323, 0x00009200328, 0x00009200330,
+	0x00009400302, 0x00009600301, 0x0000960030c, 0x00009600323,
+	0x00009600327, 0x00009600331, 0x00009800301, 0x0000980030c,
+	0x00009800323, 0x00009800327, 0x0000980032d, 0x00009800331,
+	0x00009a00301, 0x00009a00307, 0x00009a00323, 0x00009c00300,
+	0x00009c00301, 0x00009c00303, 0x00009c00307, 0x00009c0030c,
+	0x00009c00323, 0x00009c00327, 0x00009c0032d, 0x00009c00331,
+	0x00009e00300, 0x00009e00301, 0x00009e00302, 0x00009e00303,
+	0x00009e00304, 0x00009e00306, 0x00009e00307, 0x00009e00308,
+	0x00009e00309, 0x00009e0030b, 0x00009e0030c, 0x00009e0030f,
+	0x00009e00311, 0x00009e0031b, 0x00009e00323, 0x00009e00328,
+	0x0000a000301, 0x0000a000307, 0x0000a400301, 0x0000a400307,
+	0x0000a40030c, 0x0000a40030f, 0x0000a400311, 0x0000a400323,
+	0x0000a400327, 0x0000a400331, 0x0000a600301, 0x0000a600302,
+	0x0000a600307, 0x0000a60030c, 0x0000a600323, 0x0000a600326,
+	0x0000a600327, 0x0000a800307, 0x0000a80030c, 0x0000a800323,
+	0x0000a800326, 0x0000a800327, 0x0000a80032d, 0x0000a800331,
+	0x0000aa00300, 0x0000aa00301, 0x0000aa00302, 0x0000aa00303,
+	0x0000aa00304, 0x0000aa00306, 0x0000aa00308, 0x0000aa00309,
+	0x0000aa0030a, 0x0000aa0030b, 0x0000aa0030c, 0x0000aa0030f,
+	0x0000aa00311, 0x0000aa0031b, 0x0000aa00323, 0x0000aa00324,
+	0x0000aa00328, 0x0000aa0032d, 0x0000aa00330, 0x0000ac00303,
+	0x0000ac00323, 0x0000ae00300, 0x0000ae00301, 0x0000ae00302,
+	0x0000ae00307, 0x0000ae00308, 0x0000ae00323, 0x0000b000307,
+	0x0000b000308, 0x0000b200300, 0x0000b200301, 0x0000b200302,
+	0x0000b200303, 0x0000b200304, 0x0000b200307, 0x0000b200308,
+	0x0000b200309, 0x0000b200323, 0x0000b400301, 0x0000b400302,
+	0x0000b400307, 0x0000b40030c, 0x0000b400323, 0x0000b400331,
+	0x0000c200300, 0x0000c200301, 0x0000c200302, 0x0000c200303,
+	0x0000c200304, 0x0000c200306, 0x0000c200307, 0x0000c200308,
+	0x0000c200309, 0x0000c20030a, 0x0000c20030c, 0x0000c20030f,
+	0x0000c200311, 0x0000c200323, 0x0000c200325, 0x0000c200328,
+	0x0000c400307, 0x0000c400323, 0x0000c400331, 0x0000c600301,
+	0x0000c600302, 0x0000c600307, 0x0000c60030c, 0x0000c600327,
+	0x0000c800307, 0x0000c80030c, 0x0000c800323, 0x0000c800327,
+	0x0000c80032d, 0x0000c800331, 0x0000ca00300, 0x0000ca00301,
+	0x0000ca00302, 0x0000ca00303, 0x0000ca00304, 0x0000ca00306,
+	0x0000ca00307, 0x0000ca00308, 0x0000ca00309, 0x0000ca0030c,
+	0x0000ca0030f, 0x0000ca00311, 0x0000ca00323, 0x0000ca00327,
+	0x0000ca00328, 0x0000ca0032d, 0x0000ca00330, 0x0000cc00307,
+	0x0000ce00301, 0x0000ce00302, 0x0000ce00304, 0x0000ce00306,
+	0x0000ce00307, 0x0000ce0030c, 0x0000ce00327, 0x0000d000302,
+	0x0000d000307, 0x0000d000308, 0x0000d00030c, 0x0000d000323,
+	0x0000d000327, 0x0000d00032e, 0x0000d000331, 0x0000d200300,
+	0x0000d200301, 0x0000d200302, 0x0000d200303, 0x0000d200304,
+	0x0000d200306, 0x0000d200308, 0x0000d200309, 0x0000d20030c,
+	0x0000d20030f, 0x0000d200311, 0x0000d200323, 0x0000d200328,
+	0x0000d200330, 0x0000d400302, 0x0000d40030c, 0x0000d600301,
+	0x0000d60030c, 0x0000d600323, 0x0000d600327, 0x0000d600331,
+	0x0000d800301, 0x0000d80030c, 0x0000d800323, 0x0000d800327,
+	0x0000d80032d, 0x0000d800331, 0x0000da00301, 0x0000da00307,
+	0x0000da00323, 0x0000dc00300, 0x0000dc00301, 0x0000dc00303,
+	0x0000dc00307, 0x0000dc0030c, 0x0000dc00323, 0x0000dc00327,
+	0x0000dc0032d, 0x0000dc00331, 0x0000de00300, 0x0000de00301,
+	0x0000de00302, 0x0000de00303, 0x0000de00304, 0x0000de00306,
+	0x0000de00307, 0x0000de00308, 0x0000de00309, 0x0000de0030b,
+	0x0000de0030c, 0x0000de0030f, 0x0000de00311, 0x0000de0031b,
+	0x0000de00323, 0x0000de00328, 0x0000e000301, 0x0000e000307,
+	0x0000e400301, 0x0000e400307, 0x0000e40030c, 0x0000e40030f,
+	0x0000e400311, 0x0000e400323, 0x0000e400327, 0x0000e400331,
+	0x0000e600301, 0x0000e600302, 0x0000e600307, 0x0000e60030c,
+	0x0000e600323, 0x0000e600326, 0x0000e600327, 0x0000e800307,
+	0x0000e800308, 0x0000e80030c, 0x0000e800323, 0x0000e800326,
+	0x0000e800327, 0x0000e80032d, 0x0000e800331, 0x0000ea00300,
+	0x0000ea00301, 0x0000ea00302, 0x0000ea00303, 0x0000ea00304,
+	0x0000ea00306, 0x0000ea00308, 0x0000ea00309, 0x0000ea0030a,
+	0x0000ea0030b, 0x0000ea0030c, 0x0000ea0030f, 0x0000ea00311,
+	0x0000ea0031b, 0x0000ea00323, 0x0000ea00324, 0x0000ea00328,
+	0x0000ea0032d, 0x0000ea00330, 0x0000ec00303, 0x0000ec00323,
+	0x0000ee00300, 0x0000ee00301, 0x0000ee00302, 0x0000ee00307,
+	0x0000ee00308, 0x0000ee0030a, 0x0000ee00323, 0x0000f000307,
+	0x0000f000308, 0x0000f200300, 0x0000f200301, 0x0000f200302,
+	0x0000f200303, 0x0000f200304, 0x0000f200307, 0x0000f200308,
+	0x0000f200309, 0x0000f20030a, 0x0000f200323, 0x0000f400301,
+	0x0000f400302, 0x0000f400307, 0x0000f40030c, 0x0000f400323,
+	0x0000f400331, 0x00015000300, 0x00015000301, 0x00015000342,
+	0x00018400300, 0x00018400301, 0x00018400303, 0x00018400309,
+	0x00018800304, 0x00018a00301, 0x00018c00301, 0x00018c00304,
+	0x00018e00301, 0x00019400300, 0x00019400301, 0x00019400303,
+	0x00019400309, 0x00019e00301, 0x0001a800300, 0x0001a800301,
+	0x0001a800303, 0x0001a800309, 0x0001aa00301, 0x0001aa00304,
+	0x0001aa00308, 0x0001ac00304, 0x0001b000301, 0x0001b800300,
+	0x0001b800301, 0x0001b800304, 0x0001b80030c, 0x0001c400300,
+	0x0001c400301, 0x0001c400303, 0x0001c400309, 0x0001c800304,
+	0x0001ca00301, 0x0001cc00301, 0x0001cc00304, 0x0001ce00301,
+	0x0001d400300, 0x0001d400301, 0x0001d400303, 0x0001d400309,
+	0x0001de00301, 0x0001e800300, 0x0001e800301, 0x0001e800303,
+	0x0001e800309, 0x0001ea00301, 0x0001ea00304, 0x0001ea00308,
+	0x0001ec00304, 0x0001f000301, 0x0001f800300, 0x0001f800301,
+	0x0001f800304, 0x0001f80030c, 0x00020400300, 0x00020400301,
+	0x00020400303, 0x00020400309, 0x00020600300, 0x00020600301,
+	0x00020600303, 0x00020600309, 0x00022400300, 0x00022400301,
+	0x00022600300, 0x00022600301, 0x00029800300, 0x00029800301,
+	0x00029a00300, 0x00029a00301, 0x0002b400307, 0x0002b600307,
+	0x0002c000307, 0x0002c200307, 0x0002d000301, 0x0002d200301,
+	0x0002d400308, 0x0002d600308, 0x0002fe00307, 0x00034000300,
+	0x00034000301, 0x00034000303, 0x00034000309, 0x00034000323,
+	0x00034200300, 0x00034200301, 0x00034200303, 0x00034200309,
+	0x00034200323, 0x00035e00300, 0x00035e00301, 0x00035e00303,
+	0x00035e00309, 0x00035e00323, 0x00036000300, 0x00036000301,
+	0x00036000303, 0x00036000309, 0x00036000323, 0x00036e0030c,
+	0x0003d400304, 0x0003d600304, 0x00044c00304, 0x00044e00304,
+	0x00045000306, 0x00045200306, 0x00045c00304, 0x00045e00304,
+	0x0005240030c, 0x00072200300, 0x00072200301, 0x00072200304,
+	0x00072200306, 0x00072200313, 0x00072200314, 0x00072200345,
+	0x00072a00300, 0x00072a00301, 0x00072a00313, 0x00072a00314,
+	0x00072e00300, 0x00072e00301, 0x00072e00313, 0x00072e00314,
+	0x00072e00345, 0x00073200300, 0x00073200301, 0x00073200304,
+	0x00073200306, 0x00073200308, 0x00073200313, 0x00073200314,
+	0x00073e00300, 0x00073e00301, 0x00073e00313, 0x00073e00314,
+	0x00074200314, 0x00074a00300, 0x00074a00301, 0x00074a00304,
+	0x00074a00306, 0x00074a00308, 0x00074a00314, 0x00075200300,
+	0x00075200301, 0x00075200313, 0x00075200314, 0x00075200345,
+	0x00075800345, 0x00075c00345, 0x00076200300, 0x00076200301,
+	0x00076200304, 0x00076200306, 0x00076200313, 0x00076200314,
+	0x00076200342, 0x00076200345, 0x00076a00300, 0x00076a00301,
+	0x00076a00313, 0x00076a00314, 0x00076e00300, 0x00076e00301,
+	0x00076e00313, 0x00076e00314, 0x00076e00342, 0x00076e00345,
+	0x00077200300, 0x00077200301, 0x00077200304, 0x00077200306,
+	0x00077200308, 0x00077200313, 0x00077200314, 0x00077200342,
+	0x00077e00300, 0x00077e00301, 0x00077e00313, 0x00077e00314,
+	0x00078200313, 0x00078200314, 0x00078a00300, 0x00078a00301,
+	0x00078a00304, 0x00078a00306, 0x00078a00308, 0x00078a00313,
+	0x00078a00314, 0x00078a00342, 0x00079200300, 0x00079200301,
+	0x00079200313, 0x00079200314, 0x00079200342, 0x00079200345,
+	0x00079400300, 0x00079400301, 0x00079400342, 0x00079600300,
+	0x00079600301, 0x00079600342, 0x00079c00345, 0x0007a400301,
+	0x0007a400308, 0x00080c00308, 0x00082000306, 0x00082000308,
+	0x00082600301, 0x00082a00300, 0x00082a00306, 0x00082a00308,
+	0x00082c00306, 0x00082c00308, 0x00082e00308, 0x00083000300,
+	0x00083000304, 0x00083000306, 0x00083000308, 0x00083400301,
+	0x00083c00308, 0x00084600304, 0x00084600306, 0x00084600308,
+	0x0008460030b, 0x00084e00308, 0x00085600308, 0x00085a00308,
+	0x00086000306, 0x00086000308, 0x00086600301, 0x00086a00300,
+	0x00086a00306, 0x00086a00308, 0x00086c00306, 0x00086c00308,
+	0x00086e00308, 0x00087000300, 0x00087000304, 0x00087000306,
+	0x00087000308, 0x00087400301, 0x00087c00308, 0x00088600304,
+	0x00088600306, 0x00088600308, 0x0008860030b, 0x00088e00308,
+	0x00089600308, 0x00089a00308, 0x0008ac00308, 0x0008e80030f,
+	0x0008ea0030f, 0x0009b000308, 0x0009b200308, 0x0009d000308,
+	0x0009d200308, 0x000c4e00653, 0x000c4e00654, 0x000c4e00655,
+	0x000c9000654, 0x000c9400654, 0x000d8200654, 0x000da400654,
+	0x000daa00654, 0x0012500093c, 0x0012600093c, 0x0012660093c,
+	0x00138e009be, 0x00138e009d7, 0x00168e00b3e, 0x00168e00b56,
+	0x00168e00b57, 0x00172400bd7, 0x00178c00bbe, 0x00178c00bd7,
+	0x00178e00bbe, 0x00188c00c56, 0x00197e00cd5, 0x00198c00cc2,
+	0x00198c00cd5, 0x00198c00cd6, 0x00199400cd5, 0x001a8c00d3e,
+	0x001a8c00d57, 0x001a8e00d3e, 0x001bb200dca, 0x001bb200dcf,
+	0x001bb200ddf, 0x001bb800dca, 0x00204a0102e, 0x00360a01b35,
+	0x00360e01b35, 0x00361201b35, 0x00361601b35, 0x00361a01b35,
+	0x00362201b35, 0x00367401b35, 0x00367801b35, 0x00367c01b35,
+	0x00367e01b35, 0x00368401b35, 0x003c6c00304, 0x003c6e00304,
+	0x003cb400304, 0x003cb600304, 0x003cc400307, 0x003cc600307,
+	0x003d4000302, 0x003d4000306, 0x003d4200302, 0x003d4200306,
+	0x003d7000302, 0x003d7200302, 0x003d9800302, 0x003d9a00302,
+	0x003e0000300, 0x003e0000301, 0x003e0000342, 0x003e0000345,
+	0x003e0200300, 0x003e0200301, 0x003e0200342, 0x003e0200345,
+	0x003e0400345, 0x003e0600345, 0x003e0800345, 0x003e0a00345,
+	0x003e0c00345, 0x003e0e00345, 0x003e1000300, 0x003e1000301,
+	0x003e1000342, 0x003e1000345, 0x003e1200300, 0x003e1200301,
+	0x003e1200342, 0x003e1200345, 0x003e1400345, 0x003e1600345,
+	0x003e1800345, 0x003e1a00345, 0x003e1c00345, 0x003e1e00345,
+	0x003e2000300, 0x003e2000301, 0x003e2200300, 0x003e2200301,
+	0x003e3000300, 0x003e3000301, 0x003e3200300, 0x003e3200301,
+	0x003e4000300, 0x003e4000301, 0x003e4000342, 0x003e4000345,
+	0x003e4200300, 0x003e4200301, 0x003e4200342, 0x003e4200345,
+	0x003e4400345, 0x003e4600345, 0x003e4800345, 0x003e4a00345,
+	0x003e4c00345, 0x003e4e00345, 0x003e5000300, 0x003e5000301,
+	0x003e5000342, 0x003e5000345, 0x003e5200300, 0x003e5200301,
+	0x003e5200342, 0x003e5200345, 0x003e5400345, 0x003e5600345,
+	0x003e5800345, 0x003e5a00345, 0x003e5c00345, 0x003e5e00345,
+	0x003e6000300, 0x003e6000301, 0x003e6000342, 0x003e6200300,
+	0x003e6200301, 0x003e6200342, 0x003e7000300, 0x003e7000301,
+	0x003e7000342, 0x003e7200300, 0x003e7200301, 0x003e7200342,
+	0x003e8000300, 0x003e8000301, 0x003e8200300, 0x003e8200301,
+	0x003e9000300, 0x003e9000301, 0x003e9200300, 0x003e9200301,
+	0x003ea000300, 0x003ea000301, 0x003ea000342, 0x003ea200300,
+	0x003ea200301, 0x003ea200342, 0x003eb200300, 0x003eb200301,
+	0x003eb200342, 0x003ec000300, 0x003ec000301, 0x003ec000342,
+	0x003ec000345, 0x003ec200300, 0x003ec200301, 0x003ec200342,
+	0x003ec200345, 0x003ec400345, 0x003ec600345, 0x003ec800345,
+	0x003eca00345, 0x003ecc00345, 0x003ece00345, 0x003ed000300,
+	0x003ed000301, 0x003ed000342, 0x003ed000345, 0x003ed200300,
+	0x003ed200301, 0x003ed200342, 0x003ed200345, 0x003ed400345,
+	0x003ed600345, 0x003ed800345, 0x003eda00345, 0x003edc00345,
+	0x003ede00345, 0x003ee000345, 0x003ee800345, 0x003ef800345,
+	0x003f6c00345, 0x003f7e00300, 0x003f7e00301, 0x003f7e00342,
+	0x003f8c00345, 0x003fec00345, 0x003ffc00300, 0x003ffc00301,
+	0x003ffc00342, 0x00432000338, 0x00432400338, 0x00432800338,
+	0x0043a000338, 0x0043a400338, 0x0043a800338, 0x00440600338,
+	0x00441000338, 0x00441600338, 0x00444600338, 0x00444a00338,
+	0x00447800338, 0x00448600338, 0x00448a00338, 0x00449000338,
+	0x00449a00338, 0x0044c200338, 0x0044c800338, 0x0044ca00338,
+	0x0044e400338, 0x0044e600338, 0x0044ec00338, 0x0044ee00338,
+	0x0044f400338, 0x0044f600338, 0x0044f800338, 0x0044fa00338,
+	0x00450400338, 0x00450600338, 0x00450c00338, 0x00450e00338,
+	0x00452200338, 0x00452400338, 0x00454400338, 0x00455000338,
+	0x00455200338, 0x00455600338, 0x00456400338, 0x00456600338,
+	0x00456800338, 0x00456a00338, 0x00608c03099, 0x00609603099,
+	0x00609a03099, 0x00609e03099, 0x0060a203099, 0x0060a603099,
+	0x0060aa03099, 0x0060ae03099, 0x0060b203099, 0x0060b603099,
+	0x0060ba03099, 0x0060be03099, 0x0060c203099, 0x0060c803099,
+	0x0060cc03099, 0x0060d003099, 0x0060de03099, 0x0060de0309a,
+	0x0060e403099, 0x0060e40309a, 0x0060ea03099, 0x0060ea0309a,
+	0x0060f003099, 0x0060f00309a, 0x0060f603099, 0x0060f60309a,
+	0x00613a03099, 0x00614c03099, 0x00615603099, 0x00615a03099,
+	0x00615e03099, 0x00616203099, 0x00616603099, 0x00616a03099,
+	0x00616e03099, 0x00617203099, 0x00617603099, 0x00617a03099,
+	0x00617e03099, 0x00618203099, 0x00618803099, 0x00618c03099,
+	0x00619003099, 0x00619e03099, 0x00619e0309a, 0x0061a403099,
+	0x0061a40309a, 0x0061aa03099, 0x0061aa0309a, 0x0061b003099,
+	0x0061b00309a, 0x0061b603099, 0x0061b60309a, 0x0061de03099,
+	0x0061e003099, 0x0061e203099, 0x0061e403099, 0x0061fa03099,
+	0x020ba400307, 0x020bb400307, 0x022132110ba, 0x022136110ba,
+	0x02214a110ba, 0x02226211127, 0x02226411127, 0x02268e1133e,
+	0x02268e11357, 0x022704113c9, 0x022708113bb, 0x022716113c2,
+	0x022720113c9, 0x022784113b8, 0x022784113c2, 0x022784113c9,
+	0x022972114b0, 0x022972114ba, 0x022972114bd, 0x022b70115af,
+	0x022b72115af, 0x02326a11930, 0x02c23c1611e, 0x02c23c1611f,
+	0x02c23c16120, 0x02c23c16129, 0x02c2421611f, 0x02c24216120,
+	0x02c2441611f, 0x02c2521611f, 0x02dac616d67, 0x02dace16d67,
+	0x02dad216d67,
+}
+
+var compositionRunes = [...]rune{
+	0x0226e, 0x02260, 0x0226f, 0x000c0, 0x000c1, 0x000c2, 0x000c3, 0x00100,
+	0x00102, 0x00226, 0x000c4, 0x01ea2, 0x000c5, 0x001cd, 0x00200, 0x00202,
+	0x01ea0, 0x01e00, 0x00104, 0x01e02, 0x01e04, 0x01e06, 0x00106, 0x00108,
+	0x0010a, 0x0010c, 0x000c7, 0x01e0a, 0x0010e, 0x01e0c, 0x01e10, 0x01e12,
+	0x01e0e, 0x000c8, 0x000c9, 0x000ca, 0x01ebc, 0x00112, 0x00114, 0x00116,
+	0x000cb, 0x01eba, 0x0011a, 0x00204, 0x00206, 0x01eb8, 0x00228, 0x00118,
+	0x01e18, 0x01e1a, 0x01e1e, 0x001f4, 0x0011c, 0x01e20, 0x0011e, 0x00120,
+	0x001e6, 0x00122, 0x00124, 0x01e22, 0x01e26, 0x0021e, 0x01e24, 0x01e28,
+	0x01e2a, 0x000cc, 0x000cd, 0x000ce, 0x00128, 0x0012a, 0x0012c, 0x00130,
+	0x000cf, 0x01ec8, 0x001cf, 0x00208, 0x0020a, 0x01eca, 0x0012e, 0x01e2c,
+	0x00134, 0x01e30, 0x001e8, 0x01e32, 0x00136, 0x01e34, 0x00139, 0x0013d,
+	0x01e36, 0x0013b, 0x01e3c, 0x01e3a, 0x01e3e, 0x01e40, 0x01e42, 0x001f8,
+	0x00143, 0x000d1, 0x01e44, 0x00147, 0x01e46, 0x00145, 0x01e4a, 0x01e48,
+	0x000d2, 0x000d3, 0x000d4, 0x000d5, 0x0014c, 0x0014e, 0x0022e, 0x000d6,
+	0x01ece, 0x00150, 0x001d1, 0x0020c, 0x0020e, 0x001a0, 0x01ecc, 0x001ea,
+	0x01e54, 0x01e56, 0x00154, 0x01e58, 0x00158, 0x00210, 0x00212, 0x01e5a,
+	0x00156, 0x01e5e, 0x0015a, 0x0015c, 0x01e60, 0x00160, 0x01e62, 0x00218,
+	0x0015e, 0x01e6a, 0x00164, 0x01e6c, 0x0021a, 0x00162, 0x01e70, 0x01e6e,
+	0x000d9, 0x000da, 0x000db, 0x00168, 0x0016a, 0x0016c, 0x000dc, 0x01ee6,
+	0x0016e, 0x00170, 0x001d3, 0x00214, 0x00216, 0x001af, 0x01ee4, 0x01e72,
+	0x00172, 0x01e76, 0x01e74, 0x01e7c, 0x01e7e, 0x01e80, 0x01e82, 0x00174,
+	0x01e86, 0x01e84, 0x01e88, 0x01e8a, 0x01e8c, 0x01ef2, 0x000dd, 0x00176,
+	0x01ef8, 0x00232, 0x01e8e, 0x00178, 0x01ef6, 0x01ef4, 0x00179, 0x01e90,
+	0x0017b, 0x0017d, 0x01e92, 0x01e94, 0x000e0, 0x000e1, 0x000e2, 0x000e3,
+	0x00101, 0x00103, 0x00227, 0x000e4, 0x01ea3, 0x000e5, 0x001ce, 0x00201,
+	0x00203, 0x01ea1, 0x01e01, 0x00105, 0x01e03, 0x01e05, 0x01e07, 0x00107,
+	0x00109, 0x0010b, 0x0010d, 0x000e7, 0x01e0b, 0x0010f, 0x01e0d, 0x01e11,
+	0x01e13, 0x01e0f, 0x000e8, 0x000e9, 0x000ea, 0x01ebd, 0x00113, 0x00115,
+	0x00117, 0x000eb, 0x01ebb, 0x0011b, 0x00205, 0x00207, 0x01eb9, 0x00229,
+	0x00119, 0x01e19, 0x01e1b, 0x01e1f, 0x001f5, 0x0011d, 0x01e21, 0x0011f,
+	0x00121, 0x001e7, 0x00123, 0x00125, 0x01e23, 0x01e27, 0x0021f, 0x01e25,
+	0x01e29, 0x01e2b, 0x01e96, 0x000ec, 0x000ed, 0x000ee, 0x00129, 0x0012b,
+	0x0012d, 0x000ef, 0x01ec9, 0x001d0, 0x00209, 0x0020b, 0x01ecb, 0x0012f,
+	0x01e2d, 0x00135, 0x001f0, 0x01e31, 0x001e9, 0x01e33, 0x00137, 0x01e35,
+	0x0013a, 0x0013e, 0x01e37, 0x0013c, 0x01e3d, 0x01e3b, 0x01e3f, 0x01e41,
+	0x01e43, 0x001f9, 0x00144, 0x000f1, 0x01e45, 0x00148, 0x01e47, 0x00146,
+	0x01e4b, 0x01e49, 0x000f2, 0x000f3, 0x000f4, 0x000f5, 0x0014d, 0x0014f,
+	0x0022f, 0x000f6, 0x01ecf, 0x00151, 0x001d2, 0x0020d, 0x0020f, 0x001a1,
+	0x01ecd, 0x001eb, 0x01e55, 0x01e57, 0x00155, 0x01e59, 0x00159, 0x00211,
+	0x00213, 0x01e5b, 0x00157, 0x01e5f, 0x0015b, 0x0015d, 0x01e61, 0x00161,
+	0x01e63, 0x00219, 0x0015f, 0x01e6b, 0x01e97, 0x00165, 0x01e6d, 0x0021b,
+	0x00163, 0x01e71, 0x01e6f, 0x000f9, 0x000fa, 0x000fb, 0x00169, 0x0016b,
+	0x0016d, 0x000fc, 0x01ee7, 0x0016f, 0x00171, 0x001d4, 0x00215, 0x00217,
+	0x001b0, 0x01ee5, 0x01e73, 0x00173, 0x01e77, 0x01e75, 0x01e7d, 0x01e7f,
+	0x01e81, 0x01e83, 0x00175, 0x01e87, 0x01e85, 0x01e98, 0x01e89, 0x01e8b,
+	0x01e8d, 0x01ef3, 0x000fd, 0x00177, 0x01ef9, 0x00233, 0x01e8f, 0x000ff,
+	0x01ef7, 0x01e99, 0x01ef5, 0x0017a, 0x01e91, 0x0017c, 0x0017e, 0x01e93,
+	0x01e95, 0x01fed, 0x00385, 0x01fc1, 0x01ea6, 0x01ea4, 0x01eaa, 0x01ea8,
+	0x001de, 0x001fa, 0x001fc, 0x001e2, 0x01e08, 0x01ec0, 0x01ebe, 0x01ec4,
+	0x01ec2, 0x01e2e, 0x01ed2, 0x01ed0, 0x01ed6, 0x01ed4, 0x01e4c, 0x0022c,
+	0x01e4e, 0x0022a, 0x001fe, 0x001db, 0x001d7, 0x001d5, 0x001d9, 0x01ea7,
+	0x01ea5, 0x01eab, 0x01ea9, 0x001df, 0x001fb, 0x001fd, 0x001e3, 0x01e09,
+	0x01ec1, 0x01ebf, 0x01ec5, 0x01ec3, 0x01e2f, 0x01ed3, 0x01ed1, 0x01ed7,
+	0x01ed5, 0x01e4d, 0x0022d, 0x01e4f, 0x0022b, 0x001ff, 0x001dc, 0x001d8,
+	0x001d6, 0x001da, 0x01eb0, 0x01eae, 0x01eb4, 0x01eb2, 0x01eb1, 0x01eaf,
+	0x01eb5, 0x01eb3, 0x01e14, 0x01e16, 0x01e15, 0x01e17, 0x01e50, 0x01e52,
+	0x01e51, 0x01e53, 0x01e64, 0x01e65, 0x01e66, 0x01e67, 0x01e78, 0x01e79,
+	0x01e7a, 0x01e7b, 0x01e9b, 0x01edc, 0x01eda, 0x01ee0, 0x01ede, 0x01ee2,
+	0x01edd, 0x01edb, 0x01ee1, 0x01edf, 0x01ee3, 0x01eea, 0x01ee8, 0x01eee,
+	0x01eec, 0x01ef0, 0x01eeb, 0x01ee9, 0x01eef, 0x01eed, 0x01ef1, 0x001ee,
+	0x001ec, 0x001ed, 0x001e0, 0x001e1, 0x01e1c, 0x01e1d, 0x00230, 0x00231,
+	0x001ef, 0x01fba, 0x00386, 0x01fb9, 0x01fb8, 0x01f08, 0x01f09, 0x01fbc,
+	0x01fc8, 0x00388, 0x01f18, 0x01f19, 0x01fca, 0x00389, 0x01f28, 0x01f29,
+	0x01fcc, 0x01fda, 0x0038a, 0x01fd9, 0x01fd8, 0x003aa, 0x01f38, 0x01f39,
+	0x01ff8, 0x0038c, 0x01f48, 0x01f49, 0x01fec, 0x01fea, 0x0038e, 0x01fe9,
+	0x01fe8, 0x003ab, 0x01f59, 0x01ffa, 0x0038f, 0x01f68, 0x01f69, 0x01ffc,
+	0x01fb4, 0x01fc4, 0x01f70, 0x003ac, 0x01fb1, 0x01fb0, 0x01f00, 0x01f01,
+	0x01fb6, 0x01fb3, 0x01f72, 0x003ad, 0x01f10, 0x01f11, 0x01f74, 0x003ae,
+	0x01f20, 0x01f21, 0x01fc6, 0x01fc3, 0x01f76, 0x003af, 0x01fd1, 0x01fd0,
+	0x003ca, 0x01f30, 0x01f31, 0x01fd6, 0x01f78, 0x003cc, 0x01f40, 0x01f41,
+	0x01fe4, 0x01fe5, 0x01f7a, 0x003cd, 0x01fe1, 0x01fe0, 0x003cb, 0x01f50,
+	0x01f51, 0x01fe6, 0x01f7c, 0x003ce, 0x01f60, 0x01f61, 0x01ff6, 0x01ff3,
+	0x01fd2, 0x00390, 0x01fd7, 0x01fe2, 0x003b0, 0x01fe7, 0x01ff4, 0x003d3,
+	0x003d4, 0x00407, 0x004d0, 0x004d2, 0x00403, 0x00400, 0x004d6, 0x00401,
+	0x004c1, 0x004dc, 0x004de, 0x0040d, 0x004e2, 0x00419, 0x004e4, 0x0040c,
+	0x004e6, 0x004ee, 0x0040e, 0x004f0, 0x004f2, 0x004f4, 0x004f8, 0x004ec,
+	0x004d1, 0x004d3, 0x00453, 0x00450, 0x004d7, 0x00451, 0x004c2, 0x004dd,
+	0x004df, 0x0045d, 0x004e3, 0x00439, 0x004e5, 0x0045c, 0x004e7, 0x004ef,
+	0x0045e, 0x004f1, 0x004f3, 0x004f5, 0x004f9, 0x004ed, 0x00457, 0x00476,
+	0x00477, 0x004da, 0x004db, 0x004ea, 0x004eb, 0x00622, 0x00623, 0x00625,
+	0x00624, 0x00626, 0x006c2, 0x006d3, 0x006c0, 0x00929, 0x00931, 0x00934,
+	0x009cb, 0x009cc, 0x00b4b, 0x00b48, 0x00b4c, 0x00b94, 0x00bca, 0x00bcc,
+	0x00bcb, 0x00c48, 0x00cc0, 0x00cca, 0x00cc7, 0x00cc8, 0x00ccb, 0x00d4a,
+	0x00d4c, 0x00d4b, 0x00dda, 0x00ddc, 0x00dde, 0x00ddd, 0x01026, 0x01b06,
+	0x01b08, 0x01b0a, 0x01b0c, 0x01b0e, 0x01b12, 0x01b3b, 0x01b3d, 0x01b40,
+	0x01b41, 0x01b43, 0x01e38, 0x01e39, 0x01e5c, 0x01e5d, 0x01e68, 0x01e69,
+	0x01eac, 0x01eb6, 0x01ead, 0x01eb7, 0x01ec6, 0x01ec7, 0x01ed8, 0x01ed9,
+	0x01f02, 0x01f04, 0x01f06, 0x01f80, 0x01f03, 0x01f05, 0x01f07, 0x01f81,
+	0x01f82, 0x01f83, 0x01f84, 0x01f85, 0x01f86, 0x01f87, 0x01f0a, 0x01f0c,
+	0x01f0e, 0x01f88, 0x01f0b, 0x01f0d, 0x01f0f, 0x01f89, 0x01f8a, 0x01f8b,
+	0x01f8c, 0x01f8d, 0x01f8e, 0x01f8f, 0x01f12, 0x01f14, 0x01f13, 0x01f15,
+	0x01f1a, 0x01f1c, 0x01f1b, 0x01f1d, 0x01f22, 0x01f24, 0x01f26, 0x01f90,
+	0x01f23, 0x01f25, 0x01f27, 0x01f91, 0x01f92, 0x01f93, 0x01f94, 0x01f95,
+	0x01f96, 0x01f97, 0x01f2a, 0x01f2c, 0x01f2e, 0x01f98, 0x01f2b, 0x01f2d,
+	0x01f2f, 0x01f99, 0x01f9a, 0x01f9b, 0x01f9c, 0x01f9d, 0x01f9e, 0x01f9f,
+	0x01f32, 0x01f34, 0x01f36, 0x01f33, 0x01f35, 0x01f37, 0x01f3a, 0x01f3c,
+	0x01f3e, 0x01f3b, 0x01f3d, 0x01f3f, 0x01f42, 0x01f44, 0x01f43, 0x01f45,
+	0x01f4a, 0x01f4c, 0x01f4b, 0x01f4d, 0x01f52, 0x01f54, 0x01f56, 0x01f53,
+	0x01f55, 0x01f57, 0x01f5b, 0x01f5d, 0x01f5f, 0x01f62, 0x01f64, 0x01f66,
+	0x01fa0, 0x01f63, 0x01f65, 0x01f67, 0x01fa1, 0x01fa2, 0x01fa3, 0x01fa4,
+	0x01fa5, 0x01fa6, 0x01fa7, 0x01f6a, 0x01f6c, 0x01f6e, 0x01fa8, 0x01f6b,
+	0x01f6d, 0x01f6f, 0x01fa9, 0x01faa, 0x01fab, 0x01fac, 0x01fad, 0x01fae,
+	0x01faf, 0x01fb2, 0x01fc2, 0x01ff2, 0x01fb7, 0x01fcd, 0x01fce, 0x01fcf,
+	0x01fc7, 0x01ff7, 0x01fdd, 0x01fde, 0x01fdf, 0x0219a, 0x0219b, 0x021ae,
+	0x021cd, 0x021cf, 0x021ce, 0x02204, 0x02209, 0x0220c, 0x02224, 0x02226,
+	0x02241, 0x02244, 0x02247, 0x02249, 0x0226d, 0x02262, 0x02270, 0x02271,
+	0x02274, 0x02275, 0x02278, 0x02279, 0x02280, 0x02281, 0x022e0, 0x022e1,
+	0x02284, 0x02285, 0x02288, 0x02289, 0x022e2, 0x022e3, 0x022ac, 0x022ad,
+	0x022ae, 0x022af, 0x022ea, 0x022eb, 0x022ec, 0x022ed, 0x03094, 0x0304c,
+	0x0304e, 0x03050, 0x03052, 0x03054, 0x03056, 0x03058, 0x0305a, 0x0305c,
+	0x0305e, 0x03060, 0x03062, 0x03065, 0x03067, 0x03069, 0x03070, 0x03071,
+	0x03073, 0x03074, 0x03076, 0x03077, 0x03079, 0x0307a, 0x0307c, 0x0307d,
+	0x0309e, 0x030f4, 0x030ac, 0x030ae, 0x030b0, 0x030b2, 0x030b4, 0x030b6,
+	0x030b8, 0x030ba, 0x030bc, 0x030be, 0x030c0, 0x030c2, 0x030c5, 0x030c7,
+	0x030c9, 0x030d0, 0x030d1, 0x030d3, 0x030d4, 0x030d6, 0x030d7, 0x030d9,
+	0x030da, 0x030dc, 0x030dd, 0x030f7, 0x030f8, 0x030f9, 0x030fa, 0x030fe,
+	0x105c9, 0x105e4, 0x1109a, 0x1109c, 0x110ab, 0x1112e, 0x1112f, 0x1134b,
+	0x1134c, 0x11383, 0x11385, 0x1138e, 0x11391, 0x113c7, 0x113c5, 0x113c8,
+	0x114bc, 0x114bb, 0x114be, 0x115ba, 0x115bb, 0x11938, 0x16121, 0x16123,
+	0x16125, 0x16122, 0x16126, 0x16128, 0x16127, 0x16124, 0x16d69, 0x16d68,
+	0x16d6a,
+}
+
+var bidiRunStarts = [...]rune{
+	0x00000, 0x00041, 0x0005b, 0x00061, 0x0007b, 0x000aa, 0x000ab, 0x000b5,
+	0x000b6, 0x000ba, 0x000bb, 0x000c0, 0x000d7, 0x000d8, 0x000f7, 0x000f8,
+	0x002b9, 0x002bb, 0x002c2, 0x002d0, 0x002d2, 0x002e0, 0x002e5, 0x002ee,
+	0x002ef, 0x00370, 0x00374, 0x00376, 0x0037e, 0x0037f, 0x00384, 0x00386,
+	0x00387, 0x00388, 0x003f6, 0x003f7, 0x00483, 0x0048a, 0x0058a, 0x0058b,
+	0x0058d, 0x00590, 0x00591, 0x005be, 0x005bf, 0x005c0, 0x005c1, 0x005c3,
+	0x005c4, 0x005c6, 0x005c7, 0x005c8, 0x00600, 0x00608, 0x00609, 0x0060b,
+	0x0060c, 0x0060d, 0x0060e, 0x0061b, 0x0064b, 0x0066d, 0x00670, 0x00671,
+	0x006d6, 0x006e5, 0x006e7, 0x006ee, 0x006f0, 0x006fa, 0x00711, 0x00712,
+	0x00730, 0x0074b, 0x007a6, 0x007b1, 0x007eb, 0x007f4, 0x007f6, 0x007fa,
+	0x007fd, 0x007fe, 0x00816, 0x0081a, 0x0081b, 0x00824, 0x00825, 0x00828,
+	0x00829, 0x0082e, 0x00859, 0x0085c, 0x00890, 0x00892, 0x00897, 0x008a0,
+	0x008ca, 0x00903, 0x0093a, 0x0093b, 0x0093c, 0x0093d, 0x00941, 0x00949,
+	0x0094d, 0x0094e, 0x00951, 0x00958, 0x00962, 0x00964, 0x00981, 0x00982,
+	0x009bc, 0x009bd, 0x009c1, 0x009c5, 0x009cd, 0x009ce, 0x009e2, 0x009e4,
+	0x009f2, 0x009f4, 0x009fb, 0x009fc, 0x009fe, 0x009ff, 0x00a01, 0x00a03,
+	0x00a3c, 0x00a3d, 0x00a41, 0x00a43, 0x00a47, 0x00a49, 0x00a4b, 0x00a4e,
+	0x00a51, 0x00a52, 0x00a70, 0x00a72, 0x00a75, 0x00a76, 0x00a81, 0x00a83,
+	0x00abc, 0x00abd, 0x00ac1, 0x00ac6, 0x00ac7, 0x00ac9, 0x00acd, 0x00ace,
+	0x00ae2, 0x00ae4, 0x00af1, 0x00af2, 0x00afa, 0x00b00, 0x00b01, 0x00b02,
+	0x00b3c, 0x00b3d, 0x00b3f, 0x00b40, 0x00b41, 0x00b45, 0x00b4d, 0x00b4e,
+	0x00b55, 0x00b57, 0x00b62, 0x00b64, 0x00b82, 0x00b83, 0x00bc0, 0x00bc1,
+	0x00bcd, 0x00bce, 0x00bf3, 0x00bfb, 0x00c00, 0x00c01, 0x00c04, 0x00c05,
+	0x00c3c, 0x00c3d, 0x00c3e, 0x00c41, 0x00c46, 0x00c49, 0x00c4a, 0x00c4e,
+	0x00c55, 0x00c57, 0x00c62, 0x00c64, 0x00c78, 0x00c7f, 0x00c81, 0x00c82,
+	0x00cbc, 0x00cbd, 0x00ccc, 0x00cce, 0x00ce2, 0x00ce4, 0x00d00, 0x00d02,
+	0x00d3b, 0x00d3d, 0x00d41, 0x00d45, 0x00d4d, 0x00d4e, 0x00d62, 0x00d64,
+	0x00d81, 0x00d82, 0x00dca, 0x00dcb, 0x00dd2, 0x00dd5, 0x00dd6, 0x00dd7,
+	0x00e31, 0x00e32, 0x00e34, 0x00e3b, 0x00e3f, 0x00e40, 0x00e47, 0x00e4f,
+	0x00eb1, 0x00eb2, 0x00eb4, 0x00ebd, 0x00ec8, 0x00ecf, 0x00f18, 0x00f1a,
+	0x00f35, 0x00f36, 0x00f37, 0x00f38, 0x00f39, 0x00f3e, 0x00f71, 0x00f7f,
+	0x00f80, 0x00f85, 0x00f86, 0x00f88, 0x00f8d, 0x00f98, 0x00f99, 0x00fbd,
+	0x00fc6, 0x00fc7, 0x0102d, 0x01031, 0x01032, 0x01038, 0x01039, 0x0103b,
+	0x0103d, 0x0103f, 0x01058, 0x0105a, 0x0105e, 0x01061, 0x01071, 0x01075,
+	0x01082, 0x01083, 0x01085, 0x01087, 0x0108d, 0x0108e, 0x0109d, 0x0109e,
+	0x0135d, 0x01360, 0x01390, 0x0139a, 0x01400, 0x01401, 0x01680, 0x01681,
+	0x0169b, 0x0169d, 0x01712, 0x01715, 0x01732, 0x01734, 0x01752, 0x01754,
+	0x01772, 0x01774, 0x017b4, 0x017b6, 0x017b7, 0x017be, 0x017c6, 0x017c7,
+	0x017c9, 0x017d4, 0x017db, 0x017dc, 0x017dd, 0x017de, 0x017f0, 0x017fa,
+	0x01800, 0x01810, 0x01885, 0x01887, 0x018a9, 0x018aa, 0x01920, 0x01923,
+	0x01927, 0x01929, 0x01932, 0x01933, 0x01939, 0x0193c, 0x01940, 0x01941,
+	0x01944, 0x01946, 0x019de, 0x01a00, 0x01a17, 0x01a19, 0x01a1b, 0x01a1c,
+	0x01a56, 0x01a57, 0x01a58, 0x01a5f, 0x01a60, 0x01a61, 0x01a62, 0x01a63,
+	0x01a65, 0x01a6d, 0x01a73, 0x01a7d, 0x01a7f, 0x01a80, 0x01ab0, 0x01ade,
+	0x01ae0, 0x01aec, 0x01b00, 0x01b04, 0x01b34, 0x01b35, 0x01b36, 0x01b3b,
+	0x01b3c, 0x01b3d, 0x01b42, 0x01b43, 0x01b6b, 0x01b74, 0x01b80, 0x01b82,
+	0x01ba2, 0x01ba6, 0x01ba8, 0x01baa, 0x01bab, 0x01bae, 0x01be6, 0x01be7,
+	0x01be8, 0x01bea, 0x01bed, 0x01bee, 0x01bef, 0x01bf2, 0x01c2c, 0x01c34,
+	0x01c36, 0x01c38, 0x01cd0, 0x01cd3, 0x01cd4, 0x01ce1, 0x01ce2, 0x01ce9,
+	0x01ced, 0x01cee, 0x01cf4, 0x01cf5, 0x01cf8, 0x01cfa, 0x01dc0, 0x01e00,
+	0x01fbd, 0x01fbe, 0x01fbf, 0x01fc2, 0x01fcd, 0x01fd0, 0x01fdd, 0x01fe0,
+	0x01fed, 0x01ff0, 0x01ffd, 0x01fff, 0x02000, 0x0200e, 0x0200f, 0x02010,
+	0x02071, 0x02074, 0x0207f, 0x02080, 0x0208f, 0x020a0, 0x020f1, 0x02100,
+	0x02102, 0x02103, 0x02107, 0x02108, 0x0210a, 0x02114, 0x02115, 0x02116,
+	0x02119, 0x0211e, 0x02124, 0x02125, 0x02126, 0x02127, 0x02128, 0x02129,
+	0x0212a, 0x0212e, 0x0212f, 0x0213a, 0x0213c, 0x02140, 0x02145, 0x0214a,
+	0x0214e, 0x02150, 0x02160, 0x02189, 0x0218c, 0x02190, 0x02336, 0x0237b,
+	0x02395, 0x02396, 0x0242a, 0x02440, 0x0244b, 0x02460, 0x0249c, 0x024ea,
+	0x026ac, 0x026ad, 0x02800, 0x02900, 0x02b74, 0x02b76, 0x02c00, 0x02ce5,
+	0x02ceb, 0x02cef, 0x02cf2, 0x02cf9, 0x02d00, 0x02d7f, 0x02d80, 0x02de0,
+	0x02e5e, 0x02e80, 0x02e9a, 0x02e9b, 0x02ef4, 0x02f00, 0x02fd6, 0x02ff0,
+	0x03005, 0x03008, 0x03021, 0x0302a, 0x0302e, 0x03030, 0x03031, 0x03036,
+	0x03038, 0x0303d, 0x03040, 0x03099, 0x0309d, 0x030a0, 0x030a1, 0x030fb,
+	0x030fc, 0x031c0, 0x031e6, 0x031ef, 0x031f0, 0x0321d, 0x0321f, 0x03250,
+	0x03260, 0x0327c, 0x0327f, 0x032b1, 0x032c0, 0x032cc, 0x032d0, 0x03377,
+	0x0337b, 0x033de, 0x033e0, 0x033ff, 0x03400, 0x04dc0, 0x04e00, 0x0a490,
+	0x0a4c7, 0x0a60d, 0x0a610, 0x0a66f, 0x0a680, 0x0a69e, 0x0a6a0, 0x0a6f0,
+	0x0a6f2, 0x0a700, 0x0a722, 0x0a788, 0x0a789, 0x0a802, 0x0a803, 0x0a806,
+	0x0a807, 0x0a80b, 0x0a80c, 0x0a825, 0x0a827, 0x0a828, 0x0a82d, 0x0a838,
+	0x0a83a, 0x0a874, 0x0a878, 0x0a8c4, 0x0a8c6, 0x0a8e0, 0x0a8f2, 0x0a8ff,
+	0x0a900, 0x0a926, 0x0a92e, 0x0a947, 0x0a952, 0x0a980, 0x0a983, 0x0a9b3,
+	0x0a9b4, 0x0a9b6, 0x0a9ba, 0x0a9bc, 0x0a9be, 0x0a9e5, 0x0a9e6, 0x0aa29,
+	0x0aa2f, 0x0aa31, 0x0aa33, 0x0aa35, 0x0aa37, 0x0aa43, 0x0aa44, 0x0aa4c,
+	0x0aa4d, 0x0aa7c, 0x0aa7d, 0x0aab0, 0x0aab1, 0x0aab2, 0x0aab5, 0x0aab7,
+	0x0aab9, 0x0aabe, 0x0aac0, 0x0aac1, 0x0aac2, 0x0aaec, 0x0aaee, 0x0aaf6,
+	0x0aaf7, 0x0ab6a, 0x0ab6c, 0x0abe5, 0x0abe6, 0x0abe8, 0x0abe9, 0x0abed,
+	0x0abee, 0x0d800, 0x0e000, 0x0fb1d, 0x0fb1e, 0x0fb1f, 0x0fb29, 0x0fb2a,
+	0x0fbc3, 0x0fbd3, 0x0fd3e, 0x0fd50, 0x0fd90, 0x0fd92, 0x0fdc8, 0x0fdf0,
+	0x0fdfd, 0x0fe1a, 0x0fe20, 0x0fe53, 0x0fe54, 0x0fe67, 0x0fe68, 0x0fe6c,
+	0x0fe70, 0x0feff, 0x0ff00, 0x0ff01, 0x0ff21, 0x0ff3b, 0x0ff41, 0x0ff5b,
+	0x0ff66, 0x0ffe0, 0x0ffe7, 0x0ffe8, 0x0ffef, 0x0fff0, 0x10000, 0x10101,
+	0x10102, 0x10140, 0x1018d, 0x10190, 0x1019d, 0x101a0, 0x101a1, 0x101fd,
+	0x101fe, 0x102e0, 0x102fc, 0x10376, 0x1037b, 0x10800, 0x1091f, 0x10920,
+	0x10a01, 0x10a04, 0x10a05, 0x10a07, 0x10a0c, 0x10a10, 0x10a38, 0x10a3b,
+	0x10a3f, 0x10a40, 0x10ae5, 0x10ae7, 0x10b39, 0x10b40, 0x10d24, 0x10d28,
+	0x10d30, 0x10d3a, 0x10d40, 0x10d4a, 0x10d69, 0x10d6f, 0x10e60, 0x10e7f,
+	0x10eab, 0x10ead, 0x10ed0, 0x10ed9, 0x10efa, 0x10f00, 0x10f46, 0x10f51,
+	0x10f82, 0x10f86, 0x11000, 0x11001, 0x11002, 0x11038, 0x11047, 0x11052,
+	0x11066, 0x11070, 0x11071, 0x11073, 0x11075, 0x1107f, 0x11082, 0x110b3,
+	0x110b7, 0x110b9, 0x110bb, 0x110c2, 0x110c3, 0x11100, 0x11103, 0x11127,
+	0x1112c, 0x1112d, 0x11135, 0x11173, 0x11174, 0x11180, 0x11182, 0x111b6,
+	0x111bf, 0x111c9, 0x111cd, 0x111cf, 0x111d0, 0x1122f, 0x11232, 0x11234,
+	0x11235, 0x11236, 0x11238, 0x1123e, 0x1123f, 0x11241, 0x11242, 0x112df,
+	0x112e0, 0x112e3, 0x112eb, 0x11300, 0x11302, 0x1133b, 0x1133d, 0x11340,
+	0x11341, 0x11366, 0x1136d, 0x11370, 0x11375, 0x113bb, 0x113c1, 0x113ce,
+	0x113cf, 0x113d0, 0x113d1, 0x113d2, 0x113d3, 0x113e1, 0x113e3, 0x11438,
+	0x11440, 0x11442, 0x11445, 0x11446, 0x11447, 0x1145e, 0x1145f, 0x114b3,
+	0x114b9, 0x114ba, 0x114bb, 0x114bf, 0x114c1, 0x114c2, 0x114c4, 0x115b2,
+	0x115b6, 0x115bc, 0x115be, 0x115bf, 0x115c1, 0x115dc, 0x115de, 0x11633,
+	0x1163b, 0x1163d, 0x1163e, 0x1163f, 0x11641, 0x11660, 0x1166d, 0x116ab,
+	0x116ac, 0x116ad, 0x116ae, 0x116b0, 0x116b6, 0x116b7, 0x116b8, 0x1171d,
+	0x1171e, 0x1171f, 0x11720, 0x11722, 0x11726, 0x11727, 0x1172c, 0x1182f,
+	0x11838, 0x11839, 0x1183b, 0x1193b, 0x1193d, 0x1193e, 0x1193f, 0x11943,
+	0x11944, 0x119d4, 0x119d8, 0x119da, 0x119dc, 0x119e0, 0x119e1, 0x11a01,
+	0x11a07, 0x11a09, 0x11a0b, 0x11a33, 0x11a39, 0x11a3b, 0x11a3f, 0x11a47,
+	0x11a48, 0x11a51, 0x11a57, 0x11a59, 0x11a5c, 0x11a8a, 0x11a97, 0x11a98,
+	0x11a9a, 0x11b60, 0x11b61, 0x11b62, 0x11b65, 0x11b66, 0x11b67, 0x11c30,
+	0x11c37, 0x11c38, 0x11c3e, 0x11c92, 0x11ca8, 0x11caa, 0x11cb1, 0x11cb2,
+	0x11cb4, 0x11cb5, 0x11cb7, 0x11d31, 0x11d37, 0x11d3a, 0x11d3b, 0x11d3c,
+	0x11d3e, 0x11d3f, 0x11d46, 0x11d47, 0x11d48, 0x11d90, 0x11d92, 0x11d95,
+	0x11d96, 0x11d97, 0x11d98, 0x11ef3, 0x11ef5, 0x11f00, 0x11f02, 0x11f36,
+	0x11f3b, 0x11f40, 0x11f41, 0x11f42, 0x11f43, 0x11f5a, 0x11f5b, 0x11fd5,
+	0x11ff2, 0x13440, 0x13441, 0x13447, 0x13456, 0x1611e, 0x1612a, 0x1612d,
+	0x16130, 0x16af0, 0x16af5, 0x16b30, 0x16b37, 0x16f4f, 0x16f50, 0x16f8f,
+	0x16f93, 0x16fe2, 0x16fe3, 0x16fe4, 0x16fe5, 0x1bc9d, 0x1bc9f, 0x1bca0,
+	0x1bca4, 0x1cc00, 0x1ccd6, 0x1ccf0, 0x1ccfd, 0x1cd00, 0x1ceb4, 0x1ceba,
+	0x1ced1, 0x1cee0, 0x1cef1, 0x1cf00, 0x1cf2e, 0x1cf30, 0x1cf47, 0x1d167,
+	0x1d16a, 0x1d173, 0x1d183, 0x1d185, 0x1d18c, 0x1d1aa, 0x1d1ae, 0x1d1e9,
+	0x1d1eb, 0x1d200, 0x1d246, 0x1d300, 0x1d357, 0x1d6c1, 0x1d6c2, 0x1d6db,
+	0x1d6dc, 0x1d6fb, 0x1d6fc, 0x1d715, 0x1d716, 0x1d735, 0x1d736, 0x1d74f,
+	0x1d750, 0x1d76f, 0x1d770, 0x1d789, 0x1d78a, 0x1d7a9, 0x1d7aa, 0x1d7c3,
+	0x1d7c4, 0x1d7ce, 0x1d800, 0x1da00, 0x1da37, 0x1da3b, 0x1da6d, 0x1da75,
+	0x1da76, 0x1da84, 0x1da85, 0x1da9b, 0x1daa0, 0x1daa1, 0x1dab0, 0x1e000,
+	0x1e007, 0x1e008, 0x1e019, 0x1e01b, 0x1e022, 0x1e023, 0x1e025, 0x1e026,
+	0x1e02b, 0x1e08f, 0x1e090, 0x1e130, 0x1e137, 0x1e2ae, 0x1e2af, 0x1e2ec,
+	0x1e2f0, 0x1e2ff, 0x1e300, 0x1e4ec, 0x1e4f0, 0x1e5ee, 0x1e5f0, 0x1e6e3,
+	0x1e6e4, 0x1e6e6, 0x1e6e7, 0x1e6ee, 0x1e6f0, 0x1e6f5, 0x1e6f6, 0x1e800,
+	0x1e8d0, 0x1e8d7, 0x1e944, 0x1e94b, 0x1eef0, 0x1eef2, 0x1f000, 0x1f02c,
+	0x1f030, 0x1f094, 0x1f0a0, 0x1f0af, 0x1f0b1, 0x1f0c0, 0x1f0c1, 0x1f0d0,
+	0x1f0d1, 0x1f0f6, 0x1f100, 0x1f110, 0x1f12f, 0x1f130, 0x1f16a, 0x1f170,
+	0x1f1ad, 0x1f1ae, 0x1f260, 0x1f266, 0x1f300, 0x1f6d9, 0x1f6dc, 0x1f6ed,
+	0x1f6f0, 0x1f6fd, 0x1f700, 0x1f7da, 0x1f7e0, 0x1f7ec, 0x1f7f0, 0x1f7f1,
+	0x1f800, 0x1f80c, 0x1f810, 0x1f848, 0x1f850, 0x1f85a, 0x1f860, 0x1f888,
+	0x1f890, 0x1f8ae, 0x1f8b0, 0x1f8bc, 0x1f8c0, 0x1f8c2, 0x1f8d0, 0x1f8d9,
+	0x1f900, 0x1fa58, 0x1fa60, 0x1fa6e, 0x1fa70, 0x1fa7d, 0x1fa80, 0x1fa8b,
+	0x1fa8e, 0x1fac7, 0x1fac8, 0x1fac9, 0x1facd, 0x1fadd, 0x1fadf, 0x1faeb,
+	0x1faef, 0x1faf9, 0x1fb00, 0x1fb93, 0x1fb94, 0x1fbfb, 0x1fffe, 0x20000,
+	0x2fffe, 0x30000, 0x3fffe, 0x40000, 0x4fffe, 0x50000, 0x5fffe, 0x60000,
+	0x6fffe, 0x70000, 0x7fffe, 0x80000, 0x8fffe, 0x90000, 0x9fffe, 0xa0000,
+	0xafffe, 0xb0000, 0xbfffe, 0xc0000, 0xcfffe, 0xd0000, 0xdfffe, 0xe1000,
+	0xefffe, 0xf0000, 0xffffe, 0x100000, 0x10fffe,
+}
+
+var bidiRunValues = [...]byte{
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x00, 0x02, 0x00, 0x02,
+	0x00, 0x02, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01,
+	0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00, 0x01, 0x00,
+}
+
@@ -0,0 +1,24 @@
+package textenc
+
+import (
+	unicode_id_trie_rle "github.com/aeldidi/unicode-id-trie-rle/go"
+	"golang.org/x/text/encoding"
+)
+
+// Checks if s is a unicode identifier, as defined by IsIdent, which survives
+// being encoded with enc and decoded again unchanged, such as for storage in
+// a database using a legacy encoding like Latin-1 or Shift JIS. An identifier
+// with any rune enc can't represent is rejected, as is one enc would only
+// represent approximately, such as by replacing it.
+func IsEncodable(s string, enc encoding.Encoding) bool {
+	if !unicode_id_trie_rle.IsIdentString(s) {
+		return false
+	}
+
+	encoded, err := enc.NewEncoder().String(s)
+	if err != nil {
+		return false
+	}
+	decoded, err := enc.NewDecoder().String(encoded)
+	return err == nil && decoded == s
+}
@@ -0,0 +1,46 @@
+package textenc
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// An encoding whose encoder replaces the runes it can't represent instead of
+// failing, like many legacy encoders do.
+type replacingEncoding struct {
+	encoding.Encoding
+}
+
+func (e replacingEncoding) NewEncoder() *encoding.Encoder {
+	return encoding.ReplaceUnsupported(e.Encoding.NewEncoder())
+}
+
+func TestIsEncodable(t *testing.T) {
+	latin1Replacing := replacingEncoding{charmap.ISO8859_1}
+	cases := []struct {
+		s        string
+		enc      encoding.Encoding
+		expected bool
+	}{
+		{"café", charmap.ISO8859_1, true},
+		{"naïve_42", charmap.ISO8859_1, true},
+		{"名前", charmap.ISO8859_1, false},
+		{"œuvre", charmap.ISO8859_1, false},
+		{"œuvre", charmap.Windows1252, true},
+		{"café", charmap.ISO8859_1, false},
+		{"名前", japanese.ShiftJIS, true},
+		{"café", japanese.ShiftJIS, false},
+		{"1abc", charmap.ISO8859_1, false},
+		{"café", latin1Replacing, true},
+		{"œuvre", latin1Replacing, false},
+		{"名前", latin1Replacing, false},
+	}
+	for _, c := range cases {
+		if got := IsEncodable(c.s, c.enc); got != c.expected {
+			t.Errorf("IsEncodable(%q, %v) = %v, expected %v", c.s, c.enc, got, c.expected)
+		}
+	}
+}
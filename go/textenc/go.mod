@@ -0,0 +1,12 @@
+module github.com/aeldidi/unicode-id-trie-rle/go/textenc
+
+go 1.26.0
+
+require (
+	github.com/aeldidi/unicode-id-trie-rle/go v0.0.0-00010101000000-000000000000
+	golang.org/x/text v0.42.0
+)
+
+require github.com/clipperhouse/uax29/v2 v2.7.0 // indirect
+
+replace github.com/aeldidi/unicode-id-trie-rle/go => ../
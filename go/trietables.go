@@ -0,0 +1,276 @@
+package unicode_id_trie_rle
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The first bytes of a blob written by the generator's -blob flag, and the
+// version of its layout, which decodeTrieTables must match.
+const (
+	blobMagic  = "UIDT"
+	blobFormat = 1
+)
+
+// The bits of a blob's flags byte.
+const (
+	blobFlagMPH = 1 << iota
+	blobFlagVarintRuns
+)
+
+// A set of trie tables decoded from a blob, along with the constants the
+// generator emits beside them, so that tables for a Unicode version other
+// than the compiled-in one can be loaded at run time. It's a tableSource,
+// read by the same lookups as the package's own tables.
+type trieTables struct {
+	version                    string
+	minCodepoint, maxCodepoint rune
+	shift, lowerBits           uint
+	useMPH, useVarintRuns      bool
+	mphDefaultLeaf             uint16
+	customBits                 IdentifierClass
+
+	leafOffsets   []uint16
+	leafRunStarts []uint16
+	leafRunValues []IdentifierClass
+	level2Tables  []uint16
+	level1Table   []uint16
+	mphSeeds      []uint16
+	mphKeys       []uint16
+	mphLeaves     []uint16
+
+	runStreamOffsets []uint16
+	runStream        []byte
+}
+
+var errTruncatedBlob = errors.New("blob is truncated")
+
+// Reads a blob from the front, remembering the first error so that the
+// caller only checks once.
+type blobReader struct {
+	data string
+	err  error
+}
+
+func (r *blobReader) next(n int) string {
+	if r.err != nil {
+		return ""
+	}
+	if n < 0 || n > len(r.data) {
+		r.err = errTruncatedBlob
+		return ""
+	}
+	s := r.data[:n]
+	r.data = r.data[n:]
+	return s
+}
+
+func (r *blobReader) uint8() byte {
+	if s := r.next(1); s != "" {
+		return s[0]
+	}
+	return 0
+}
+
+func (r *blobReader) uint16() uint16 {
+	if s := r.next(2); s != "" {
+		return uint16(s[0]) | uint16(s[1])<<8
+	}
+	return 0
+}
+
+func (r *blobReader) uint32() uint32 {
+	if s := r.next(4); s != "" {
+		return uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+	}
+	return 0
+}
+
+// Reads the length of the next table, which the generator writes before
+// each one, in the same order as decodeTrieTables reads them.
+func (r *blobReader) tableLen(size int) int {
+	n := r.uint32()
+	if r.err == nil && uint64(n)*uint64(size) > uint64(len(r.data)) {
+		r.err = errTruncatedBlob
+	}
+	return int(n)
+}
+
+func (r *blobReader) uint16Table() []uint16 {
+	n := r.tableLen(2)
+	if r.err != nil {
+		return nil
+	}
+	table := make([]uint16, n)
+	for i := range table {
+		table[i] = r.uint16()
+	}
+	return table
+}
+
+func (r *blobReader) classTable() []IdentifierClass {
+	data := r.next(r.tableLen(1))
+	table := make([]IdentifierClass, len(data))
+	for i := range table {
+		table[i] = IdentifierClass(data[i])
+	}
+	return table
+}
+
+func (r *blobReader) byteTable() []byte {
+	return []byte(r.next(r.tableLen(1)))
+}
+
+// Decodes a blob written by the generator's -blob flag, checking that every
+// index in it stays in bounds so that lookups can't panic however the blob
+// was corrupted.
+func decodeTrieTables(blob string) (*trieTables, error) {
+	r := &blobReader{data: blob}
+	if r.next(len(blobMagic)) != blobMagic {
+		return nil, errors.New("not a tables blob")
+	}
+	if format := r.uint8(); format != blobFormat {
+		return nil, fmt.Errorf("blob format %d isn't supported, expected %d", format, blobFormat)
+	}
+
+	t := &trieTables{}
+	t.version = r.next(int(r.uint8()))
+	t.minCodepoint = rune(r.uint32())
+	t.maxCodepoint = rune(r.uint32())
+	t.shift = uint(r.uint8())
+	t.lowerBits = uint(r.uint8())
+	flags := r.uint8()
+	t.useMPH = flags&blobFlagMPH != 0
+	t.useVarintRuns = flags&blobFlagVarintRuns != 0
+	t.mphDefaultLeaf = r.uint16()
+	t.customBits = IdentifierClass(r.uint8())
+
+	t.leafOffsets = r.uint16Table()
+	t.leafRunStarts = r.uint16Table()
+	t.leafRunValues = r.classTable()
+	t.level2Tables = r.uint16Table()
+	t.level1Table = r.uint16Table()
+	t.mphSeeds = r.uint16Table()
+	t.mphKeys = r.uint16Table()
+	t.mphLeaves = r.uint16Table()
+	if t.useVarintRuns {
+		t.runStreamOffsets = r.uint16Table()
+		t.runStream = r.byteTable()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.data != "" {
+		return nil, errors.New("trailing data after the tables")
+	}
+	if err := t.check(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Checks that every index in the tables stays in bounds, and that the
+// leaves are laid out as leafValue expects, like SelfTest does for the
+// compiled-in tables.
+func (t *trieTables) check() error {
+	if t.minCodepoint < 0 || t.maxCodepoint > 0x10ffff || t.minCodepoint > t.maxCodepoint {
+		return fmt.Errorf("codepoint range %#x..%#x is invalid", t.minCodepoint, t.maxCodepoint)
+	}
+	if t.shift < 7 || t.shift > 15 || t.lowerBits > 16 {
+		return fmt.Errorf("shift %d and lower bits %d are out of range", t.shift, t.lowerBits)
+	}
+	if t.customBits&(Start|Continue) != 0 {
+		return fmt.Errorf("custom bits %#x overlap Start and Continue", t.customBits)
+	}
+
+	if len(t.leafRunStarts) != len(t.leafRunValues) {
+		return fmt.Errorf("leafRunStarts has %d entries but leafRunValues has %d", len(t.leafRunStarts), len(t.leafRunValues))
+	}
+	if len(t.leafOffsets) < 2 || t.leafOffsets[0] != 0 || int(t.leafOffsets[len(t.leafOffsets)-1]) != len(t.leafRunStarts) {
+		return errors.New("leafOffsets doesn't span the leaf runs")
+	}
+	leafCount := len(t.leafOffsets) - 1
+	for i := 0; i < leafCount; i++ {
+		start, end := t.leafOffsets[i], t.leafOffsets[i+1]
+		if start >= end || int(end) > len(t.leafRunStarts) {
+			return fmt.Errorf("leaf %d is empty or out of bounds (%d..%d)", i, start, end)
+		}
+		runs := t.leafRunStarts[start:end]
+		if runs[0] != 0 && !(i == 0 && runs[0] == startCodepoint) {
+			return fmt.Errorf("leaf %d starts at %#x, expected 0", i, runs[0])
+		}
+		for j := 1; j < len(runs); j++ {
+			if runs[j] < runs[j-1] {
+				return fmt.Errorf("leaf %d run starts decrease at run %d (%#x < %#x)", i, j, runs[j], runs[j-1])
+			}
+		}
+		if runs[len(runs)-1] != 1<<t.shift {
+			return fmt.Errorf("leaf %d ends at %#x, expected %#x", i, runs[len(runs)-1], 1<<t.shift)
+		}
+		for _, v := range t.leafRunValues[start:end] {
+			if v&^(Start|Continue|t.customBits) != 0 {
+				return fmt.Errorf("leaf %d has invalid class %#x", i, v)
+			}
+		}
+	}
+
+	lowerSize := 1 << t.lowerBits
+	if blocks := int(t.maxCodepoint)>>t.shift + 1; len(t.level1Table)*lowerSize < blocks {
+		return fmt.Errorf("level1Table covers %d blocks, expected at least %d", len(t.level1Table)*lowerSize, blocks)
+	}
+	for top, idx := range t.level1Table {
+		if (int(idx)+1)*lowerSize > len(t.level2Tables) {
+			return fmt.Errorf("level1Table[%d] = %d is out of bounds", top, idx)
+		}
+	}
+	for i, leafIdx := range t.level2Tables {
+		if int(leafIdx) >= leafCount {
+			return fmt.Errorf("level2Tables[%d] = %d is out of bounds", i, leafIdx)
+		}
+	}
+
+	if len(t.mphKeys) != len(t.mphSeeds) || len(t.mphLeaves) != len(t.mphSeeds) || len(t.mphSeeds) == 0 {
+		return errors.New("perfect hash tables have mismatched lengths")
+	}
+	if int(t.mphDefaultLeaf) >= leafCount {
+		return fmt.Errorf("mphDefaultLeaf %d is out of bounds", t.mphDefaultLeaf)
+	}
+	for i, leafIdx := range t.mphLeaves {
+		if int(leafIdx) >= leafCount {
+			return fmt.Errorf("mphLeaves[%d] = %d is out of bounds", i, leafIdx)
+		}
+	}
+
+	if t.useVarintRuns {
+		if len(t.runStreamOffsets) != leafCount+1 || int(t.runStreamOffsets[leafCount]) != len(t.runStream) {
+			return errors.New("runStreamOffsets doesn't span the run stream")
+		}
+		for i := 0; i < leafCount; i++ {
+			if t.runStreamOffsets[i] > t.runStreamOffsets[i+1] || int(t.runStreamOffsets[i+1]) > len(t.runStream) {
+				return fmt.Errorf("runStreamOffsets decreases at leaf %d", i)
+			}
+		}
+	}
+	return nil
+}
+
+func (t *trieTables) codepointRange() (lo, hi rune) {
+	return t.minCodepoint, t.maxCodepoint
+}
+
+// The run arrays are always in the blob, so they're read even if it was
+// generated with -varint.
+func (t *trieTables) runsOf(cp rune) ([]uint16, []IdentifierClass, uint16) {
+	leafIdx := t.blockLeaf(uint32(cp) >> t.shift)
+	start, end := t.leafOffsets[leafIdx], t.leafOffsets[leafIdx+1]
+	return t.leafRunStarts[start:end], t.leafRunValues[start:end], uint16(uint32(cp) & (1<<t.shift - 1))
+}
+
+// Finds the leaf through the minimal perfect hash if the blob was generated
+// with -mph, and the two-level tables otherwise.
+func (t *trieTables) blockLeaf(block uint32) uint16 {
+	if t.useMPH {
+		return hashBlockLeaf(t.mphSeeds, t.mphKeys, t.mphLeaves, t.mphDefaultLeaf, block)
+	}
+	return levelBlockLeaf(t.level1Table, t.level2Tables, t.lowerBits, block)
+}
@@ -0,0 +1,30 @@
+package unicode_id_trie_rle
+
+import (
+	"unicode"
+	"unicode/utf16"
+)
+
+// Checks if a sequence of UTF-16 code units is a unicode identifier, as
+// defined by IsIdent. Surrogate pairs are decoded into a single codepoint,
+// and an unpaired surrogate makes the identifier invalid.
+func IsIdentUTF16(units []uint16) bool {
+	var v identScanner
+	for i := 0; i < len(units); i++ {
+		c := rune(units[i])
+		if utf16.IsSurrogate(c) {
+			if i+1 == len(units) {
+				return false
+			}
+			c = utf16.DecodeRune(c, rune(units[i+1]))
+			if c == unicode.ReplacementChar {
+				return false
+			}
+			i++
+		}
+		if !v.next(c) {
+			return false
+		}
+	}
+	return v.valid()
+}
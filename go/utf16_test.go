@@ -0,0 +1,31 @@
+package unicode_id_trie_rle
+
+import (
+	"testing"
+	"unicode/utf16"
+)
+
+func TestIsIdentUTF16(t *testing.T) {
+	cases := []struct {
+		name     string
+		units    []uint16
+		expected bool
+	}{
+		{"ascii", utf16.Encode([]rune("id_42")), true},
+		// U+10400 DESERET CAPITAL LETTER LONG I
+		{"surrogate pair", []uint16{'a', 0xd801, 0xdc00, 'b'}, true},
+		{"leading surrogate pair", []uint16{0xd801, 0xdc00}, true},
+		{"unpaired high surrogate", []uint16{'a', 0xd801, 'b'}, false},
+		{"trailing high surrogate", []uint16{'a', 0xd801}, false},
+		{"unpaired low surrogate", []uint16{'a', 0xdc00}, false},
+		{"medial ZWJ", []uint16{'a', ZWJ, 'b'}, true},
+		{"trailing ZWJ", []uint16{'a', ZWJ}, false},
+		{"empty", nil, false},
+		{"leading digit", utf16.Encode([]rune("4id")), false},
+	}
+	for _, c := range cases {
+		if got := IsIdentUTF16(c.units); got != c.expected {
+			t.Errorf("%s: IsIdentUTF16(%04x) = %v, expected %v", c.name, c.units, got, c.expected)
+		}
+	}
+}